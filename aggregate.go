@@ -0,0 +1,234 @@
+package graph
+
+import "fmt"
+
+// AggregateNeighbors computes a value for each vertex by aggregating over the
+// edges to its neighbors, similar to MapReduce: mapper computes a value for each
+// edge, and reduce combines these values pairwise into a single value. This is
+// useful for computing features like the mean neighbor value or the maximum
+// edge weight of each vertex:
+//
+//	// Compute the maximum weight of the edges of each vertex.
+//	maxWeights, _ := graph.AggregateNeighbors(g,
+//		func(edge graph.Edge[string]) int { return edge.Properties.Weight },
+//		func(a, b int) int {
+//			if a > b {
+//				return a
+//			}
+//			return b
+//		})
+//
+// The edges passed to mapper contain the source vertex, which is the vertex for
+// which the value is computed, and the target vertex, which is the neighbor. In
+// a directed graph, only outgoing edges are considered. Vertices without any
+// neighbors are not contained in the returned map, because there is no value
+// to aggregate.
+//
+// The vertices are processed in parallel, so mapper and reduce must not modify
+// shared data without synchronization. The order in which reduce combines the
+// values is undefined, so reduce should be associative and commutative. The
+// number of goroutines can be limited using [WithParallelism] or
+// [SetParallelism], and the progress can be reported using [WithProgress].
+func AggregateNeighbors[K comparable, T any, M any](g Graph[K, T], mapper func(Edge[T]) M, reduce func(M, M) M, options ...func(*computation)) (map[K]M, error) {
+	var c computation
+
+	for _, option := range options {
+		option(&c)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	hashes := make([]K, 0, len(adjacencyMap))
+	values := make(map[K]T, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		value, err := g.Vertex(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		hashes = append(hashes, hash)
+		values[hash] = value
+	}
+
+	results := make([]M, len(hashes))
+	hasResult := make([]bool, len(hashes))
+	progress := newProgressReporter(c.progress, len(hashes))
+
+	runParallel(workerCount(c.parallelism, len(hashes)), len(hashes), func(_, start, end int) {
+		for i := start; i < end; i++ {
+			source := hashes[i]
+
+			for target, edge := range adjacencyMap[source] {
+				value := mapper(Edge[T]{
+					Source:     values[source],
+					Target:     values[target],
+					Properties: edge.Properties,
+				})
+
+				if hasResult[i] {
+					results[i] = reduce(results[i], value)
+				} else {
+					results[i] = value
+					hasResult[i] = true
+				}
+			}
+
+			progress.add(1)
+		}
+	})
+
+	aggregates := make(map[K]M, len(hashes))
+
+	for i, hash := range hashes {
+		if hasResult[i] {
+			aggregates[hash] = results[i]
+		}
+	}
+
+	return aggregates, nil
+}
+
+// AggregateVertices computes a single value for the entire graph by aggregating
+// over all vertices, similar to MapReduce: mapper computes a value for each
+// vertex, and reduce combines these values pairwise into a single value. This is
+// useful for computing sums, maxima, or custom statistics over large graphs:
+//
+//	// Compute the total population of all cities.
+//	population, _ := graph.AggregateVertices(g,
+//		func(city City, _ graph.VertexProperties) int { return city.Population },
+//		func(a, b int) int { return a + b })
+//
+// If the graph has no vertices, the zero value of M is returned.
+//
+// The vertices are mapped in parallel, so mapper and reduce must not modify
+// shared data without synchronization. The order in which reduce combines the
+// values is undefined, so reduce should be associative and commutative. The
+// number of goroutines can be limited using [WithParallelism] or
+// [SetParallelism], and the progress can be reported using [WithProgress].
+func AggregateVertices[K comparable, T any, M any](g Graph[K, T], mapper func(vertex T, properties VertexProperties) M, reduce func(M, M) M, options ...func(*computation)) (M, error) {
+	var result M
+
+	hashes := make([]K, 0)
+
+	_ = VisitVertices(g, func(hash K) bool {
+		hashes = append(hashes, hash)
+		return false
+	})
+
+	values := make([]T, len(hashes))
+	properties := make([]VertexProperties, len(hashes))
+
+	for i, hash := range hashes {
+		value, vertexProperties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return result, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		values[i], properties[i] = value, vertexProperties
+	}
+
+	result = mapReduce(len(hashes), func(i int) M {
+		return mapper(values[i], properties[i])
+	}, reduce, options)
+
+	return result, nil
+}
+
+// AggregateEdges computes a single value for the entire graph by aggregating
+// over all edges, just like AggregateVertices does for the vertices. In an
+// undirected graph, each edge is mapped only once:
+//
+//	// Compute the maximum edge weight.
+//	maxWeight, _ := graph.AggregateEdges(g,
+//		func(edge graph.Edge[string]) int { return edge.Properties.Weight },
+//		func(a, b int) int {
+//			if a > b {
+//				return a
+//			}
+//			return b
+//		})
+//
+// The edges passed to mapper contain the values of their source and target
+// vertices. If the graph has no edges, the zero value of M is returned. Like
+// for AggregateVertices, the edges are mapped in parallel.
+func AggregateEdges[K comparable, T any, M any](g Graph[K, T], mapper func(Edge[T]) M, reduce func(M, M) M, options ...func(*computation)) (M, error) {
+	var result M
+
+	edges, err := g.Edges()
+	if err != nil {
+		return result, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	values := make(map[K]T)
+
+	for _, edge := range edges {
+		for _, hash := range []K{edge.Source, edge.Target} {
+			if _, ok := values[hash]; ok {
+				continue
+			}
+
+			value, err := g.Vertex(hash)
+			if err != nil {
+				return result, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+			}
+
+			values[hash] = value
+		}
+	}
+
+	result = mapReduce(len(edges), func(i int) M {
+		return mapper(Edge[T]{
+			Source:     values[edges[i].Source],
+			Target:     values[edges[i].Target],
+			Properties: edges[i].Properties,
+		})
+	}, reduce, options)
+
+	return result, nil
+}
+
+// mapReduce maps the items 0 to n-1 in parallel and reduces the mapped values
+// into a single value. Each worker reduces the values of its own items first,
+// and the results of all workers are reduced afterwards. If there are no items,
+// the zero value of M is returned.
+func mapReduce[M any](n int, mapper func(i int) M, reduce func(M, M) M, options []func(*computation)) M {
+	var c computation
+
+	for _, option := range options {
+		option(&c)
+	}
+
+	workers := workerCount(c.parallelism, n)
+	results := make([]M, workers)
+	progress := newProgressReporter(c.progress, n)
+
+	runParallel(workers, n, func(worker, start, end int) {
+		for i := start; i < end; i++ {
+			value := mapper(i)
+
+			if i == start {
+				results[worker] = value
+			} else {
+				results[worker] = reduce(results[worker], value)
+			}
+
+			progress.add(1)
+		}
+	})
+
+	var result M
+
+	for worker, value := range results {
+		if worker == 0 {
+			result = value
+		} else {
+			result = reduce(result, value)
+		}
+	}
+
+	return result
+}
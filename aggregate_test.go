@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestAggregateNeighbors(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		edges      []Edge[int]
+		expected   map[int]int
+	}{
+		"directed graph": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 7}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+			},
+			expected: map[int]int{1: 7, 2: 5},
+		},
+		"undirected graph": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 7}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+			},
+			expected: map[int]int{1: 7, 2: 5, 3: 7},
+		},
+		"no edges": {
+			isDirected: true,
+			expected:   map[int]int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var g Graph[int, int]
+
+			if test.isDirected {
+				g = New(IntHash, Directed(), Weighted())
+			} else {
+				g = New(IntHash, Weighted())
+			}
+
+			for _, vertex := range []int{1, 2, 3, 4} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			maxWeights, err := AggregateNeighbors(g,
+				func(edge Edge[int]) int { return edge.Properties.Weight },
+				func(a, b int) int {
+					if a > b {
+						return a
+					}
+					return b
+				})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(maxWeights) != len(test.expected) {
+				t.Fatalf("expected %d aggregates, got %d: %v", len(test.expected), len(maxWeights), maxWeights)
+			}
+
+			for vertex, expected := range test.expected {
+				if maxWeights[vertex] != expected {
+					t.Errorf("expected aggregate %d for vertex %d, got %d", expected, vertex, maxWeights[vertex])
+				}
+			}
+		})
+	}
+}
+
+func TestAggregateNeighbors_MeanNeighborValue(t *testing.T) {
+	type sum struct {
+		total, count int
+	}
+
+	g := New(func(v int) int { return v * 10 }, Directed())
+
+	for _, vertex := range []int{1, 2, 3, 4} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(10, 20)
+	_ = g.AddEdge(10, 30)
+	_ = g.AddEdge(10, 40)
+	_ = g.AddEdge(20, 40)
+
+	sums, err := AggregateNeighbors(g,
+		func(edge Edge[int]) sum { return sum{total: edge.Target, count: 1} },
+		func(a, b sum) sum { return sum{total: a.total + b.total, count: a.count + b.count} })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if mean := sums[10].total / sums[10].count; mean != 3 {
+		t.Errorf("expected mean neighbor value 3 for vertex 10, got %d", mean)
+	}
+
+	if mean := sums[20].total / sums[20].count; mean != 4 {
+		t.Errorf("expected mean neighbor value 4 for vertex 20, got %d", mean)
+	}
+}
+
+func TestAggregateVertices(t *testing.T) {
+	tests := map[string]struct {
+		vertices    []int
+		parallelism int
+		expected    int
+	}{
+		"sequential": {
+			vertices:    []int{1, 2, 3, 4, 5},
+			parallelism: 1,
+			expected:    15,
+		},
+		"parallel": {
+			vertices:    []int{1, 2, 3, 4, 5},
+			parallelism: 3,
+			expected:    15,
+		},
+		"more workers than vertices": {
+			vertices:    []int{1, 2},
+			parallelism: 8,
+			expected:    3,
+		},
+		"no vertices": {
+			parallelism: 2,
+			expected:    0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			sum, err := AggregateVertices(g,
+				func(vertex int, _ VertexProperties) int { return vertex },
+				func(a, b int) int { return a + b },
+				WithParallelism(test.parallelism))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if sum != test.expected {
+				t.Errorf("expected sum %d, got %d", test.expected, sum)
+			}
+		})
+	}
+}
+
+func TestAggregateVertices_Properties(t *testing.T) {
+	g := New(StringHash)
+
+	_ = g.AddVertex("A", VertexWeight(3))
+	_ = g.AddVertex("B", VertexWeight(9))
+	_ = g.AddVertex("C", VertexWeight(4))
+
+	maxWeight, err := AggregateVertices(g,
+		func(_ string, properties VertexProperties) int { return properties.Weight },
+		func(a, b int) int {
+			if a > b {
+				return a
+			}
+			return b
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if maxWeight != 9 {
+		t.Errorf("expected maximum weight 9, got %d", maxWeight)
+	}
+}
+
+func TestAggregateEdges(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		edges         []Edge[int]
+		expectedSum   int
+		expectedCount int
+	}{
+		"directed graph": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 4}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedSum:   12,
+			expectedCount: 3,
+		},
+		"undirected graph": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+			},
+			expectedSum:   8,
+			expectedCount: 2,
+		},
+		"no edges": {},
+	}
+
+	type aggregate struct {
+		sum, count int
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var g Graph[int, int]
+
+			if test.isDirected {
+				g = New(IntHash, Directed(), Weighted())
+			} else {
+				g = New(IntHash, Weighted())
+			}
+
+			for _, vertex := range []int{1, 2, 3} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			result, err := AggregateEdges(g,
+				func(edge Edge[int]) aggregate { return aggregate{sum: edge.Properties.Weight, count: 1} },
+				func(a, b aggregate) aggregate { return aggregate{sum: a.sum + b.sum, count: a.count + b.count} },
+				WithParallelism(2))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.sum != test.expectedSum || result.count != test.expectedCount {
+				t.Errorf("expected sum %d of %d edges, got %d of %d edges", test.expectedSum, test.expectedCount, result.sum, result.count)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNegativeCycle is returned by shortest path algorithms if the graph
+// contains a cycle with a negative total weight, which makes shortest paths
+// undefined. Use [FindNegativeCycle] to determine the cycle.
+var ErrNegativeCycle = errors.New("graph contains a negative cycle")
+
+// AllPairsShortestPaths computes the shortest paths between all pairs of
+// vertices. It returns the shortest paths from each vertex to all other
+// vertices, keyed by the source vertex:
+//
+//	paths, _ := graph.AllPairsShortestPaths(g)
+//
+//	distance, _ := paths["A"].Distance("B")
+//	path, _ := paths["A"].ShortestPath("B")
+//
+// Unlike [ShortestPathTree], AllPairsShortestPaths supports negative edge
+// weights in weighted graphs. It uses Johnson's algorithm: The Bellman-Ford
+// algorithm determines a potential for each vertex, which is used to reweight
+// the edges so that all weights become non-negative while preserving the
+// shortest paths. Dijkstra's algorithm is then run from each vertex on the
+// reweighted graph. This takes O(|V|*|E|log(|V|)) time, which is faster than
+// the O(|V|³) of the Floyd-Warshall algorithm for sparse graphs.
+//
+// If the graph contains a cycle with a negative total weight, shortest paths
+// are undefined and ErrNegativeCycle is returned. In an undirected graph, each
+// edge with a negative weight forms such a cycle. For unweighted graphs, a BFS
+// is run from each vertex instead.
+//
+// Computing all shortest paths in a large graph may take a while. Use
+// [WithProgress] to report the number of source vertices processed so far.
+func AllPairsShortestPaths[K comparable, T any](g Graph[K, T], options ...func(*computation)) (map[K]*ShortestPaths[K, T], error) {
+	return AllPairsShortestPathsContext(context.Background(), g, options...)
+}
+
+// AllPairsShortestPathsContext works like [AllPairsShortestPaths], but stops
+// the computation as soon as the given context is cancelled and returns the
+// error of the context. This allows servers to bound the computation time:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//
+//	paths, err := graph.AllPairsShortestPathsContext(ctx, g)
+//	if errors.Is(err, context.DeadlineExceeded) {
+//		// The graph is too large to compute all shortest paths in time.
+//	}
+//
+// The context is checked before computing the shortest paths from each vertex.
+func AllPairsShortestPathsContext[K comparable, T any](ctx context.Context, g Graph[K, T], options ...func(*computation)) (map[K]*ShortestPaths[K, T], error) {
+	var c computation
+
+	for _, option := range options {
+		option(&c)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	allPaths := make(map[K]*ShortestPaths[K, T], len(adjacencyMap))
+	progress := newProgressReporter(c.progress, len(adjacencyMap))
+
+	if !g.Traits().IsWeighted {
+		for source := range adjacencyMap {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			if allPaths[source], err = shortestPathTree(g, adjacencyMap, source); err != nil {
+				return nil, fmt.Errorf("failed to compute shortest paths from vertex %v: %w", source, err)
+			}
+
+			progress.add(1)
+		}
+
+		return allPaths, nil
+	}
+
+	potentials, err := johnsonPotentials(adjacencyMap)
+	if err != nil {
+		return nil, err
+	}
+
+	reweighted := make(map[K]map[K]Edge[K], len(adjacencyMap))
+
+	for source, adjacencies := range adjacencyMap {
+		reweighted[source] = make(map[K]Edge[K], len(adjacencies))
+
+		for target, edge := range adjacencies {
+			edge.Properties.Weight += potentials[source] - potentials[target]
+			reweighted[source][target] = edge
+		}
+	}
+
+	for source := range adjacencyMap {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		paths, err := shortestPathTree(g, reweighted, source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute shortest paths from vertex %v: %w", source, err)
+		}
+
+		// Undo the reweighting to obtain the distances in the original graph.
+		for target, distance := range paths.distances {
+			paths.distances[target] = distance - float64(potentials[source]) + float64(potentials[target])
+		}
+
+		allPaths[source] = paths
+		progress.add(1)
+	}
+
+	return allPaths, nil
+}
+
+// FindNegativeCycle finds a cycle whose edge weights sum up to a negative value
+// and returns its vertices in the order of its edges, where the last vertex has
+// an edge back to the first one. If there is no such cycle, it returns nil.
+// Negative cycles are the reason why shortest paths may be undefined, and they
+// also indicate arbitrage opportunities: In a graph of exchange rates where
+// each edge is weighted with the negative logarithm of the rate, a negative
+// cycle is a sequence of trades that yields a profit.
+//
+// In an undirected graph, each edge with a negative weight forms a negative
+// cycle with its two vertices. Unweighted graphs never contain negative
+// cycles. FindNegativeCycle uses the Bellman-Ford algorithm, which takes
+// O(|V|*|E|) time.
+func FindNegativeCycle[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	if !g.Traits().IsWeighted {
+		return nil, nil
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	_, predecessors, vertex, ok := bellmanFord(adjacencyMap)
+	if !ok {
+		return nil, nil
+	}
+
+	// The vertex has been improved in the last iteration, so following its
+	// predecessors for |V| steps is guaranteed to end up on the cycle.
+	for i := 0; i < len(adjacencyMap); i++ {
+		vertex = predecessors[vertex]
+	}
+
+	cycle := []K{vertex}
+
+	for current := predecessors[vertex]; current != vertex; current = predecessors[current] {
+		cycle = append(cycle, current)
+	}
+
+	// The predecessors lead backwards along the cycle.
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+
+	return cycle, nil
+}
+
+// johnsonPotentials runs the Bellman-Ford algorithm like bellmanFord does and
+// returns the distance from the virtual vertex to each vertex. For each edge
+// (u,v), these potentials satisfy h(v) <= h(u) + w(u,v), so the reweighted
+// edge weights w(u,v) + h(u) - h(v) are non-negative. If the graph contains a
+// negative cycle, ErrNegativeCycle is returned.
+func johnsonPotentials[K comparable](adjacencyMap map[K]map[K]Edge[K]) (map[K]int, error) {
+	potentials, _, _, ok := bellmanFord(adjacencyMap)
+	if ok {
+		return nil, ErrNegativeCycle
+	}
+
+	return potentials, nil
+}
+
+// bellmanFord runs the Bellman-Ford algorithm from a virtual vertex that has an
+// edge with weight 0 to each vertex. It returns the distance from the virtual
+// vertex to each vertex and the predecessor of each vertex on the shortest
+// path, which is missing for vertices whose shortest path is the edge from the
+// virtual vertex. If the graph contains a negative cycle, it also returns a
+// vertex that has been improved in the last iteration and true.
+func bellmanFord[K comparable](adjacencyMap map[K]map[K]Edge[K]) (map[K]int, map[K]K, K, bool) {
+	distances := make(map[K]int, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		distances[vertex] = 0
+	}
+
+	predecessors := make(map[K]K)
+
+	// Including the virtual vertex, there are |V|+1 vertices, so all shortest
+	// paths are found after |V| iterations. Any improvement in another
+	// iteration indicates a negative cycle.
+	for i := 0; ; i++ {
+		var improved K
+		isImproved := false
+
+		for source, adjacencies := range adjacencyMap {
+			for target, edge := range adjacencies {
+				if distance := distances[source] + edge.Properties.Weight; distance < distances[target] {
+					distances[target] = distance
+					predecessors[target] = source
+					improved, isImproved = target, true
+				}
+			}
+		}
+
+		if !isImproved {
+			return distances, predecessors, improved, false
+		}
+
+		if i == len(adjacencyMap) {
+			return distances, predecessors, improved, true
+		}
+	}
+}
@@ -0,0 +1,358 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestAllPairsShortestPaths(t *testing.T) {
+	tests := map[string]struct {
+		traits            []func(*Traits)
+		vertices          []int
+		edges             []Edge[int]
+		expectedDistances map[[2]int]int
+		expectedPaths     map[[2]int][]int
+		shouldFail        bool
+	}{
+		"negative edge weights": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: -3}},
+				{Source: 3, Target: 2, Properties: EdgeProperties{Weight: 2}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 2}},
+			},
+			expectedDistances: map[[2]int]int{
+				{1, 1}: 0,
+				{1, 2}: 3,
+				{1, 4}: 0,
+				{3, 4}: -1,
+				{2, 4}: -3,
+			},
+			expectedPaths: map[[2]int][]int{
+				{1, 4}: {1, 3, 2, 4},
+				{3, 4}: {3, 2, 4},
+			},
+		},
+		"unweighted graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+			expectedDistances: map[[2]int]int{
+				{1, 3}: 1,
+				{2, 3}: 1,
+			},
+		},
+		"negative cycle": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: -2}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 0}},
+			},
+			shouldFail: true,
+		},
+		"undirected negative edge": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -1}},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			paths, err := AllPairsShortestPaths(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				if !errors.Is(err, ErrNegativeCycle) {
+					t.Errorf("expected error %v, got %v", ErrNegativeCycle, err)
+				}
+				return
+			}
+
+			for pair, expected := range test.expectedDistances {
+				distance, err := paths[pair[0]].Distance(pair[1])
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if distance != expected {
+					t.Errorf("expected distance %d from %d to %d, got %d", expected, pair[0], pair[1], distance)
+				}
+			}
+
+			for pair, expected := range test.expectedPaths {
+				path, err := paths[pair[0]].ShortestPath(pair[1])
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if len(path) != len(expected) {
+					t.Fatalf("expected path %v, got %v", expected, path)
+				}
+
+				for i := range path {
+					if path[i] != expected[i] {
+						t.Fatalf("expected path %v, got %v", expected, path)
+					}
+				}
+			}
+
+			if _, err := paths[test.vertices[len(test.vertices)-1]].Distance(test.vertices[0]); !errors.Is(err, ErrTargetNotReachable) {
+				t.Errorf("expected error %v, got %v", ErrTargetNotReachable, err)
+			}
+		})
+	}
+}
+
+func TestAllPairsShortestPaths_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	n := 30
+
+	g := New(IntHash, Directed(), Weighted())
+
+	for vertex := 0; vertex < n; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	// Shifting non-negative weights by the difference of random potentials
+	// yields negative weights, but no negative cycles, because the potentials
+	// cancel out along each cycle.
+	potentials := make([]int, n)
+	for vertex := range potentials {
+		potentials[vertex] = random.Intn(10)
+	}
+
+	for i := 0; i < 120; i++ {
+		source, target := random.Intn(n), random.Intn(n)
+		weight := random.Intn(10) + potentials[source] - potentials[target]
+
+		_ = g.AddEdge(source, target, EdgeWeight(weight))
+	}
+
+	// Compute the expected distances using the Floyd-Warshall algorithm.
+	const infinity = 1 << 30
+
+	distances := make([][]int, n)
+
+	for i := range distances {
+		distances[i] = make([]int, n)
+		for j := range distances[i] {
+			distances[i][j] = infinity
+		}
+		distances[i][i] = 0
+	}
+
+	edges, _ := g.Edges()
+
+	for _, edge := range edges {
+		if edge.Properties.Weight < distances[edge.Source][edge.Target] {
+			distances[edge.Source][edge.Target] = edge.Properties.Weight
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if distances[i][k] < infinity && distances[k][j] < infinity && distances[i][k]+distances[k][j] < distances[i][j] {
+					distances[i][j] = distances[i][k] + distances[k][j]
+				}
+			}
+		}
+	}
+
+	paths, err := AllPairsShortestPaths(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for source := 0; source < n; source++ {
+		for target := 0; target < n; target++ {
+			distance, err := paths[source].Distance(target)
+
+			if distances[source][target] == infinity {
+				if !errors.Is(err, ErrTargetNotReachable) {
+					t.Fatalf("expected %d to be unreachable from %d, got %v", target, source, err)
+				}
+				continue
+			}
+
+			if distance != distances[source][target] {
+				t.Fatalf("expected distance %d from %d to %d, got %d", distances[source][target], source, target, distance)
+			}
+		}
+	}
+}
+
+func TestAllPairsShortestPathsContext(t *testing.T) {
+	for _, traits := range [][]func(*Traits){{Directed()}, {Directed(), Weighted()}} {
+		g := New(IntHash, traits...)
+
+		_ = g.AddVertex(1)
+		_ = g.AddVertex(2)
+		_ = g.AddEdge(1, 2)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if _, err := AllPairsShortestPathsContext(ctx, g); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected error %v, got %v", context.Canceled, err)
+		}
+	}
+}
+
+func TestFindNegativeCycle(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []int
+		edges         []Edge[int]
+		expectedCycle []int
+	}{
+		"negative cycle": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: -4}},
+				{Source: 4, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedCycle: []int{2, 3, 4},
+		},
+		"negative self-loop": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 2, Properties: EdgeProperties{Weight: -1}},
+			},
+			expectedCycle: []int{2},
+		},
+		"negative edge without cycle": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -5}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 3}},
+			},
+		},
+		"undirected negative edge": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -1}},
+			},
+			expectedCycle: []int{1, 2},
+		},
+		"unweighted graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			cycle, err := FindNegativeCycle(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !cyclesAreEqual([][]int{cycle}, [][]int{test.expectedCycle}) {
+				t.Errorf("expected cycle %v, got %v", test.expectedCycle, cycle)
+			}
+		})
+	}
+}
+
+func TestFindNegativeCycle_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20; i++ {
+		g := New(IntHash, Directed(), Weighted())
+		n := 20
+
+		for vertex := 0; vertex < n; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for j := 0; j < 40; j++ {
+			_ = g.AddEdge(random.Intn(n), random.Intn(n), EdgeWeight(random.Intn(10)-2))
+		}
+
+		cycle, err := FindNegativeCycle(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err = AllPairsShortestPaths(g)
+
+		if (cycle != nil) != errors.Is(err, ErrNegativeCycle) {
+			t.Fatalf("expected cycle %v to be consistent with error %v", cycle, err)
+		}
+
+		if cycle == nil {
+			continue
+		}
+
+		weight := 0
+		isVisited := make(map[int]bool)
+
+		for i, vertex := range cycle {
+			if isVisited[vertex] {
+				t.Fatalf("expected simple cycle, got %v", cycle)
+			}
+			isVisited[vertex] = true
+
+			edge, err := g.Edge(vertex, cycle[(i+1)%len(cycle)])
+			if err != nil {
+				t.Fatalf("expected edge (%d, %d) in cycle %v: %v", vertex, cycle[(i+1)%len(cycle)], cycle, err)
+			}
+			weight += edge.Properties.Weight
+		}
+
+		if weight >= 0 {
+			t.Fatalf("expected negative cycle, got %v with weight %d", cycle, weight)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+package graph
+
+import "fmt"
+
+// BipartiteGraph is a graph with two disjoint sets of vertices, the left and the
+// right vertices, where each edge connects a left vertex with a right vertex.
+// Both sets have their own hash and vertex types, so that an edge within one of
+// the sets is rejected at compile time. This models relations between two kinds
+// of entities, such as users and the products they bought:
+//
+//	g := graph.NewBipartiteGraph(userHash, productHash)
+//
+//	_ = g.AddLeftVertex(alice)
+//	_ = g.AddRightVertex(book)
+//
+//	_ = g.AddEdge(alice.ID, book.SKU, graph.EdgeWeight(2))
+//
+// The edges are undirected. To run algorithms for ordinary graphs on one of the
+// sets, use [ProjectLeft] or [ProjectRight]. A BipartiteGraph keeps its vertices
+// and edges in memory and is not safe for concurrent use.
+type BipartiteGraph[K1 comparable, T1 any, K2 comparable, T2 any] struct {
+	left  *bipartiteSide[K1, T1, K2]
+	right *bipartiteSide[K2, T2, K1]
+}
+
+// BipartiteEdge is an edge of a [BipartiteGraph] between a left and a right
+// vertex.
+type BipartiteEdge[K1 comparable, K2 comparable] struct {
+	Left       K1
+	Right      K2
+	Properties EdgeProperties
+}
+
+// bipartiteSide contains the vertices of one side of a bipartite graph, where
+// neighbors contains the properties of the edges to the other side, which are
+// shared with the neighbors of the other side.
+type bipartiteSide[K comparable, T any, L comparable] struct {
+	hash       Hash[K, T]
+	vertices   map[K]T
+	properties map[K]VertexProperties
+	neighbors  map[K]map[L]*EdgeProperties
+}
+
+// NewBipartiteGraph creates an empty bipartite graph that uses the given hashing
+// functions for its left and right vertices.
+func NewBipartiteGraph[K1 comparable, T1 any, K2 comparable, T2 any](leftHash Hash[K1, T1], rightHash Hash[K2, T2]) *BipartiteGraph[K1, T1, K2, T2] {
+	return &BipartiteGraph[K1, T1, K2, T2]{
+		left:  newBipartiteSide[K1, T1, K2](leftHash),
+		right: newBipartiteSide[K2, T2, K1](rightHash),
+	}
+}
+
+func newBipartiteSide[K comparable, T any, L comparable](hash Hash[K, T]) *bipartiteSide[K, T, L] {
+	return &bipartiteSide[K, T, L]{
+		hash:       hash,
+		vertices:   make(map[K]T),
+		properties: make(map[K]VertexProperties),
+		neighbors:  make(map[K]map[L]*EdgeProperties),
+	}
+}
+
+// AddLeftVertex adds a left vertex with the given value. If a left vertex with
+// the same hash already exists, ErrVertexAlreadyExists is returned.
+func (b *BipartiteGraph[K1, T1, K2, T2]) AddLeftVertex(value T1, options ...func(*VertexProperties)) error {
+	return b.left.addVertex(value, options)
+}
+
+// AddRightVertex adds a right vertex with the given value. If a right vertex
+// with the same hash already exists, ErrVertexAlreadyExists is returned.
+func (b *BipartiteGraph[K1, T1, K2, T2]) AddRightVertex(value T2, options ...func(*VertexProperties)) error {
+	return b.right.addVertex(value, options)
+}
+
+// LeftVertex returns the left vertex with the given hash along with its
+// properties or ErrVertexNotFound if it doesn't exist.
+func (b *BipartiteGraph[K1, T1, K2, T2]) LeftVertex(hash K1) (T1, VertexProperties, error) {
+	return b.left.vertex(hash)
+}
+
+// RightVertex returns the right vertex with the given hash along with its
+// properties or ErrVertexNotFound if it doesn't exist.
+func (b *BipartiteGraph[K1, T1, K2, T2]) RightVertex(hash K2) (T2, VertexProperties, error) {
+	return b.right.vertex(hash)
+}
+
+// RemoveLeftVertex removes the left vertex with the given hash. If the vertex
+// has edges, ErrVertexHasEdges is returned.
+func (b *BipartiteGraph[K1, T1, K2, T2]) RemoveLeftVertex(hash K1) error {
+	return b.left.removeVertex(hash)
+}
+
+// RemoveRightVertex removes the right vertex with the given hash. If the vertex
+// has edges, ErrVertexHasEdges is returned.
+func (b *BipartiteGraph[K1, T1, K2, T2]) RemoveRightVertex(hash K2) error {
+	return b.right.removeVertex(hash)
+}
+
+// AddEdge adds an edge between the given left and right vertices. If one of the
+// vertices doesn't exist, ErrVertexNotFound is returned. If the edge already
+// exists, ErrEdgeAlreadyExists is returned.
+func (b *BipartiteGraph[K1, T1, K2, T2]) AddEdge(left K1, right K2, options ...func(*EdgeProperties)) error {
+	if _, ok := b.left.vertices[left]; !ok {
+		return fmt.Errorf("left vertex %v: %w", left, ErrVertexNotFound)
+	}
+
+	if _, ok := b.right.vertices[right]; !ok {
+		return fmt.Errorf("right vertex %v: %w", right, ErrVertexNotFound)
+	}
+
+	if _, ok := b.left.neighbors[left][right]; ok {
+		return ErrEdgeAlreadyExists
+	}
+
+	properties := &EdgeProperties{
+		Attributes: make(map[string]string),
+	}
+
+	for _, option := range options {
+		option(properties)
+	}
+
+	b.left.neighbors[left][right] = properties
+	b.right.neighbors[right][left] = properties
+
+	return nil
+}
+
+// Edge returns the edge between the given left and right vertices or
+// ErrEdgeNotFound if it doesn't exist.
+func (b *BipartiteGraph[K1, T1, K2, T2]) Edge(left K1, right K2) (BipartiteEdge[K1, K2], error) {
+	properties, ok := b.left.neighbors[left][right]
+	if !ok {
+		return BipartiteEdge[K1, K2]{}, ErrEdgeNotFound
+	}
+
+	return BipartiteEdge[K1, K2]{
+		Left:       left,
+		Right:      right,
+		Properties: *properties,
+	}, nil
+}
+
+// RemoveEdge removes the edge between the given left and right vertices or
+// returns ErrEdgeNotFound if it doesn't exist.
+func (b *BipartiteGraph[K1, T1, K2, T2]) RemoveEdge(left K1, right K2) error {
+	if _, ok := b.left.neighbors[left][right]; !ok {
+		return ErrEdgeNotFound
+	}
+
+	delete(b.left.neighbors[left], right)
+	delete(b.right.neighbors[right], left)
+
+	return nil
+}
+
+// Edges returns all edges of the graph in an arbitrary order.
+func (b *BipartiteGraph[K1, T1, K2, T2]) Edges() []BipartiteEdge[K1, K2] {
+	edges := make([]BipartiteEdge[K1, K2], 0)
+
+	for left, neighbors := range b.left.neighbors {
+		for right, properties := range neighbors {
+			edges = append(edges, BipartiteEdge[K1, K2]{
+				Left:       left,
+				Right:      right,
+				Properties: *properties,
+			})
+		}
+	}
+
+	return edges
+}
+
+// LeftNeighbors returns the hashes of the right vertices adjacent to the given
+// left vertex in an arbitrary order, such as the products bought by a user. If
+// the vertex doesn't exist, ErrVertexNotFound is returned.
+func (b *BipartiteGraph[K1, T1, K2, T2]) LeftNeighbors(hash K1) ([]K2, error) {
+	return b.left.adjacent(hash)
+}
+
+// RightNeighbors returns the hashes of the left vertices adjacent to the given
+// right vertex in an arbitrary order, such as the users who bought a product. If
+// the vertex doesn't exist, ErrVertexNotFound is returned.
+func (b *BipartiteGraph[K1, T1, K2, T2]) RightNeighbors(hash K2) ([]K1, error) {
+	return b.right.adjacent(hash)
+}
+
+// Order returns the number of left and the number of right vertices.
+func (b *BipartiteGraph[K1, T1, K2, T2]) Order() (int, int) {
+	return len(b.left.vertices), len(b.right.vertices)
+}
+
+// Size returns the number of edges.
+func (b *BipartiteGraph[K1, T1, K2, T2]) Size() int {
+	size := 0
+	for _, neighbors := range b.left.neighbors {
+		size += len(neighbors)
+	}
+	return size
+}
+
+func (s *bipartiteSide[K, T, L]) addVertex(value T, options []func(*VertexProperties)) error {
+	hash := s.hash(value)
+
+	if _, ok := s.vertices[hash]; ok {
+		return ErrVertexAlreadyExists
+	}
+
+	properties := VertexProperties{
+		Weight:     0,
+		Attributes: make(map[string]string),
+	}
+
+	for _, option := range options {
+		option(&properties)
+	}
+
+	s.vertices[hash] = value
+	s.properties[hash] = properties
+	s.neighbors[hash] = make(map[L]*EdgeProperties)
+
+	return nil
+}
+
+func (s *bipartiteSide[K, T, L]) vertex(hash K) (T, VertexProperties, error) {
+	vertex, ok := s.vertices[hash]
+	if !ok {
+		return vertex, VertexProperties{}, ErrVertexNotFound
+	}
+
+	return vertex, s.properties[hash], nil
+}
+
+func (s *bipartiteSide[K, T, L]) removeVertex(hash K) error {
+	if _, ok := s.vertices[hash]; !ok {
+		return ErrVertexNotFound
+	}
+
+	if len(s.neighbors[hash]) > 0 {
+		return ErrVertexHasEdges
+	}
+
+	delete(s.vertices, hash)
+	delete(s.properties, hash)
+	delete(s.neighbors, hash)
+
+	return nil
+}
+
+func (s *bipartiteSide[K, T, L]) adjacent(hash K) ([]L, error) {
+	neighbors, ok := s.neighbors[hash]
+	if !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	adjacent := make([]L, 0, len(neighbors))
+	for neighbor := range neighbors {
+		adjacent = append(adjacent, neighbor)
+	}
+
+	return adjacent, nil
+}
+
+// ProjectLeft returns the projection of the given bipartite graph onto its left
+// vertices. The projection is an undirected, weighted graph that contains all
+// left vertices along with their properties, where two vertices are adjacent if
+// they share at least one right vertex. The weight of an edge is the number of
+// shared right vertices. For a graph of users and products, this yields a graph
+// of users that bought the same products:
+//
+//	users, _ := graph.ProjectLeft(g)
+//
+// The projection takes O(sum of deg(v)^2) time for all right vertices v, so
+// right vertices with many neighbors dominate the running time.
+func ProjectLeft[K1 comparable, T1 any, K2 comparable, T2 any](b *BipartiteGraph[K1, T1, K2, T2]) (Graph[K1, T1], error) {
+	return projectBipartite(b.left, b.right)
+}
+
+// ProjectRight returns the projection of the given bipartite graph onto its
+// right vertices, where two vertices are adjacent if they share at least one
+// left vertex. See [ProjectLeft] for the structure of the projection.
+func ProjectRight[K1 comparable, T1 any, K2 comparable, T2 any](b *BipartiteGraph[K1, T1, K2, T2]) (Graph[K2, T2], error) {
+	return projectBipartite(b.right, b.left)
+}
+
+// projectBipartite projects the given side of a bipartite graph onto itself
+// using the other side.
+func projectBipartite[K comparable, T any, L comparable, U any](side *bipartiteSide[K, T, L], other *bipartiteSide[L, U, K]) (Graph[K, T], error) {
+	g := New(side.hash, Weighted())
+
+	for hash, value := range side.vertices {
+		if err := g.AddVertex(value, copyVertexProperties(side.properties[hash])); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+	}
+
+	// done contains the vertices whose edges have been added, so that each
+	// edge is only added once.
+	done := make(map[K]struct{}, len(side.vertices))
+
+	for hash, neighbors := range side.neighbors {
+		shared := make(map[K]int)
+
+		for neighbor := range neighbors {
+			for adjacent := range other.neighbors[neighbor] {
+				if _, ok := done[adjacent]; ok || adjacent == hash {
+					continue
+				}
+				shared[adjacent]++
+			}
+		}
+
+		for adjacent, weight := range shared {
+			if err := g.AddEdge(hash, adjacent, EdgeWeight(weight)); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", hash, adjacent, err)
+			}
+		}
+
+		done[hash] = struct{}{}
+	}
+
+	return g, nil
+}
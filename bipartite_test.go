@@ -0,0 +1,184 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBipartiteGraph_AddEdge(t *testing.T) {
+	tests := map[string]struct {
+		left        []string
+		right       []int
+		edges       []BipartiteEdge[string, int]
+		edge        BipartiteEdge[string, int]
+		expectedErr error
+	}{
+		"new edge": {
+			left:  []string{"alice"},
+			right: []int{1},
+			edge:  BipartiteEdge[string, int]{Left: "alice", Right: 1},
+		},
+		"existing edge": {
+			left:        []string{"alice"},
+			right:       []int{1},
+			edges:       []BipartiteEdge[string, int]{{Left: "alice", Right: 1}},
+			edge:        BipartiteEdge[string, int]{Left: "alice", Right: 1},
+			expectedErr: ErrEdgeAlreadyExists,
+		},
+		"missing left vertex": {
+			right:       []int{1},
+			edge:        BipartiteEdge[string, int]{Left: "alice", Right: 1},
+			expectedErr: ErrVertexNotFound,
+		},
+		"missing right vertex": {
+			left:        []string{"alice"},
+			edge:        BipartiteEdge[string, int]{Left: "alice", Right: 1},
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewBipartiteGraph(StringHash, IntHash)
+
+			for _, vertex := range test.left {
+				_ = g.AddLeftVertex(vertex)
+			}
+
+			for _, vertex := range test.right {
+				_ = g.AddRightVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Left, edge.Right)
+			}
+
+			err := g.AddEdge(test.edge.Left, test.edge.Right, EdgeWeight(5))
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			edge, err := g.Edge(test.edge.Left, test.edge.Right)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if edge.Properties.Weight != 5 {
+				t.Errorf("expected weight 5, got %d", edge.Properties.Weight)
+			}
+
+			if neighbors, _ := g.LeftNeighbors(test.edge.Left); !slicesAreEqual(neighbors, []int{test.edge.Right}) {
+				t.Errorf("expected left neighbors %v, got %v", []int{test.edge.Right}, neighbors)
+			}
+
+			if neighbors, _ := g.RightNeighbors(test.edge.Right); !slicesAreEqual(neighbors, []string{test.edge.Left}) {
+				t.Errorf("expected right neighbors %v, got %v", []string{test.edge.Left}, neighbors)
+			}
+		})
+	}
+}
+
+func TestBipartiteGraph_RemoveEdge(t *testing.T) {
+	g := NewBipartiteGraph(StringHash, IntHash)
+
+	_ = g.AddLeftVertex("alice")
+	_ = g.AddRightVertex(1)
+	_ = g.AddEdge("alice", 1)
+
+	if err := g.RemoveLeftVertex("alice"); !errors.Is(err, ErrVertexHasEdges) {
+		t.Fatalf("expected error %v, got %v", ErrVertexHasEdges, err)
+	}
+
+	if err := g.RemoveEdge("alice", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := g.RemoveEdge("alice", 1); !errors.Is(err, ErrEdgeNotFound) {
+		t.Fatalf("expected error %v, got %v", ErrEdgeNotFound, err)
+	}
+
+	if err := g.RemoveRightVertex(1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if left, right := g.Order(); left != 1 || right != 0 {
+		t.Errorf("expected order (1, 0), got (%d, %d)", left, right)
+	}
+
+	if size := g.Size(); size != 0 {
+		t.Errorf("expected size 0, got %d", size)
+	}
+}
+
+func TestProjectLeft(t *testing.T) {
+	g := NewBipartiteGraph(StringHash, IntHash)
+
+	for _, user := range []string{"alice", "bob", "carol", "dave"} {
+		_ = g.AddLeftVertex(user)
+	}
+
+	for _, product := range []int{1, 2, 3} {
+		_ = g.AddRightVertex(product, VertexWeight(product))
+	}
+
+	edges := []BipartiteEdge[string, int]{
+		{Left: "alice", Right: 1},
+		{Left: "alice", Right: 2},
+		{Left: "bob", Right: 1},
+		{Left: "bob", Right: 2},
+		{Left: "carol", Right: 2},
+		{Left: "carol", Right: 3},
+	}
+
+	for _, edge := range edges {
+		_ = g.AddEdge(edge.Left, edge.Right)
+	}
+
+	users, err := ProjectLeft(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedWeights := map[[2]string]int{
+		{"alice", "bob"}:   2,
+		{"alice", "carol"}: 1,
+		{"bob", "carol"}:   1,
+	}
+
+	if size, _ := users.Size(); size != len(expectedWeights) {
+		t.Errorf("expected size %d, got %d", len(expectedWeights), size)
+	}
+
+	for pair, expectedWeight := range expectedWeights {
+		edge, err := users.Edge(pair[0], pair[1])
+		if err != nil {
+			t.Fatalf("unexpected error for (%v, %v): %v", pair[0], pair[1], err)
+		}
+
+		if edge.Properties.Weight != expectedWeight {
+			t.Errorf("expected weight %d for (%v, %v), got %d", expectedWeight, pair[0], pair[1], edge.Properties.Weight)
+		}
+	}
+
+	if order, _ := users.Order(); order != 4 {
+		t.Errorf("expected isolated users to remain, got order %d", order)
+	}
+
+	products, err := ProjectRight(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if edge, err := products.Edge(1, 2); err != nil || edge.Properties.Weight != 2 {
+		t.Errorf("expected edge (1, 2) with weight 2, got %v, %v", edge.Properties.Weight, err)
+	}
+
+	if _, properties, _ := products.VertexWithProperties(3); properties.Weight != 3 {
+		t.Errorf("expected weight 3 for product 3, got %d", properties.Weight)
+	}
+}
@@ -0,0 +1,121 @@
+package graph
+
+import "fmt"
+
+// Builder provides a fluent API for building a graph. Instead of checking the
+// error of each AddVertex and AddEdge call, all errors are collected and then
+// returned at once by Build:
+//
+//	g, err := graph.NewBuilder(graph.IntHash, graph.Directed()).
+//		AddVertex(1).
+//		AddVertex(2).
+//		AddVertex(3).
+//		AddEdge(1, 2).
+//		AddEdge(2, 3, graph.EdgeWeight(4)).
+//		Build()
+//
+// This makes a Builder particularly useful for test fixtures or graphs built
+// from configuration files. All errors are returned as a [MultiError].
+type Builder[K comparable, T any] struct {
+	graph Graph[K, T]
+	errs  []error
+}
+
+// NewBuilder creates a new builder for a graph with the given hashing function
+// and traits. The accepted arguments are the same as for [New].
+func NewBuilder[K comparable, T any](hash Hash[K, T], options ...func(*Traits)) *Builder[K, T] {
+	return &Builder[K, T]{
+		graph: New(hash, options...),
+	}
+}
+
+// NewBuilderWithStore creates a new builder same as [NewBuilder] but builds the
+// graph using the provided store, as [NewWithStore] does.
+func NewBuilderWithStore[K comparable, T any](hash Hash[K, T], store Store[K, T], options ...func(*Traits)) *Builder[K, T] {
+	return &Builder[K, T]{
+		graph: NewWithStore(hash, store, options...),
+	}
+}
+
+// AddVertex adds the given vertex to the graph. See [graph.Graph.AddVertex].
+func (b *Builder[K, T]) AddVertex(value T, options ...func(*VertexProperties)) *Builder[K, T] {
+	if err := b.graph.AddVertex(value, options...); err != nil {
+		b.errs = append(b.errs, fmt.Errorf("failed to add vertex %v: %w", value, err))
+	}
+
+	return b
+}
+
+// AddEdge adds an edge between the given vertices to the graph. The vertices
+// have to be added before. See [graph.Graph.AddEdge].
+func (b *Builder[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) *Builder[K, T] {
+	if err := b.graph.AddEdge(sourceHash, targetHash, options...); err != nil {
+		b.errs = append(b.errs, fmt.Errorf("failed to add edge (%v, %v): %w", sourceHash, targetHash, err))
+	}
+
+	return b
+}
+
+// Build returns the built graph. If any of the previous calls failed, Build
+// returns a *MultiError containing all errors in the order they occurred.
+func (b *Builder[K, T]) Build() (Graph[K, T], error) {
+	if err := multiError(b.errs); err != nil {
+		return nil, err
+	}
+
+	return b.graph, nil
+}
+
+// FromEdgeList creates a new graph from a list of edges between vertices of
+// type T. Unlike adding each vertex and edge separately, the vertices are
+// created on the fly from the sources and targets of the edges, and the graph
+// is built in a single pass that adds all vertices and edges at once:
+//
+//	g, _ := graph.FromEdgeList(graph.StringHash, []graph.Edge[string]{
+//		{Source: "A", Target: "B", Properties: graph.EdgeProperties{Weight: 3}},
+//		{Source: "B", Target: "C", Properties: graph.EdgeProperties{Weight: 5}},
+//	}, graph.Directed(), graph.Weighted())
+//
+// The accepted traits are the same as for [New], and the store is pre-sized
+// like in [NewWithCapacity]. The properties of each edge, such as its weight,
+// are copied into the graph. Vertices are added in the order they first appear
+// in the list, without any properties. If an edge is contained more than once,
+// the returned error wraps ErrEdgeAlreadyExists.
+func FromEdgeList[K comparable, T any](hash Hash[K, T], edges []Edge[T], options ...func(*Traits)) (Graph[K, T], error) {
+	hashes := make(map[K]struct{}, len(edges))
+	vertices := make([]T, 0, len(edges))
+	hashEdges := make([]Edge[K], 0, len(edges))
+
+	addVertex := func(vertex T) K {
+		h := hash(vertex)
+
+		if _, ok := hashes[h]; !ok {
+			hashes[h] = struct{}{}
+			vertices = append(vertices, vertex)
+		}
+
+		return h
+	}
+
+	for _, edge := range edges {
+		sourceHash, targetHash := addVertex(edge.Source), addVertex(edge.Target)
+
+		hashEdges = append(hashEdges, Edge[K]{
+			Source:     sourceHash,
+			Target:     targetHash,
+			Properties: edge.Properties,
+		})
+	}
+
+	g := NewWithCapacity(hash, len(vertices), len(hashEdges), options...)
+
+	if err := g.AddVertices(vertices); err != nil {
+		return nil, fmt.Errorf("failed to add vertices: %w", err)
+	}
+
+	if err := g.AddEdges(hashEdges); err != nil {
+		return nil, fmt.Errorf("failed to add edges: %w", err)
+	}
+
+	return g, nil
+}
@@ -0,0 +1,228 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	type edge struct {
+		source, target int
+		weight         int
+	}
+
+	tests := map[string]struct {
+		options        []func(*Traits)
+		vertices       []int
+		edges          []edge
+		expectedOrder  int
+		expectedSize   int
+		expectedErrors []error
+	}{
+		"directed graph": {
+			options:  []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []edge{
+				{source: 1, target: 2, weight: 4},
+				{source: 2, target: 3},
+			},
+			expectedOrder: 3,
+			expectedSize:  2,
+		},
+		"undirected graph": {
+			vertices: []int{1, 2, 3},
+			edges: []edge{
+				{source: 1, target: 2},
+				{source: 2, target: 3},
+				{source: 3, target: 1},
+			},
+			expectedOrder: 3,
+			expectedSize:  3,
+		},
+		"multiple errors": {
+			options:  []func(*Traits){Directed()},
+			vertices: []int{1, 2, 2},
+			edges: []edge{
+				{source: 1, target: 2},
+				{source: 1, target: 2},
+				{source: 2, target: 4},
+			},
+			expectedErrors: []error{ErrVertexAlreadyExists, ErrEdgeAlreadyExists, ErrVertexNotFound},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := NewBuilder(IntHash, test.options...)
+
+			for _, vertex := range test.vertices {
+				builder.AddVertex(vertex)
+			}
+
+			for _, e := range test.edges {
+				builder.AddEdge(e.source, e.target, EdgeWeight(e.weight))
+			}
+
+			g, err := builder.Build()
+
+			if len(test.expectedErrors) > 0 {
+				var multiErr *MultiError
+				if !errors.As(err, &multiErr) {
+					t.Fatalf("expected a *MultiError, got %v", err)
+				}
+				if len(multiErr.Errors) != len(test.expectedErrors) {
+					t.Fatalf("expected %d errors, got %d: %v", len(test.expectedErrors), len(multiErr.Errors), err)
+				}
+				for i, expectedErr := range test.expectedErrors {
+					if !errors.Is(multiErr.Errors[i], expectedErr) {
+						t.Errorf("expected error %v at index %d, got %v", expectedErr, i, multiErr.Errors[i])
+					}
+					if !errors.Is(err, expectedErr) {
+						t.Errorf("expected errors.Is to match %v", expectedErr)
+					}
+				}
+				if g != nil {
+					t.Errorf("expected no graph, got %v", g)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			order, _ := g.Order()
+			if order != test.expectedOrder {
+				t.Errorf("expected order %v, got %v", test.expectedOrder, order)
+			}
+
+			size, _ := g.Size()
+			if size != test.expectedSize {
+				t.Errorf("expected size %v, got %v", test.expectedSize, size)
+			}
+
+			for _, e := range test.edges {
+				actual, err := g.Edge(e.source, e.target)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if actual.Properties.Weight != e.weight {
+					t.Errorf("expected weight %v, got %v", e.weight, actual.Properties.Weight)
+				}
+			}
+		})
+	}
+}
+
+func TestBuilder_Chaining(t *testing.T) {
+	g, err := NewBuilder(StringHash, Directed()).
+		AddVertex("A").
+		AddVertex("B", VertexWeight(3)).
+		AddEdge("A", "B", EdgeAttribute("color", "red")).
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, properties, err := g.VertexWithProperties("B")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if properties.Weight != 3 {
+		t.Errorf("expected vertex weight 3, got %v", properties.Weight)
+	}
+
+	edge, err := g.Edge("A", "B")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if edge.Properties.Attributes["color"] != "red" {
+		t.Errorf("expected attribute color=red, got %v", edge.Properties.Attributes)
+	}
+}
+
+func TestFromEdgeList(t *testing.T) {
+	tests := map[string]struct {
+		traits          []func(*Traits)
+		edges           []Edge[string]
+		expectedOrder   int
+		expectedSize    int
+		expectedWeights map[[2]string]int
+		expectedErr     error
+	}{
+		"directed weighted graph": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 5}},
+				{Source: "C", Target: "A", Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedOrder: 3,
+			expectedSize:  3,
+			expectedWeights: map[[2]string]int{
+				{"A", "B"}: 3,
+				{"B", "C"}: 5,
+				{"C", "A"}: 1,
+			},
+		},
+		"undirected graph": {
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "C", Target: "B"},
+				{Source: "D", Target: "A"},
+			},
+			expectedOrder: 4,
+			expectedSize:  3,
+			expectedWeights: map[[2]string]int{
+				{"B", "A"}: 0,
+				{"B", "C"}: 0,
+				{"A", "D"}: 0,
+			},
+		},
+		"empty edge list": {
+			expectedOrder: 0,
+			expectedSize:  0,
+		},
+		"duplicate edge": {
+			traits: []func(*Traits){Directed()},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "B"},
+			},
+			expectedErr: ErrEdgeAlreadyExists,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := FromEdgeList(StringHash, test.edges, test.traits...)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			if order, _ := g.Order(); order != test.expectedOrder {
+				t.Errorf("expected order %d, got %d", test.expectedOrder, order)
+			}
+
+			if size, _ := g.Size(); size != test.expectedSize {
+				t.Errorf("expected size %d, got %d", test.expectedSize, size)
+			}
+
+			for pair, weight := range test.expectedWeights {
+				edge, err := g.Edge(pair[0], pair[1])
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if edge.Properties.Weight != weight {
+					t.Errorf("expected weight %d for edge %v, got %d", weight, pair, edge.Properties.Weight)
+				}
+			}
+		})
+	}
+}
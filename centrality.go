@@ -0,0 +1,334 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrNotConverged is returned by iterative algorithms like EigenvectorCentrality
+// if they haven't converged within the maximum number of iterations.
+var ErrNotConverged = errors.New("iteration did not converge")
+
+// EigenvectorCentrality computes the eigenvector centrality of all vertices. A
+// vertex is central if it is connected to other central vertices, which makes
+// it a measure of influence: Unlike the degree, it takes into account how
+// important the neighbors of a vertex are. The centralities are the entries of
+// the eigenvector for the largest eigenvalue of the adjacency matrix, scaled to
+// a Euclidean norm of 1.
+//
+//	centralities, _ := graph.EigenvectorCentrality(g, 1e-6, 100)
+//
+// The eigenvector is computed using power iteration. On each iteration, the
+// centrality of a vertex becomes the sum of the centralities of its neighbors,
+// plus its own centrality so that the iteration converges on bipartite graphs
+// as well. The iteration stops as soon as the centralities have changed by less
+// than tolerance per vertex on average. If this doesn't happen within the given
+// maximum number of iterations, ErrNotConverged is returned. Each iteration
+// takes O(|V|+|E|) time.
+//
+// In a directed graph, the centrality of a vertex is determined by its ingoing
+// edges. In a weighted graph, the centralities of the neighbors are multiplied
+// by the edge weights, which must not be negative. For a directed acyclic graph,
+// the centralities converge towards the vertices without outgoing edges.
+func EigenvectorCentrality[K comparable, T any](g Graph[K, T], tolerance float64, maxIterations int) (map[K]float64, error) {
+	return EigenvectorCentralityContext(context.Background(), g, tolerance, maxIterations)
+}
+
+// EigenvectorCentralityContext works like [EigenvectorCentrality], but stops
+// the iteration as soon as the given context is cancelled and returns the error
+// of the context. The context is checked once per iteration.
+func EigenvectorCentralityContext[K comparable, T any](ctx context.Context, g Graph[K, T], tolerance float64, maxIterations int) (map[K]float64, error) {
+	hashes, arcs, err := centralityArcs(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(hashes)
+	if n == 0 {
+		return map[K]float64{}, nil
+	}
+
+	centralities := make([]float64, n)
+	next := make([]float64, n)
+
+	for i := range centralities {
+		centralities[i] = 1 / float64(n)
+	}
+
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for vertex := range next {
+			next[vertex] = centralities[vertex]
+
+			for _, arc := range arcs[vertex] {
+				next[vertex] += arc.weight * centralities[arc.vertex]
+			}
+		}
+
+		normalize(next)
+
+		change := 0.0
+		for vertex := range next {
+			change += math.Abs(next[vertex] - centralities[vertex])
+		}
+
+		centralities, next = next, centralities
+
+		if change < float64(n)*tolerance {
+			return centralityMap(hashes, centralities), nil
+		}
+	}
+
+	return nil, ErrNotConverged
+}
+
+// KatzCentrality computes the Katz centrality of all vertices. Like the
+// eigenvector centrality, it measures the influence of a vertex by taking the
+// centralities of its neighbors into account, but each vertex additionally
+// receives a constant bias. Hence, vertices without ingoing edges have a
+// centrality as well, which makes it suitable for directed acyclic graphs,
+// where the eigenvector centrality of most vertices is 0.
+//
+//	centralities, _ := graph.KatzCentrality(g, 0.1, 1, 1e-6, 100)
+//
+// The centrality of a vertex is the bias beta plus the sum of the centralities of
+// its neighbors, attenuated by the factor alpha. This is equivalent to counting
+// all walks that end at a vertex, where a walk of length k is weighted with
+// alpha^k. The returned centralities are scaled to a Euclidean norm of 1.
+//
+// The centralities are computed iteratively until they have changed by less than
+// tolerance per vertex on average. This only converges if alpha is smaller than
+// the reciprocal of the largest eigenvalue of the adjacency matrix, which is
+// always the case for directed acyclic graphs. Otherwise, or if the iteration
+// doesn't converge within the given maximum number of iterations,
+// ErrNotConverged is returned. Directed and weighted graphs are handled like
+// in [EigenvectorCentrality].
+func KatzCentrality[K comparable, T any](g Graph[K, T], alpha, beta, tolerance float64, maxIterations int) (map[K]float64, error) {
+	return KatzCentralityContext(context.Background(), g, alpha, beta, tolerance, maxIterations)
+}
+
+// KatzCentralityContext works like [KatzCentrality], but stops the iteration
+// as soon as the given context is cancelled and returns the error of the
+// context. The context is checked once per iteration.
+func KatzCentralityContext[K comparable, T any](ctx context.Context, g Graph[K, T], alpha, beta, tolerance float64, maxIterations int) (map[K]float64, error) {
+	hashes, arcs, err := centralityArcs(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(hashes)
+	if n == 0 {
+		return map[K]float64{}, nil
+	}
+
+	centralities := make([]float64, n)
+	next := make([]float64, n)
+
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		change := 0.0
+
+		for vertex := range next {
+			next[vertex] = beta
+
+			for _, arc := range arcs[vertex] {
+				next[vertex] += alpha * arc.weight * centralities[arc.vertex]
+			}
+
+			change += math.Abs(next[vertex] - centralities[vertex])
+		}
+
+		centralities, next = next, centralities
+
+		if change < float64(n)*tolerance {
+			normalize(centralities)
+
+			return centralityMap(hashes, centralities), nil
+		}
+	}
+
+	return nil, ErrNotConverged
+}
+
+// HITS computes the hub and authority scores of all vertices in a directed graph
+// using Kleinberg's Hyperlink-Induced Topic Search algorithm. A good authority
+// is a vertex that many good hubs point to, and a good hub is a vertex that
+// points to many good authorities. In a link graph, authorities typically are
+// pages with relevant content, while hubs are pages that link to them:
+//
+//	hubs, authorities, _ := graph.HITS(g, 1e-6, 100)
+//
+// On each iteration, the authority score of a vertex becomes the sum of the hub
+// scores of its predecessors, and the hub score becomes the sum of the new
+// authority scores of its successors. Both scores are then scaled to a
+// Euclidean norm of 1. The iteration stops as soon as the scores have changed
+// by less than tolerance per vertex on average. If this doesn't happen within
+// the given maximum number of iterations, ErrNotConverged is returned. In a
+// weighted graph, the scores are multiplied by the edge weights, which must not
+// be negative.
+//
+// HITS can only be computed on directed graphs.
+func HITS[K comparable, T any](g Graph[K, T], tolerance float64, maxIterations int) (map[K]float64, map[K]float64, error) {
+	return HITSContext(context.Background(), g, tolerance, maxIterations)
+}
+
+// HITSContext works like [HITS], but stops the iteration as soon as the given
+// context is cancelled and returns the error of the context. The context is
+// checked once per iteration.
+func HITSContext[K comparable, T any](ctx context.Context, g Graph[K, T], tolerance float64, maxIterations int) (map[K]float64, map[K]float64, error) {
+	if !g.Traits().IsDirected {
+		return nil, nil, errors.New("HITS cannot be computed on undirected graph")
+	}
+
+	hashes, inArcs, err := centralityArcs(g)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := len(hashes)
+	if n == 0 {
+		return map[K]float64{}, map[K]float64{}, nil
+	}
+
+	outArcs := make([][]centralityArc, n)
+
+	for target, arcs := range inArcs {
+		for _, arc := range arcs {
+			outArcs[arc.vertex] = append(outArcs[arc.vertex], centralityArc{vertex: target, weight: arc.weight})
+		}
+	}
+
+	hubs := make([]float64, n)
+	authorities := make([]float64, n)
+	nextHubs := make([]float64, n)
+
+	for i := range hubs {
+		hubs[i] = 1 / math.Sqrt(float64(n))
+	}
+
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		for vertex := range authorities {
+			authorities[vertex] = 0
+
+			for _, arc := range inArcs[vertex] {
+				authorities[vertex] += arc.weight * hubs[arc.vertex]
+			}
+		}
+
+		normalize(authorities)
+
+		for vertex := range nextHubs {
+			nextHubs[vertex] = 0
+
+			for _, arc := range outArcs[vertex] {
+				nextHubs[vertex] += arc.weight * authorities[arc.vertex]
+			}
+		}
+
+		normalize(nextHubs)
+
+		change := 0.0
+		for vertex := range hubs {
+			change += math.Abs(nextHubs[vertex] - hubs[vertex])
+		}
+
+		hubs, nextHubs = nextHubs, hubs
+
+		if change < float64(n)*tolerance {
+			return centralityMap(hashes, hubs), centralityMap(hashes, authorities), nil
+		}
+	}
+
+	return nil, nil, ErrNotConverged
+}
+
+// normalize scales the given vector to a Euclidean norm of 1, unless all of its
+// entries are 0.
+func normalize(vector []float64) {
+	norm := 0.0
+	for _, value := range vector {
+		norm += value * value
+	}
+
+	if norm == 0 {
+		return
+	}
+
+	norm = math.Sqrt(norm)
+
+	for i := range vector {
+		vector[i] /= norm
+	}
+}
+
+// centralityArc is an ingoing edge from the given vertex, which is an index into
+// the hashes returned by centralityArcs.
+type centralityArc struct {
+	vertex int
+	weight float64
+}
+
+// centralityArcs returns the hashes of all vertices and the ingoing arcs of
+// each vertex, with a weight of 1 for each edge in an unweighted graph. In an
+// undirected graph, each edge yields an arc in both directions. Negative edge
+// weights are rejected with an error.
+func centralityArcs[K comparable, T any](g Graph[K, T]) ([]K, [][]centralityArc, error) {
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	indices := make(map[K]int, len(predecessorMap))
+	hashes := make([]K, 0, len(predecessorMap))
+
+	for hash := range predecessorMap {
+		indices[hash] = len(hashes)
+		hashes = append(hashes, hash)
+	}
+
+	isWeighted := g.Traits().IsWeighted
+	arcs := make([][]centralityArc, len(hashes))
+
+	for target, predecessors := range predecessorMap {
+		for source, edge := range predecessors {
+			weight := 1.0
+
+			if isWeighted {
+				if edge.Properties.Weight < 0 {
+					return nil, nil, fmt.Errorf("edge (%v, %v) has a negative weight", source, target)
+				}
+				weight = float64(edge.Properties.Weight)
+			}
+
+			arcs[indices[target]] = append(arcs[indices[target]], centralityArc{
+				vertex: indices[source],
+				weight: weight,
+			})
+		}
+	}
+
+	return hashes, arcs, nil
+}
+
+// centralityMap maps the hash of each vertex to its centrality.
+func centralityMap[K comparable](hashes []K, centralities []float64) map[K]float64 {
+	m := make(map[K]float64, len(hashes))
+
+	for i, hash := range hashes {
+		m[hash] = centralities[i]
+	}
+
+	return m
+}
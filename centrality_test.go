@@ -0,0 +1,327 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestEigenvectorCentrality(t *testing.T) {
+	tests := map[string]struct {
+		traits     []func(*Traits)
+		vertices   []int
+		edges      []Edge[int]
+		expected   map[int]float64
+		shouldFail bool
+	}{
+		"star": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expected: map[int]float64{
+				1: 1 / math.Sqrt(2),
+				2: 1 / math.Sqrt(6),
+				3: 1 / math.Sqrt(6),
+				4: 1 / math.Sqrt(6),
+			},
+		},
+		"directed cycle": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			expected: map[int]float64{1: 0.5, 2: 0.5, 3: 0.5, 4: 0.5},
+		},
+		"weighted graph": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+			},
+			expected: map[int]float64{1: 1 / math.Sqrt(2), 2: 1 / math.Sqrt(2)},
+		},
+		"empty graph": {
+			expected: map[int]float64{},
+		},
+		"negative weight": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -1}},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			centralities, err := EigenvectorCentrality(g, 1e-12, 1000)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			assertCentralities(t, test.expected, centralities)
+		})
+	}
+}
+
+func TestEigenvectorCentrality_notConverged(t *testing.T) {
+	g := New(IntHash)
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2)
+	_ = g.AddEdge(2, 3)
+
+	if _, err := EigenvectorCentrality(g, 1e-12, 1); !errors.Is(err, ErrNotConverged) {
+		t.Errorf("expected error %v, got %v", ErrNotConverged, err)
+	}
+}
+
+func TestKatzCentrality(t *testing.T) {
+	norm := math.Sqrt(1 + 1.5*1.5 + 1.75*1.75)
+
+	tests := map[string]struct {
+		traits     []func(*Traits)
+		vertices   []int
+		edges      []Edge[int]
+		alpha      float64
+		expected   map[int]float64
+		shouldFail bool
+	}{
+		"directed path": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			alpha:    0.5,
+			expected: map[int]float64{1: 1 / norm, 2: 1.5 / norm, 3: 1.75 / norm},
+		},
+		"undirected regular graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			alpha: 0.1,
+			expected: map[int]float64{
+				1: 1 / math.Sqrt(3),
+				2: 1 / math.Sqrt(3),
+				3: 1 / math.Sqrt(3),
+			},
+		},
+		"empty graph": {
+			alpha:    0.1,
+			expected: map[int]float64{},
+		},
+		"alpha too large": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			alpha:      2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			centralities, err := KatzCentrality(g, test.alpha, 1, 1e-12, 1000)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail && !errors.Is(err, ErrNotConverged) {
+				t.Errorf("expected error %v, got %v", ErrNotConverged, err)
+			}
+
+			assertCentralities(t, test.expected, centralities)
+		})
+	}
+}
+
+func TestHITS(t *testing.T) {
+	tests := map[string]struct {
+		traits              []func(*Traits)
+		vertices            []int
+		edges               []Edge[int]
+		expectedHubs        map[int]float64
+		expectedAuthorities map[int]float64
+		shouldFail          bool
+	}{
+		"star": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expectedHubs: map[int]float64{1: 1, 2: 0, 3: 0, 4: 0},
+			expectedAuthorities: map[int]float64{
+				1: 0,
+				2: 1 / math.Sqrt(3),
+				3: 1 / math.Sqrt(3),
+				4: 1 / math.Sqrt(3),
+			},
+		},
+		"two hubs": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 4},
+			},
+			// The hub scores are the principal eigenvector of [[2, 1], [1, 1]],
+			// and the authority scores follow from the hub scores.
+			expectedHubs: map[int]float64{
+				1: math.Sqrt((5 + math.Sqrt(5)) / 10),
+				2: math.Sqrt((5 - math.Sqrt(5)) / 10),
+				3: 0,
+				4: 0,
+			},
+			expectedAuthorities: map[int]float64{
+				1: 0,
+				2: 0,
+				3: math.Sqrt((5 - math.Sqrt(5)) / 10),
+				4: math.Sqrt((5 + math.Sqrt(5)) / 10),
+			},
+		},
+		"no edges": {
+			traits:              []func(*Traits){Directed()},
+			vertices:            []int{1, 2},
+			expectedHubs:        map[int]float64{1: 0, 2: 0},
+			expectedAuthorities: map[int]float64{1: 0, 2: 0},
+		},
+		"undirected graph": {
+			vertices:   []int{1, 2},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			hubs, authorities, err := HITS(g, 1e-12, 1000)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			assertCentralities(t, test.expectedHubs, hubs)
+			assertCentralities(t, test.expectedAuthorities, authorities)
+		})
+	}
+}
+
+func assertCentralities(t *testing.T, expected, actual map[int]float64) {
+	t.Helper()
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected centralities %v, got %v", expected, actual)
+	}
+
+	for vertex, centrality := range expected {
+		if math.Abs(actual[vertex]-centrality) > 1e-6 {
+			t.Errorf("expected centrality %v for vertex %d, got %v", centrality, vertex, actual[vertex])
+		}
+	}
+}
+
+func TestCentralityContext(t *testing.T) {
+	tests := map[string]struct {
+		centrality func(ctx context.Context, g Graph[int, int]) error
+	}{
+		"EigenvectorCentralityContext": {
+			centrality: func(ctx context.Context, g Graph[int, int]) error {
+				_, err := EigenvectorCentralityContext(ctx, g, 1e-9, 1000)
+				return err
+			},
+		},
+		"KatzCentralityContext": {
+			centrality: func(ctx context.Context, g Graph[int, int]) error {
+				_, err := KatzCentralityContext(ctx, g, 0.1, 1, 1e-9, 1000)
+				return err
+			},
+		},
+		"HITSContext": {
+			centrality: func(ctx context.Context, g Graph[int, int]) error {
+				_, _, err := HITSContext(ctx, g, 1e-9, 1000)
+				return err
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for _, vertex := range []int{1, 2, 3} {
+				_ = g.AddVertex(vertex)
+			}
+
+			_ = g.AddEdge(1, 2)
+			_ = g.AddEdge(2, 3)
+			_ = g.AddEdge(3, 1)
+			_ = g.AddEdge(1, 3)
+
+			if err := test.centrality(context.Background(), g); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			if err := test.centrality(ctx, g); !errors.Is(err, context.Canceled) {
+				t.Errorf("expected error %v, got %v", context.Canceled, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaximalCliques enumerates all maximal cliques of the given graph. A clique is
+// a set of vertices that are pairwise adjacent, and it is maximal if no other
+// vertex can be added to it. Each clique is passed to the visit function as
+// soon as it has been found. If visit returns true, the enumeration stops,
+// which can be used to limit the number of cliques:
+//
+//	// Collect the first 10 cliques with at least 3 vertices.
+//	cliques := make([][]string, 0)
+//
+//	_ = graph.MaximalCliques(g, func(clique []string) bool {
+//		if len(clique) >= 3 {
+//			cliques = append(cliques, clique)
+//		}
+//		return len(cliques) == 10
+//	})
+//
+// The direction of the edges is ignored, and self-loops are ignored as well.
+// Each clique is passed to visit as a new slice that may be retained. Isolated
+// vertices form a clique on their own.
+//
+// MaximalCliques uses the Bron–Kerbosch algorithm with pivoting as described
+// by Tomita et al., which takes O(3^(|V|/3)) time in the worst case. This is
+// optimal because a graph may have that many maximal cliques.
+func MaximalCliques[K comparable, T any](g Graph[K, T], visit func(clique []K) bool) error {
+	return MaximalCliquesContext(context.Background(), g, visit)
+}
+
+// MaximalCliquesContext works like [MaximalCliques], but stops the enumeration
+// as soon as the given context is cancelled and returns the error of the
+// context. The cliques that have been passed to visit until then remain valid.
+func MaximalCliquesContext[K comparable, T any](ctx context.Context, g Graph[K, T], visit func(clique []K) bool) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	hashes, neighbors := undirectedNeighbors(adjacencyMap)
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	candidates := make([]int, len(hashes))
+	for i := range candidates {
+		candidates[i] = i
+	}
+
+	e := cliqueEnumeration[K]{
+		hashes:    hashes,
+		neighbors: neighbors,
+		visit:     visit,
+		cancel:    cancellation{ctx: ctx},
+	}
+
+	e.expand(nil, candidates, nil)
+
+	return e.err
+}
+
+// cliqueEnumeration holds the state of the Bron–Kerbosch algorithm. Vertices
+// are represented by their indices, and all vertex sets are sorted slices.
+type cliqueEnumeration[K comparable] struct {
+	hashes    []K
+	neighbors [][]int
+	visit     func(clique []K) bool
+	cancel    cancellation
+	err       error
+}
+
+// expand reports all maximal cliques that contain the given clique, some of
+// the candidates, and none of the excluded vertices. It returns true if the
+// enumeration has been stopped, either by visit or because the context has
+// been cancelled, in which case the error of the context is stored.
+func (e *cliqueEnumeration[K]) expand(clique, candidates, excluded []int) bool {
+	if e.err = e.cancel.check(); e.err != nil {
+		return true
+	}
+
+	if len(candidates) == 0 {
+		if len(excluded) > 0 {
+			return false
+		}
+
+		hashes := make([]K, len(clique))
+		for i, vertex := range clique {
+			hashes[i] = e.hashes[vertex]
+		}
+
+		return e.visit(hashes)
+	}
+
+	// Choose the vertex with the most neighbors among the candidates as the
+	// pivot. Each maximal clique contains either the pivot or a non-neighbor
+	// of it, so only the non-neighbors need to be tried.
+	pivot, maxNeighbors := -1, -1
+
+	for _, vertices := range [][]int{candidates, excluded} {
+		for _, vertex := range vertices {
+			if n := len(sortedIntersection(candidates, e.neighbors[vertex])); n > maxNeighbors {
+				pivot, maxNeighbors = vertex, n
+			}
+		}
+	}
+
+	for _, vertex := range sortedDifference(candidates, e.neighbors[pivot]) {
+		newClique := append(clique[:len(clique):len(clique)], vertex)
+
+		stopped := e.expand(
+			newClique,
+			sortedIntersection(candidates, e.neighbors[vertex]),
+			sortedIntersection(excluded, e.neighbors[vertex]),
+		)
+		if stopped {
+			return true
+		}
+
+		candidates = sortedDifference(candidates, []int{vertex})
+		excluded = sortedUnion(excluded, []int{vertex})
+	}
+
+	return false
+}
+
+// sortedIntersection returns the elements contained in both sorted slices.
+func sortedIntersection(a, b []int) []int {
+	result := make([]int, 0)
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+
+	return result
+}
+
+// sortedDifference returns the elements of the sorted slice a that aren't
+// contained in the sorted slice b.
+func sortedDifference(a, b []int) []int {
+	result := make([]int, 0, len(a))
+
+	j := 0
+
+	for _, value := range a {
+		for j < len(b) && b[j] < value {
+			j++
+		}
+		if j < len(b) && b[j] == value {
+			continue
+		}
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// sortedUnion returns the elements contained in either of the sorted slices.
+func sortedUnion(a, b []int) []int {
+	result := make([]int, 0, len(a)+len(b))
+
+	i, j := 0, 0
+
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			result = append(result, a[i])
+			i++
+		case a[i] > b[j]:
+			result = append(result, b[j])
+			j++
+		default:
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+
+	result = append(result, a[i:]...)
+	result = append(result, b[j:]...)
+
+	return result
+}
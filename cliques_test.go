@@ -0,0 +1,234 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestMaximalCliques(t *testing.T) {
+	tests := map[string]struct {
+		isDirected      bool
+		vertices        []int
+		edges           []Edge[int]
+		expectedCliques [][]int
+	}{
+		"empty graph": {
+			expectedCliques: [][]int{},
+		},
+		"clique and triangle sharing a vertex": {
+			vertices: []int{1, 2, 3, 4, 5, 6, 7},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 4},
+				{Source: 7, Target: 7},
+			},
+			expectedCliques: [][]int{{1, 2, 3, 4}, {4, 5, 6}, {7}},
+		},
+		"directed graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 3},
+			},
+			expectedCliques: [][]int{{1, 2, 3}, {3, 4}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target)
+			}
+
+			cliques := make([][]int, 0)
+
+			err := MaximalCliques(g, func(clique []int) bool {
+				cliques = append(cliques, clique)
+				return false
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !cliquesAreEqual(cliques, test.expectedCliques) {
+				t.Errorf("expected cliques %v, got %v", test.expectedCliques, cliques)
+			}
+		})
+	}
+}
+
+func TestMaximalCliques_stop(t *testing.T) {
+	g := New(IntHash)
+
+	// A graph without edges has one clique per vertex.
+	for vertex := 1; vertex <= 5; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	count := 0
+
+	_ = MaximalCliques(g, func([]int) bool {
+		count++
+		return count == 2
+	})
+
+	if count != 2 {
+		t.Errorf("expected enumeration to stop after 2 cliques, got %d", count)
+	}
+}
+
+func TestMaximalCliquesContext(t *testing.T) {
+	g := New(IntHash)
+
+	for vertex := 1; vertex <= 5; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	count := 0
+
+	err := MaximalCliquesContext(ctx, g, func([]int) bool {
+		count++
+		return false
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error %v, got %v", context.Canceled, err)
+	}
+
+	if count != 0 {
+		t.Errorf("expected no cliques for cancelled context, got %d", count)
+	}
+
+	if err := MaximalCliquesContext(context.Background(), g, func([]int) bool { return false }); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestMaximalCliques_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	g := New(IntHash)
+
+	n := 12
+
+	for vertex := 0; vertex < n; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	for i := 0; i < 40; i++ {
+		_ = g.AddEdge(random.Intn(n), random.Intn(n))
+	}
+
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	isClique := func(subset int) bool {
+		for a := 0; a < n; a++ {
+			for b := a + 1; b < n; b++ {
+				if subset&(1<<a) == 0 || subset&(1<<b) == 0 {
+					continue
+				}
+				if _, ok := adjacencyMap[a][b]; !ok {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	expected := make([][]int, 0)
+
+	for subset := 1; subset < 1<<n; subset++ {
+		if !isClique(subset) {
+			continue
+		}
+
+		isMaximal := true
+		for vertex := 0; vertex < n; vertex++ {
+			if subset&(1<<vertex) == 0 && isClique(subset|1<<vertex) {
+				isMaximal = false
+				break
+			}
+		}
+
+		if !isMaximal {
+			continue
+		}
+
+		clique := make([]int, 0)
+		for vertex := 0; vertex < n; vertex++ {
+			if subset&(1<<vertex) != 0 {
+				clique = append(clique, vertex)
+			}
+		}
+		expected = append(expected, clique)
+	}
+
+	cliques := make([][]int, 0)
+
+	_ = MaximalCliques(g, func(clique []int) bool {
+		cliques = append(cliques, clique)
+		return false
+	})
+
+	if !cliquesAreEqual(cliques, expected) {
+		t.Errorf("expected cliques %v, got %v", expected, cliques)
+	}
+}
+
+// cliquesAreEqual checks whether both slices contain the same cliques,
+// regardless of the order of the cliques and of the vertices in each clique.
+func cliquesAreEqual(a, b [][]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	keys := make(map[string]int)
+
+	key := func(clique []int) string {
+		sorted := append([]int(nil), clique...)
+		sort.Ints(sorted)
+		return fmt.Sprint(sorted)
+	}
+
+	for _, clique := range a {
+		keys[key(clique)]++
+	}
+
+	for _, clique := range b {
+		keys[key(clique)]--
+	}
+
+	for _, count := range keys {
+		if count != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,235 @@
+package graph
+
+import "fmt"
+
+// Coarsening is a hierarchy of successively smaller graphs created by Coarsen.
+// Each vertex of a coarse graph stands for a group of vertices of the original
+// graph, which have been merged into a single vertex.
+//
+// The coarse graphs are undirected and weighted. Their vertices are numbered
+// from 0 to n-1, and the weight of each vertex is the number of original
+// vertices it stands for. The weight of an edge between two coarse vertices is
+// the total weight of the original edges between their groups, where each edge
+// of an unweighted graph has a weight of 1. Edges within a group are dropped.
+type Coarsening[K comparable] struct {
+	// Graphs contains the coarse graphs, from the finest to the coarsest one.
+	Graphs []Graph[int, int]
+	// Projections contains a projection for each coarse graph. The projection
+	// of the first graph maps the index of each original vertex, as returned
+	// by Vertices, to its vertex in the first graph. The projection of each
+	// following graph maps the vertices of the previous graph to its vertices.
+	Projections [][]int
+
+	hashes []K
+}
+
+// Vertices returns the hashes of the original vertices, where the index of each
+// vertex is the index used by the projection of the first coarse graph.
+func (c *Coarsening[K]) Vertices() []K {
+	hashes := make([]K, len(c.hashes))
+	copy(hashes, c.hashes)
+	return hashes
+}
+
+// Project maps each original vertex to the vertex of the coarse graph with the
+// given level that it has been merged into, where level 0 is the finest coarse
+// graph. This is useful for transferring a result computed on a coarse graph,
+// like a partition or a layout, back to the original graph.
+func (c *Coarsening[K]) Project(level int) (map[K]int, error) {
+	if level < 0 || level >= len(c.Graphs) {
+		return nil, fmt.Errorf("level must be in [0, %d), got %d", len(c.Graphs), level)
+	}
+
+	m := make(map[K]int, len(c.hashes))
+
+	for i, hash := range c.hashes {
+		vertex := i
+		for l := 0; l <= level; l++ {
+			vertex = c.Projections[l][vertex]
+		}
+		m[hash] = vertex
+	}
+
+	return m, nil
+}
+
+// Coarsen repeatedly merges pairs of adjacent vertices of the given graph until
+// the graph has at most minVertices vertices, or until no more vertices can be
+// merged. This yields a hierarchy of smaller graphs that preserve the overall
+// structure of the original graph, as used by multilevel algorithms like graph
+// partitioners and layout engines: They solve the problem on the coarsest graph
+// and then refine the solution while going back to the finer graphs.
+//
+//	coarsening, _ := graph.Coarsen(g, 100)
+//
+//	coarsest := coarsening.Graphs[len(coarsening.Graphs)-1]
+//	groups, _ := coarsening.Project(len(coarsening.Graphs) - 1)
+//
+// In each step, the vertices are merged along a heavy-edge matching: Each vertex
+// that hasn't been matched yet is matched with the unmatched neighbor it shares
+// the heaviest edge with, so that heavy edges end up within the merged vertices.
+// Vertices without unmatched neighbors stay on their own. Hence, the number of
+// vertices roughly halves in each step, and each step takes O(|V|+|E|) time.
+//
+// The direction of the edges is ignored. If the graph has at most minVertices
+// vertices, or if none of its vertices are adjacent, the returned coarsening
+// doesn't contain any graphs. See [Coarsening] for the structure of the coarse
+// graphs.
+func Coarsen[K comparable, T any](g Graph[K, T], minVertices int) (*Coarsening[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	indices := make(map[K]int, len(adjacencyMap))
+	hashes := make([]K, 0, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		indices[hash] = len(hashes)
+		hashes = append(hashes, hash)
+	}
+
+	isDirected := g.Traits().IsDirected
+	isWeighted := g.Traits().IsWeighted
+
+	// neighbors contains the total weight of the edges between each pair of
+	// adjacent vertices, regardless of their direction.
+	neighbors := make([]map[int]int, len(hashes))
+	sizes := make([]int, len(hashes))
+
+	for i := range neighbors {
+		neighbors[i] = make(map[int]int)
+		sizes[i] = 1
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if source == target {
+				continue
+			}
+
+			weight := 1
+			if isWeighted {
+				weight = edge.Properties.Weight
+			}
+
+			s, t := indices[source], indices[target]
+
+			neighbors[s][t] += weight
+			if isDirected {
+				neighbors[t][s] += weight
+			}
+		}
+	}
+
+	coarsening := &Coarsening[K]{
+		Graphs:      make([]Graph[int, int], 0),
+		Projections: make([][]int, 0),
+		hashes:      hashes,
+	}
+
+	for len(neighbors) > minVertices {
+		projection, n := heavyEdgeMatching(neighbors)
+		if n == len(neighbors) {
+			break
+		}
+
+		coarseNeighbors := make([]map[int]int, n)
+		coarseSizes := make([]int, n)
+
+		for i := range coarseNeighbors {
+			coarseNeighbors[i] = make(map[int]int)
+		}
+
+		for vertex, adjacencies := range neighbors {
+			coarse := projection[vertex]
+			coarseSizes[coarse] += sizes[vertex]
+
+			for neighbor, weight := range adjacencies {
+				if other := projection[neighbor]; other != coarse {
+					coarseNeighbors[coarse][other] += weight
+				}
+			}
+		}
+
+		coarse, err := coarseGraph(coarseNeighbors, coarseSizes)
+		if err != nil {
+			return nil, err
+		}
+
+		coarsening.Graphs = append(coarsening.Graphs, coarse)
+		coarsening.Projections = append(coarsening.Projections, projection)
+
+		neighbors, sizes = coarseNeighbors, coarseSizes
+	}
+
+	return coarsening, nil
+}
+
+// heavyEdgeMatching matches each vertex with the unmatched neighbor it shares
+// the heaviest edge with. It returns the coarse vertex of each vertex, where
+// matched vertices have the same coarse vertex, and the number of coarse
+// vertices.
+func heavyEdgeMatching(neighbors []map[int]int) ([]int, int) {
+	projection := make([]int, len(neighbors))
+	for i := range projection {
+		projection[i] = -1
+	}
+
+	n := 0
+
+	for vertex, adjacencies := range neighbors {
+		if projection[vertex] != -1 {
+			continue
+		}
+
+		match, heaviest := -1, 0
+
+		for neighbor, weight := range adjacencies {
+			if projection[neighbor] != -1 {
+				continue
+			}
+
+			// Ties are broken using the smaller index, so that the matching
+			// doesn't depend on the iteration order of the map.
+			if match == -1 || weight > heaviest || weight == heaviest && neighbor < match {
+				match, heaviest = neighbor, weight
+			}
+		}
+
+		projection[vertex] = n
+		if match != -1 {
+			projection[match] = n
+		}
+
+		n++
+	}
+
+	return projection, n
+}
+
+// coarseGraph creates an undirected, weighted graph with the given adjacencies
+// and the given sizes as vertex weights.
+func coarseGraph(neighbors []map[int]int, sizes []int) (Graph[int, int], error) {
+	g := New(IntHash, Weighted())
+
+	for vertex, size := range sizes {
+		if err := g.AddVertex(vertex, VertexWeight(size)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", vertex, err)
+		}
+	}
+
+	for vertex, adjacencies := range neighbors {
+		for neighbor, weight := range adjacencies {
+			if neighbor < vertex {
+				continue
+			}
+
+			if err := g.AddEdge(vertex, neighbor, EdgeWeight(weight)); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", vertex, neighbor, err)
+			}
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,166 @@
+package graph
+
+import "testing"
+
+func TestCoarsen(t *testing.T) {
+	tests := map[string]struct {
+		traits         []func(*Traits)
+		vertices       []int
+		edges          []Edge[int]
+		minVertices    int
+		expectedOrders []int
+	}{
+		"path": {
+			vertices: []int{1, 2, 3, 4, 5, 6, 7, 8},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 7},
+				{Source: 7, Target: 8},
+			},
+			minVertices: 1,
+		},
+		"directed graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+				{Source: 4, Target: 3},
+			},
+			minVertices: 2,
+		},
+		"small graph": {
+			vertices:       []int{1, 2},
+			edges:          []Edge[int]{{Source: 1, Target: 2}},
+			minVertices:    2,
+			expectedOrders: []int{},
+		},
+		"no edges": {
+			vertices:       []int{1, 2, 3},
+			minVertices:    1,
+			expectedOrders: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			coarsening, err := Coarsen(g, test.minVertices)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if test.expectedOrders != nil && len(coarsening.Graphs) != len(test.expectedOrders) {
+				t.Fatalf("expected %d coarse graphs, got %d", len(test.expectedOrders), len(coarsening.Graphs))
+			}
+
+			previous := len(test.vertices)
+
+			for level, coarse := range coarsening.Graphs {
+				order, _ := coarse.Order()
+
+				if order >= previous || 2*order < previous {
+					t.Errorf("expected level %d to have between %d and %d vertices, got %d", level, (previous+1)/2, previous-1, order)
+				}
+
+				previous = order
+
+				assertCoarseWeights(t, coarsening, level, len(test.vertices))
+			}
+		})
+	}
+}
+
+func TestCoarsen_heavyEdges(t *testing.T) {
+	g := New(StringHash, Weighted())
+
+	for _, vertex := range []string{"A", "B", "C", "D"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	// A path whose inner edge is light, so the heavy edges are merged.
+	_ = g.AddEdge("A", "B", EdgeWeight(10))
+	_ = g.AddEdge("B", "C", EdgeWeight(1))
+	_ = g.AddEdge("C", "D", EdgeWeight(10))
+
+	coarsening, err := Coarsen(g, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(coarsening.Graphs) != 1 {
+		t.Fatalf("expected 1 coarse graph, got %d", len(coarsening.Graphs))
+	}
+
+	groups, _ := coarsening.Project(0)
+
+	if groups["A"] != groups["B"] || groups["C"] != groups["D"] || groups["A"] == groups["C"] {
+		t.Fatalf("expected groups {A, B} and {C, D}, got %v", groups)
+	}
+
+	edge, err := coarsening.Graphs[0].Edge(groups["A"], groups["C"])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if edge.Properties.Weight != 1 {
+		t.Errorf("expected coarse edge weight 1, got %d", edge.Properties.Weight)
+	}
+
+	if _, err := coarsening.Project(1); err == nil {
+		t.Error("expected error for level 1")
+	}
+}
+
+// assertCoarseWeights checks that the weight of each vertex of the coarse graph
+// with the given level equals the number of original vertices projected onto it.
+func assertCoarseWeights(t *testing.T, coarsening *Coarsening[int], level, n int) {
+	t.Helper()
+
+	groups, err := coarsening.Project(level)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(groups) != n {
+		t.Fatalf("expected %d projected vertices, got %d", n, len(groups))
+	}
+
+	counts := make(map[int]int)
+	for _, vertex := range groups {
+		counts[vertex]++
+	}
+
+	total := 0
+	order, _ := coarsening.Graphs[level].Order()
+
+	for vertex := 0; vertex < order; vertex++ {
+		_, properties, err := coarsening.Graphs[level].VertexWithProperties(vertex)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if properties.Weight != counts[vertex] {
+			t.Errorf("expected weight %d for vertex %d at level %d, got %d", counts[vertex], vertex, level, properties.Weight)
+		}
+
+		total += properties.Weight
+	}
+
+	if total != n {
+		t.Errorf("expected total weight %d at level %d, got %d", n, level, total)
+	}
+}
@@ -3,6 +3,7 @@ package graph
 import (
 	"container/heap"
 	"errors"
+	"math"
 )
 
 // priorityQueue implements a minimum priority queue using a minimum binary heap
@@ -63,6 +64,16 @@ func (p *priorityQueue[T]) Pop() (T, error) {
 	return item.value, nil
 }
 
+// MinPriority returns the lowest priority in the queue without removing the
+// corresponding item. If the queue is empty, it returns positive infinity.
+func (p *priorityQueue[T]) MinPriority() float64 {
+	if len(*p.items) == 0 {
+		return math.Inf(1)
+	}
+
+	return (*p.items)[0].priority
+}
+
 // UpdatePriority updates the priority of a given item and sets it to the given
 // priority. If the item doesn't exist, nothing happens. This operation may
 // cause a re-balance of the heap and this scales with O(log n).
@@ -195,3 +206,25 @@ func (s *stackOfStacks[T]) top() (*stack[T], error) {
 func (s *stackOfStacks[T]) isEmpty() bool {
 	return len(s.stacks) == 0
 }
+
+// bitset is a fixed-size set of non-negative integers backed by a slice of
+// 64-bit words, which makes membership tests cheap and branch-free.
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i>>6] |= 1 << (uint(i) & 63)
+}
+
+func (b bitset) has(i int) bool {
+	return b[i>>6]&(1<<(uint(i)&63)) != 0
+}
+
+func (b bitset) reset() {
+	for i := range b {
+		b[i] = 0
+	}
+}
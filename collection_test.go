@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"math"
 	"reflect"
 	"testing"
 )
@@ -186,6 +187,44 @@ func TestPriorityQueue_UpdatePriority(t *testing.T) {
 	}
 }
 
+func TestPriorityQueue_MinPriority(t *testing.T) {
+	tests := map[string]struct {
+		items            []int
+		priorities       []float64
+		expectedPriority float64
+	}{
+		"queue with 5 item": {
+			items:            []int{10, 20, 30, 40, 50},
+			priorities:       []float64{6, 8, 2, 7, 5},
+			expectedPriority: 2,
+		},
+		"queue with 1 item": {
+			items:            []int{10},
+			priorities:       []float64{6},
+			expectedPriority: 6,
+		},
+		"empty queue": {
+			items:            []int{},
+			priorities:       []float64{},
+			expectedPriority: math.Inf(1),
+		},
+	}
+
+	for name, test := range tests {
+		queue := newPriorityQueue[int]()
+
+		for i, item := range test.items {
+			queue.Push(item, test.priorities[i])
+		}
+
+		priority := queue.MinPriority()
+
+		if priority != test.expectedPriority {
+			t.Errorf("%s: priority expectancy doesn't match: expected %v, got %v", name, test.expectedPriority, priority)
+		}
+	}
+}
+
 func TestPriorityQueue_Len(t *testing.T) {
 	tests := map[string]struct {
 		items       []int
@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Modularity computes the modularity of the given partition of the vertices into
+// communities, which maps each vertex to the ID of its community. The modularity
+// measures how much denser the edges within the communities are than they would
+// be by chance, which makes it possible to evaluate and compare clusterings:
+//
+//	q, _ := graph.Modularity(g, map[string]int{
+//		"A": 0, "B": 0, "C": 0,
+//		"D": 1, "E": 1, "F": 1,
+//	})
+//
+// The modularity ranges from -1/2 to 1. A value of 0 means that the number of
+// edges within the communities is what would be expected in a random graph with
+// the same degrees, and higher values indicate a stronger community structure.
+// In an undirected graph, it is the sum of L/m - (d/2m)^2 over all communities,
+// where m is the number of edges, L is the number of edges inside the
+// community, and d is the total degree of the vertices in the community, with
+// self-loops counting twice. In a directed graph, (d/2m)^2 is replaced by the
+// product of the total out-degree and the total in-degree of the community,
+// divided by m^2.
+//
+// In a weighted graph, the number of edges is replaced by the sum of the edge
+// weights, which must not be negative. If a vertex is missing from the
+// partition or if the graph has no edges, an error is returned.
+func Modularity[K comparable, T any](g Graph[K, T], partition map[K]int) (float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for vertex := range adjacencyMap {
+		if _, ok := partition[vertex]; !ok {
+			return 0, fmt.Errorf("vertex %v is not assigned to a community", vertex)
+		}
+	}
+
+	isDirected := g.Traits().IsDirected
+	isWeighted := g.Traits().IsWeighted
+
+	// In an undirected graph, total is 2m, inside is 2L, and out is the total
+	// degree of each community, because the adjacency map contains each edge
+	// in both directions. Self-loops are only contained once, so they are
+	// counted twice explicitly.
+	total := 0.0
+	inside := 0.0
+	out := make(map[int]float64)
+	in := make(map[int]float64)
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			weight := 1.0
+
+			if isWeighted {
+				if edge.Properties.Weight < 0 {
+					return 0, fmt.Errorf("edge (%v, %v) has a negative weight", source, target)
+				}
+				weight = float64(edge.Properties.Weight)
+			}
+
+			if !isDirected && source == target {
+				weight *= 2
+			}
+
+			total += weight
+			out[partition[source]] += weight
+			in[partition[target]] += weight
+
+			if partition[source] == partition[target] {
+				inside += weight
+			}
+		}
+	}
+
+	if total == 0 {
+		return 0, errors.New("modularity is undefined for a graph without edges")
+	}
+
+	modularity := inside / total
+
+	for community, degree := range out {
+		modularity -= degree * in[community] / (total * total)
+	}
+
+	return modularity, nil
+}
@@ -0,0 +1,107 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestModularity(t *testing.T) {
+	barbell := []Edge[int]{
+		{Source: 1, Target: 2},
+		{Source: 2, Target: 3},
+		{Source: 3, Target: 1},
+		{Source: 3, Target: 4},
+		{Source: 4, Target: 5},
+		{Source: 5, Target: 6},
+		{Source: 6, Target: 4},
+	}
+
+	tests := map[string]struct {
+		traits     []func(*Traits)
+		vertices   []int
+		edges      []Edge[int]
+		partition  map[int]int
+		expected   float64
+		shouldFail bool
+	}{
+		"two triangles": {
+			vertices:  []int{1, 2, 3, 4, 5, 6},
+			edges:     barbell,
+			partition: map[int]int{1: 0, 2: 0, 3: 0, 4: 1, 5: 1, 6: 1},
+			expected:  6.0/7.0 - 0.5,
+		},
+		"single community": {
+			vertices:  []int{1, 2, 3, 4, 5, 6},
+			edges:     barbell,
+			partition: map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0, 6: 0},
+			expected:  0,
+		},
+		"weighted graph": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 3}},
+			},
+			partition: map[int]int{1: 0, 2: 0, 3: 1, 4: 1},
+			// L/m = 6/7, and both communities have a total degree of 7.
+			expected: 6.0/7.0 - 0.5,
+		},
+		"self-loop": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			partition: map[int]int{1: 0, 2: 1},
+			// m = 2, L = 1 for the first community, whose degree is 3.
+			expected: 0.5 - (9.0+1.0)/16.0,
+		},
+		"directed graph": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			partition: map[int]int{1: 0, 2: 1},
+			expected:  -0.5,
+		},
+		"missing vertex": {
+			vertices:   []int{1, 2},
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			partition:  map[int]int{1: 0},
+			shouldFail: true,
+		},
+		"no edges": {
+			vertices:   []int{1, 2},
+			partition:  map[int]int{1: 0, 2: 1},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			modularity, err := Modularity(g, test.partition)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if math.Abs(modularity-test.expected) > 1e-9 {
+				t.Errorf("expected modularity %v, got %v", test.expected, modularity)
+			}
+		})
+	}
+}
@@ -0,0 +1,614 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+var ErrReadOnly = errors.New("store is read-only")
+
+// KeyHash is a function that maps a vertex hash of type K to a 64-bit integer.
+// It is used by [NewCompactStore] for building a minimal perfect hash function
+// over all vertex hashes. Different vertex hashes should yield different 64-bit
+// values, for example:
+//
+//	keyHash := func(k int) uint64 {
+//		return uint64(k)
+//	}
+//
+// For string hashes, a function from hash/fnv or hash/maphash can be used.
+type KeyHash[K comparable] func(K) uint64
+
+// compactStore is a read-only Store implementation optimized for lookups and
+// memory usage. Instead of Go maps, the vertices are located using a minimal
+// perfect hash function which maps each of the n vertex hashes to a unique slot
+// in [0, n). Vertex data lives in flat slices indexed by those slots, and the
+// edges are stored in compressed sparse row (CSR) layout.
+//
+// Since the store never changes, it doesn't require any locking and is safe for
+// concurrent use.
+type compactStore[K comparable, T any] struct {
+	keyHash KeyHash[K]
+
+	// seeds contains one entry per bucket of the perfect hash function. Seeds
+	// >= 0 are displacements for re-hashing the keys of the bucket, negative
+	// seeds s directly encode the slot -s-1 for single-key buckets.
+	seeds []int32
+
+	keys       []K
+	values     []T
+	properties []VertexProperties
+
+	// The outgoing edges of the vertex in slot i are edges[offsets[i]:offsets[i+1]].
+	// They are sorted by the slot of their target vertex, which is stored in
+	// targets, so that edge lookups can use a binary search.
+	offsets []int
+	targets []int32
+	edges   []Edge[K]
+
+	// The ingoing edges of the vertex in slot i come from the vertices in the
+	// slots sources[inOffsets[i]:inOffsets[i+1]]. In an undirected graph, these
+	// slices are the same as offsets and targets.
+	inOffsets []int
+	sources   []int32
+}
+
+// NewCompactStore creates a read-only store containing all vertices and edges
+// of the given graph. This store requires substantially less memory and offers
+// faster lookups than the default in-memory store, which makes it suitable for
+// very large graphs that don't change anymore.
+//
+// The provided key hash function is used for building a minimal perfect hash
+// function over the vertex hashes. If two vertex hashes yield the same 64-bit
+// value, an error will be returned.
+//
+// All methods of the store that would modify it return ErrReadOnly. To create a
+// graph that uses the store, use [Compact] or [NewWithStore].
+func NewCompactStore[K comparable, T any](g Graph[K, T], keyHash KeyHash[K]) (Store[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	if len(adjacencyMap) > math.MaxInt32 {
+		return nil, fmt.Errorf("compact store supports at most %d vertices", math.MaxInt32)
+	}
+
+	keys := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		keys = append(keys, vertex)
+	}
+
+	s := &compactStore[K, T]{
+		keyHash: keyHash,
+	}
+
+	if err := s.buildSeeds(keys); err != nil {
+		return nil, err
+	}
+
+	n := len(keys)
+
+	s.keys = make([]K, n)
+	s.values = make([]T, n)
+	s.properties = make([]VertexProperties, n)
+	s.offsets = make([]int, n+1)
+
+	for _, key := range keys {
+		slot := s.lookup(key)
+
+		value, properties, err := g.VertexWithProperties(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", key, err)
+		}
+
+		s.keys[slot] = key
+		s.values[slot] = value
+		s.properties[slot] = newVertexPropertiesFrom(properties)
+		s.offsets[slot+1] = len(adjacencyMap[key])
+	}
+
+	for i := 1; i <= n; i++ {
+		s.offsets[i] += s.offsets[i-1]
+	}
+
+	s.targets = make([]int32, s.offsets[n])
+	s.edges = make([]Edge[K], s.offsets[n])
+
+	for slot, key := range s.keys {
+		start := s.offsets[slot]
+		i := start
+
+		for adjacency, edge := range adjacencyMap[key] {
+			s.targets[i] = int32(s.lookup(adjacency))
+			s.edges[i] = newEdgeFrom(edge)
+			i++
+		}
+
+		sort.Sort(byTarget[K]{
+			targets: s.targets[start:i],
+			edges:   s.edges[start:i],
+		})
+	}
+
+	if g.Traits().IsDirected {
+		s.buildInEdges()
+	} else {
+		s.inOffsets = s.offsets
+		s.sources = s.targets
+	}
+
+	return s, nil
+}
+
+// buildInEdges derives the CSR layout of the ingoing edges from the CSR layout
+// of the outgoing edges.
+func (s *compactStore[K, T]) buildInEdges() {
+	n := len(s.keys)
+
+	s.inOffsets = make([]int, n+1)
+	s.sources = make([]int32, len(s.targets))
+
+	for _, target := range s.targets {
+		s.inOffsets[target+1]++
+	}
+
+	for i := 1; i <= n; i++ {
+		s.inOffsets[i] += s.inOffsets[i-1]
+	}
+
+	next := make([]int, n)
+	copy(next, s.inOffsets[:n])
+
+	for source := 0; source < n; source++ {
+		for i := s.offsets[source]; i < s.offsets[source+1]; i++ {
+			target := s.targets[i]
+			s.sources[next[target]] = int32(source)
+			next[target]++
+		}
+	}
+}
+
+// Compact returns a read-only copy of the given graph that uses a store created
+// by [NewCompactStore]. The copy has the same hashing function and traits as the
+// given graph. All operations that would modify it return ErrReadOnly.
+func Compact[K comparable, T any](g Graph[K, T], keyHash KeyHash[K]) (Graph[K, T], error) {
+	store, err := NewCompactStore(g, keyHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithStore(hashOf(g), store, copyTraits(g.Traits())), nil
+}
+
+// buildSeeds builds the minimal perfect hash function for the given keys using
+// the "hash and displace" approach: All keys are distributed into n buckets.
+// Starting with the largest bucket, a seed is searched that maps all keys in the
+// bucket to free slots. Buckets holding a single key are directly assigned one
+// of the remaining free slots.
+func (s *compactStore[K, T]) buildSeeds(keys []K) error {
+	n := len(keys)
+
+	s.seeds = make([]int32, n)
+	if n == 0 {
+		return nil
+	}
+
+	hashes := make([]uint64, n)
+	buckets := make([][]int, n)
+
+	for i, key := range keys {
+		hashes[i] = s.keyHash(key)
+		bucket := mix(hashes[i], 0) % uint64(n)
+		buckets[bucket] = append(buckets[bucket], i)
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.Slice(order, func(i, j int) bool {
+		return len(buckets[order[i]]) > len(buckets[order[j]])
+	})
+
+	occupied := make([]bool, n)
+	slots := make([]uint64, 0)
+
+	b := 0
+
+	for ; b < n && len(buckets[order[b]]) > 1; b++ {
+		bucket := buckets[order[b]]
+		seed := uint64(1)
+
+		// Keys with identical 64-bit hashes always end up in the same bucket
+		// and can never be separated by any seed.
+		for i := range bucket {
+			for j := i + 1; j < len(bucket); j++ {
+				if hashes[bucket[i]] == hashes[bucket[j]] {
+					return fmt.Errorf("failed to build perfect hash function: vertices %v and %v have the same key hash", keys[bucket[i]], keys[bucket[j]])
+				}
+			}
+		}
+
+		for {
+			slots = slots[:0]
+			ok := true
+
+			for _, i := range bucket {
+				slot := mix(hashes[i], seed) % uint64(n)
+				if occupied[slot] || containsSlot(slots, slot) {
+					ok = false
+					break
+				}
+				slots = append(slots, slot)
+			}
+
+			if ok {
+				break
+			}
+
+			seed++
+
+			if seed > math.MaxInt32 {
+				return errors.New("failed to build perfect hash function: no seed found")
+			}
+		}
+
+		for _, slot := range slots {
+			occupied[slot] = true
+		}
+
+		s.seeds[order[b]] = int32(seed)
+	}
+
+	free := 0
+
+	for ; b < n && len(buckets[order[b]]) == 1; b++ {
+		for occupied[free] {
+			free++
+		}
+
+		occupied[free] = true
+		s.seeds[order[b]] = -int32(free) - 1
+	}
+
+	return nil
+}
+
+// lookup returns the slot for the given key. If the key is not contained in the
+// store, the returned slot belongs to another key or is -1.
+func (s *compactStore[K, T]) lookup(key K) int {
+	n := uint64(len(s.seeds))
+	if n == 0 {
+		return -1
+	}
+
+	h := s.keyHash(key)
+	seed := s.seeds[mix(h, 0)%n]
+
+	if seed < 0 {
+		return int(-seed - 1)
+	}
+
+	return int(mix(h, uint64(seed)) % n)
+}
+
+// slot returns the slot of the given key or false if the key doesn't exist.
+func (s *compactStore[K, T]) slot(key K) (int, bool) {
+	slot := s.lookup(key)
+	if slot < 0 || s.keys[slot] != key {
+		return 0, false
+	}
+	return slot, true
+}
+
+func (s *compactStore[K, T]) AddVertex(K, T, VertexProperties) error {
+	return ErrReadOnly
+}
+
+func (s *compactStore[K, T]) Vertex(k K) (T, VertexProperties, error) {
+	slot, ok := s.slot(k)
+	if !ok {
+		var v T
+		return v, VertexProperties{}, ErrVertexNotFound
+	}
+
+	return s.values[slot], s.properties[slot], nil
+}
+
+func (s *compactStore[K, T]) RemoveVertex(K) error {
+	return ErrReadOnly
+}
+
+func (s *compactStore[K, T]) ListVertices() ([]K, error) {
+	hashes := make([]K, len(s.keys))
+	copy(hashes, s.keys)
+
+	return hashes, nil
+}
+
+func (s *compactStore[K, T]) VertexCount() (int, error) {
+	return len(s.keys), nil
+}
+
+func (s *compactStore[K, T]) AddEdge(K, K, Edge[K]) error {
+	return ErrReadOnly
+}
+
+func (s *compactStore[K, T]) UpdateEdge(K, K, Edge[K]) error {
+	return ErrReadOnly
+}
+
+func (s *compactStore[K, T]) RemoveEdge(K, K) error {
+	return ErrReadOnly
+}
+
+func (s *compactStore[K, T]) Edge(sourceHash, targetHash K) (Edge[K], error) {
+	source, ok := s.slot(sourceHash)
+	if !ok {
+		return Edge[K]{}, ErrEdgeNotFound
+	}
+
+	target, ok := s.slot(targetHash)
+	if !ok {
+		return Edge[K]{}, ErrEdgeNotFound
+	}
+
+	start, end := s.offsets[source], s.offsets[source+1]
+
+	i := start + sort.Search(end-start, func(i int) bool {
+		return s.targets[start+i] >= int32(target)
+	})
+
+	if i == end || s.targets[i] != int32(target) {
+		return Edge[K]{}, ErrEdgeNotFound
+	}
+
+	return s.edges[i], nil
+}
+
+func (s *compactStore[K, T]) ListEdges() ([]Edge[K], error) {
+	edges := make([]Edge[K], len(s.edges))
+	copy(edges, s.edges)
+
+	return edges, nil
+}
+
+// InEdges returns all ingoing edges of the given vertex.
+func (s *compactStore[K, T]) InEdges(k K) ([]Edge[K], error) {
+	target, ok := s.slot(k)
+	if !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	edges := make([]Edge[K], 0, s.inOffsets[target+1]-s.inOffsets[target])
+
+	err := s.VisitInEdges(k, func(edge Edge[K]) bool {
+		edges = append(edges, edge)
+		return false
+	})
+
+	return edges, err
+}
+
+// OutEdges returns all outgoing edges of the given vertex.
+func (s *compactStore[K, T]) OutEdges(k K) ([]Edge[K], error) {
+	source, ok := s.slot(k)
+	if !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	edges := make([]Edge[K], s.offsets[source+1]-s.offsets[source])
+	copy(edges, s.edges[s.offsets[source]:s.offsets[source+1]])
+
+	return edges, nil
+}
+
+// VisitVertices calls the visit function for each vertex in slot order.
+func (s *compactStore[K, T]) VisitVertices(visit func(hash K) bool) error {
+	for _, k := range s.keys {
+		if visit(k) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// VisitOutEdges calls the visit function for each outgoing edge of the given
+// vertex, ordered by the slots of the targets.
+func (s *compactStore[K, T]) VisitOutEdges(k K, visit func(edge Edge[K]) bool) error {
+	source, ok := s.slot(k)
+	if !ok {
+		return ErrVertexNotFound
+	}
+
+	for _, edge := range s.edges[s.offsets[source]:s.offsets[source+1]] {
+		if visit(edge) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// VisitInEdges calls the visit function for each ingoing edge of the given
+// vertex. Since only the sources of the ingoing edges are stored, each edge
+// has to be looked up, which takes O(log deg) time.
+func (s *compactStore[K, T]) VisitInEdges(k K, visit func(edge Edge[K]) bool) error {
+	target, ok := s.slot(k)
+	if !ok {
+		return ErrVertexNotFound
+	}
+
+	for i := s.inOffsets[target]; i < s.inOffsets[target+1]; i++ {
+		edge, err := s.Edge(s.keys[s.sources[i]], k)
+		if err != nil {
+			return err
+		}
+
+		if visit(edge) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// byTarget sorts the edges of a single vertex by the slots of their targets.
+type byTarget[K comparable] struct {
+	targets []int32
+	edges   []Edge[K]
+}
+
+func (b byTarget[K]) Len() int {
+	return len(b.targets)
+}
+
+func (b byTarget[K]) Less(i, j int) bool {
+	return b.targets[i] < b.targets[j]
+}
+
+func (b byTarget[K]) Swap(i, j int) {
+	b.targets[i], b.targets[j] = b.targets[j], b.targets[i]
+	b.edges[i], b.edges[j] = b.edges[j], b.edges[i]
+}
+
+// mix derives a well-distributed 64-bit hash from the given hash and seed using
+// the finalizer of the SplitMix64 generator.
+func mix(h, seed uint64) uint64 {
+	h ^= seed * 0x9e3779b97f4a7c15
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+func containsSlot(slots []uint64, slot uint64) bool {
+	for _, s := range slots {
+		if s == slot {
+			return true
+		}
+	}
+	return false
+}
+
+// bfs performs a direction-optimizing breadth-first search as described by
+// Beamer, Asanović, and Patterson. The search proceeds level by level, and for
+// each level, it decides between two strategies:
+//
+//   - Top-down: All outgoing edges of the vertices in the current frontier are
+//     inspected to find unvisited vertices. This is cheap for small frontiers.
+//   - Bottom-up: Each unvisited vertex inspects its ingoing edges until it finds
+//     a parent in the current frontier. This is cheap for large frontiers, as
+//     most vertices find a parent after inspecting only a few edges.
+//
+// On graphs with a small diameter like social networks, the frontier quickly
+// covers a large part of the graph, and switching to bottom-up steps saves the
+// majority of edge inspections.
+//
+// The visit function has the same semantics as the one passed to BFS.
+func (s *compactStore[K, T]) bfs(start K, visit func(K) bool) error {
+	startSlot, ok := s.slot(start)
+	if !ok {
+		return fmt.Errorf("could not find start vertex with hash %v", start)
+	}
+
+	// The heuristic parameters proposed by Beamer et al.: Switch to bottom-up
+	// if the frontier has more than 1/alpha of the unexplored edges, switch
+	// back to top-down if the frontier has less than 1/beta of all vertices.
+	const (
+		alpha = 14
+		beta  = 24
+	)
+
+	n := len(s.keys)
+
+	visited := newBitset(n)
+	inFrontier := newBitset(n)
+
+	visited.set(startSlot)
+
+	if visit(s.keys[startSlot]) {
+		return nil
+	}
+
+	frontier := []int32{int32(startSlot)}
+	next := make([]int32, 0)
+
+	unexploredEdges := len(s.sources) - (s.inOffsets[startSlot+1] - s.inOffsets[startSlot])
+	bottomUp := false
+
+	for len(frontier) > 0 {
+		frontierEdges := 0
+		for _, v := range frontier {
+			frontierEdges += s.offsets[v+1] - s.offsets[v]
+		}
+
+		if !bottomUp && frontierEdges > unexploredEdges/alpha {
+			bottomUp = true
+		} else if bottomUp && len(frontier) < n/beta {
+			bottomUp = false
+		}
+
+		next = next[:0]
+
+		if bottomUp {
+			inFrontier.reset()
+			for _, v := range frontier {
+				inFrontier.set(int(v))
+			}
+
+			for word := range visited {
+				// Skip 64 vertices at once if all of them are visited.
+				if visited[word] == ^uint64(0) {
+					continue
+				}
+
+				for u := word * 64; u < (word+1)*64 && u < n; u++ {
+					if visited.has(u) {
+						continue
+					}
+
+					for i := s.inOffsets[u]; i < s.inOffsets[u+1]; i++ {
+						if inFrontier.has(int(s.sources[i])) {
+							visited.set(u)
+							next = append(next, int32(u))
+							break
+						}
+					}
+				}
+			}
+		} else {
+			for _, v := range frontier {
+				for i := s.offsets[v]; i < s.offsets[v+1]; i++ {
+					u := int(s.targets[i])
+					if visited.has(u) {
+						continue
+					}
+
+					visited.set(u)
+					next = append(next, int32(u))
+				}
+			}
+		}
+
+		for _, u := range next {
+			unexploredEdges -= s.inOffsets[u+1] - s.inOffsets[u]
+
+			// Stop traversing the graph if the visit function returns true.
+			if visit(s.keys[u]) {
+				return nil
+			}
+		}
+
+		frontier, next = next, frontier
+	}
+
+	return nil
+}
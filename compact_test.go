@@ -0,0 +1,398 @@
+package graph
+
+import (
+	"errors"
+	"hash/fnv"
+	"testing"
+)
+
+func TestNewCompactStore(t *testing.T) {
+	tests := map[string]struct {
+		vertices int
+		edges    func(i int) []int
+	}{
+		"empty graph": {
+			vertices: 0,
+			edges:    func(int) []int { return nil },
+		},
+		"single vertex": {
+			vertices: 1,
+			edges:    func(int) []int { return nil },
+		},
+		"ring": {
+			vertices: 50,
+			edges:    func(i int) []int { return []int{(i + 1) % 50} },
+		},
+		"dense graph": {
+			vertices: 2000,
+			edges: func(i int) []int {
+				return []int{(i * 7) % 2000, (i*13 + 1) % 2000, (i + 3) % 2000}
+			},
+		},
+	}
+
+	keyHash := func(k int) uint64 {
+		return uint64(k)
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for i := 0; i < test.vertices; i++ {
+				_ = g.AddVertex(i, VertexWeight(i))
+			}
+
+			for i := 0; i < test.vertices; i++ {
+				for _, target := range test.edges(i) {
+					_ = g.AddEdge(i, target, EdgeWeight(i+target))
+				}
+			}
+
+			store, err := NewCompactStore(g, keyHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			count, _ := store.VertexCount()
+			if count != test.vertices {
+				t.Errorf("expected %d vertices, got %d", test.vertices, count)
+			}
+
+			for i := 0; i < test.vertices; i++ {
+				value, properties, err := store.Vertex(i)
+				if err != nil {
+					t.Fatalf("unexpected error for vertex %d: %v", i, err)
+				}
+				if value != i || properties.Weight != i {
+					t.Errorf("expected vertex %d with weight %d, got %d with weight %d", i, i, value, properties.Weight)
+				}
+			}
+
+			if _, _, err := store.Vertex(-1); !errors.Is(err, ErrVertexNotFound) {
+				t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+			}
+
+			expectedEdges, _ := g.Edges()
+			actualEdges, _ := store.ListEdges()
+
+			if len(expectedEdges) != len(actualEdges) {
+				t.Fatalf("expected %d edges, got %d", len(expectedEdges), len(actualEdges))
+			}
+
+			for _, expected := range expectedEdges {
+				actual, err := store.Edge(expected.Source, expected.Target)
+				if err != nil {
+					t.Fatalf("unexpected error for edge (%d, %d): %v", expected.Source, expected.Target, err)
+				}
+				if !edgesAreEqual(expected, actual, true) {
+					t.Errorf("expected edge %v, got %v", expected, actual)
+				}
+			}
+
+			for i := 0; i < test.vertices; i++ {
+				if _, err := store.Edge(i, -1); !errors.Is(err, ErrEdgeNotFound) {
+					t.Errorf("expected error %v, got %v", ErrEdgeNotFound, err)
+				}
+			}
+
+			if test.vertices > 0 {
+				if _, err := store.Edge(-1, 0); !errors.Is(err, ErrEdgeNotFound) {
+					t.Errorf("expected error %v, got %v", ErrEdgeNotFound, err)
+				}
+			}
+		})
+	}
+}
+
+func TestCompactStore_ReadOnly(t *testing.T) {
+	g := New(StringHash)
+
+	_ = g.AddVertex("A")
+	_ = g.AddVertex("B")
+	_ = g.AddEdge("A", "B")
+
+	keyHash := func(k string) uint64 {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(k))
+		return h.Sum64()
+	}
+
+	store, err := NewCompactStore(g, keyHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := store.AddVertex("C", "C", VertexProperties{}); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("AddVertex: expected error %v, got %v", ErrReadOnly, err)
+	}
+
+	if err := store.RemoveVertex("A"); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("RemoveVertex: expected error %v, got %v", ErrReadOnly, err)
+	}
+
+	if err := store.AddEdge("B", "A", Edge[string]{}); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("AddEdge: expected error %v, got %v", ErrReadOnly, err)
+	}
+
+	if err := store.UpdateEdge("A", "B", Edge[string]{}); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("UpdateEdge: expected error %v, got %v", ErrReadOnly, err)
+	}
+
+	if err := store.RemoveEdge("A", "B"); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("RemoveEdge: expected error %v, got %v", ErrReadOnly, err)
+	}
+}
+
+func TestNewCompactStore_CollidingKeyHashes(t *testing.T) {
+	g := New(IntHash)
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddVertex(3)
+
+	keyHash := func(int) uint64 {
+		return 42
+	}
+
+	if _, err := NewCompactStore(g, keyHash); err == nil {
+		t.Errorf("expected error for colliding key hashes, got nil")
+	}
+}
+
+func TestCompact(t *testing.T) {
+	for _, isDirected := range []bool{true, false} {
+		var g Graph[string, string]
+
+		if isDirected {
+			g = New(StringHash, Directed(), Weighted())
+		} else {
+			g = New(StringHash, Weighted())
+		}
+
+		for _, vertex := range []string{"A", "B", "C", "D", "E", "F", "G"} {
+			_ = g.AddVertex(vertex)
+		}
+
+		_ = g.AddEdge("A", "C", EdgeWeight(3))
+		_ = g.AddEdge("A", "F", EdgeWeight(2))
+		_ = g.AddEdge("C", "D", EdgeWeight(4))
+		_ = g.AddEdge("C", "E", EdgeWeight(1))
+		_ = g.AddEdge("C", "F", EdgeWeight(2))
+		_ = g.AddEdge("D", "B", EdgeWeight(1))
+		_ = g.AddEdge("E", "B", EdgeWeight(2))
+		_ = g.AddEdge("E", "F", EdgeWeight(3))
+		_ = g.AddEdge("F", "G", EdgeWeight(5))
+		_ = g.AddEdge("G", "B", EdgeWeight(2))
+
+		keyHash := func(k string) uint64 {
+			h := fnv.New64a()
+			_, _ = h.Write([]byte(k))
+			return h.Sum64()
+		}
+
+		compact, err := Compact(g, keyHash)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !traitsAreEqual(g.Traits(), compact.Traits()) {
+			t.Errorf("expected traits %v, got %v", g.Traits(), compact.Traits())
+		}
+
+		expectedSize, _ := g.Size()
+		actualSize, _ := compact.Size()
+		if expectedSize != actualSize {
+			t.Errorf("expected size %d, got %d", expectedSize, actualSize)
+		}
+
+		path, err := ShortestPath(compact, "A", "B")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expectedPath := []string{"A", "C", "E", "B"}
+		if len(path) != len(expectedPath) {
+			t.Fatalf("expected path %v, got %v", expectedPath, path)
+		}
+		for i := range path {
+			if path[i] != expectedPath[i] {
+				t.Errorf("expected path %v, got %v", expectedPath, path)
+			}
+		}
+
+		if err := compact.AddVertex("H"); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("expected error %v, got %v", ErrReadOnly, err)
+		}
+	}
+}
+
+func TestCompact_independentAttributes(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1, VertexAttribute("label", "one"))
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2, EdgeAttribute("color", "red"))
+
+	compact, err := Compact(g, func(k int) uint64 { return uint64(k) })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_ = g.UpdateEdge(1, 2, EdgeAttribute("color", "blue"))
+
+	_, properties, _ := g.VertexWithProperties(1)
+	properties.Attributes["label"] = "changed"
+
+	edge, _ := compact.Edge(1, 2)
+	if color := edge.Properties.Attributes["color"]; color != "red" {
+		t.Errorf("expected color red, got %s", color)
+	}
+
+	_, properties, _ = compact.VertexWithProperties(1)
+	if label := properties.Attributes["label"]; label != "one" {
+		t.Errorf("expected label one, got %s", label)
+	}
+}
+
+func TestCompactStore_BFS(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		vertices   int
+		edges      func(i int) []int
+	}{
+		"directed sparse graph": {
+			isDirected: true,
+			vertices:   500,
+			edges:      func(i int) []int { return []int{(i*2 + 1) % 500} },
+		},
+		"directed dense graph": {
+			isDirected: true,
+			vertices:   1000,
+			edges: func(i int) []int {
+				return []int{(i*7 + 1) % 1000, (i*13 + 5) % 1000, (i*31 + 2) % 1000, (i*3 + 11) % 1000}
+			},
+		},
+		"undirected dense graph": {
+			vertices: 1000,
+			edges: func(i int) []int {
+				return []int{(i*17 + 3) % 1000, (i*29 + 7) % 1000, (i*5 + 1) % 1000}
+			},
+		},
+		"disconnected graph": {
+			isDirected: true,
+			vertices:   200,
+			edges: func(i int) []int {
+				if i >= 100 {
+					return nil
+				}
+				return []int{(i + 1) % 100, (i * 3) % 100}
+			},
+		},
+	}
+
+	keyHash := func(k int) uint64 {
+		return uint64(k)
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var g Graph[int, int]
+
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			} else {
+				g = New(IntHash)
+			}
+
+			for i := 0; i < test.vertices; i++ {
+				_ = g.AddVertex(i)
+			}
+
+			for i := 0; i < test.vertices; i++ {
+				for _, target := range test.edges(i) {
+					_ = g.AddEdge(i, target)
+				}
+			}
+
+			// Compute the BFS level of each reachable vertex as a reference.
+			adjacencyMap, _ := g.AdjacencyMap()
+			levels := map[int]int{0: 0}
+			queue := []int{0}
+
+			for len(queue) > 0 {
+				current := queue[0]
+				queue = queue[1:]
+
+				for adjacency := range adjacencyMap[current] {
+					if _, ok := levels[adjacency]; !ok {
+						levels[adjacency] = levels[current] + 1
+						queue = append(queue, adjacency)
+					}
+				}
+			}
+
+			compact, err := Compact(g, keyHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			visited := make(map[int]struct{})
+			lastLevel := 0
+
+			_ = BFS(compact, 0, func(value int) bool {
+				if _, ok := visited[value]; ok {
+					t.Errorf("vertex %v has been visited twice", value)
+				}
+				visited[value] = struct{}{}
+
+				level, ok := levels[value]
+				if !ok {
+					t.Errorf("unreachable vertex %v has been visited", value)
+				}
+				if level < lastLevel {
+					t.Errorf("vertex %v on level %d visited after a vertex on level %d", value, level, lastLevel)
+				}
+				lastLevel = level
+
+				return false
+			})
+
+			if len(visited) != len(levels) {
+				t.Errorf("expected %d visited vertices, got %d", len(levels), len(visited))
+			}
+		})
+	}
+}
+
+func TestCompactStore_BFSStop(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 10; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	for i := 1; i < 10; i++ {
+		_ = g.AddEdge(0, i)
+	}
+
+	compact, err := Compact(g, func(k int) uint64 { return uint64(k) })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	visits := 0
+
+	_ = BFS(compact, 0, func(int) bool {
+		visits++
+		return visits == 3
+	})
+
+	if visits != 3 {
+		t.Errorf("expected 3 visits, got %d", visits)
+	}
+
+	if err := BFS(compact, 42, func(int) bool { return false }); err == nil {
+		t.Errorf("expected error for non-existent start vertex, got nil")
+	}
+}
@@ -0,0 +1,233 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Equal reports whether the graphs g and h are structurally equal. This is the
+// case if both graphs are either directed or undirected and contain the same
+// vertex hashes with the same vertex properties, and the same edges with the
+// same edge properties. Edge properties are equal if their weights, attributes,
+// data, and validity intervals are equal, where the data is compared using
+// reflect.DeepEqual.
+//
+// The vertex values themselves aren't compared, because T isn't necessarily
+// comparable. Traits other than directedness are ignored as well. Equal is
+// particularly useful for table-driven tests that compare a computed graph
+// against an expected graph.
+func Equal[K comparable, T any](g, h Graph[K, T]) (bool, error) {
+	if g.Traits().IsDirected != h.Traits().IsDirected {
+		return false, nil
+	}
+
+	gAdjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map of g: %w", err)
+	}
+
+	hAdjacencyMap, err := h.AdjacencyMap()
+	if err != nil {
+		return false, fmt.Errorf("failed to get adjacency map of h: %w", err)
+	}
+
+	if len(gAdjacencyMap) != len(hAdjacencyMap) {
+		return false, nil
+	}
+
+	for hash, gAdjacencies := range gAdjacencyMap {
+		hAdjacencies, ok := hAdjacencyMap[hash]
+		if !ok || len(gAdjacencies) != len(hAdjacencies) {
+			return false, nil
+		}
+
+		_, gProperties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return false, fmt.Errorf("failed to get vertex %v of g: %w", hash, err)
+		}
+
+		_, hProperties, err := h.VertexWithProperties(hash)
+		if err != nil {
+			return false, fmt.Errorf("failed to get vertex %v of h: %w", hash, err)
+		}
+
+		if !vertexPropertiesEqual(gProperties, hProperties) {
+			return false, nil
+		}
+
+		for target, gEdge := range gAdjacencies {
+			hEdge, ok := hAdjacencies[target]
+			if !ok || !edgePropertiesEqual(gEdge.Properties, hEdge.Properties) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// Difference contains the changes between two versions of a graph, as computed
+// by [Diff]. Edges are identified by their source and target vertices, so an
+// edge whose properties have changed is contained in ChangedEdges rather than
+// in RemovedEdges and AddedEdges.
+type Difference[K comparable] struct {
+	AddedVertices   []K
+	RemovedVertices []K
+	ChangedVertices []VertexChange[K]
+	AddedEdges      []Edge[K]
+	RemovedEdges    []Edge[K]
+	ChangedEdges    []EdgeChange[K]
+}
+
+// VertexChange describes a vertex whose properties differ between two versions
+// of a graph.
+type VertexChange[K comparable] struct {
+	Hash K
+	Old  VertexProperties
+	New  VertexProperties
+}
+
+// EdgeChange describes an edge whose properties, for example its weight, differ
+// between two versions of a graph.
+type EdgeChange[K comparable] struct {
+	Source K
+	Target K
+	Old    EdgeProperties
+	New    EdgeProperties
+}
+
+// IsEmpty reports whether there are no changes at all, which means that the
+// compared graphs are equal in terms of [Equal].
+func (d Difference[K]) IsEmpty() bool {
+	return len(d.AddedVertices) == 0 && len(d.RemovedVertices) == 0 && len(d.ChangedVertices) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0 && len(d.ChangedEdges) == 0
+}
+
+// Diff computes the changes that turn the graph g into the graph h: the
+// vertices and edges that have been added or removed, and the vertices and
+// edges whose properties have changed. Properties are compared in the same way
+// as in [Equal]. This is useful for showing what has changed between two
+// versions of a dependency graph:
+//
+//	diff, _ := graph.Diff(oldGraph, newGraph)
+//
+//	for _, change := range diff.ChangedEdges {
+//		fmt.Printf("%v -> %v: weight %d -> %d\n", change.Source, change.Target, change.Old.Weight, change.New.Weight)
+//	}
+//
+// In an undirected graph, each added, removed, or changed edge is contained
+// only once. The order of the vertices and edges in the result is undefined.
+// Diff returns an error if one of the graphs is directed and the other isn't.
+func Diff[K comparable, T any](g, h Graph[K, T]) (Difference[K], error) {
+	var diff Difference[K]
+
+	if g.Traits().IsDirected != h.Traits().IsDirected {
+		return diff, errors.New("cannot compare directed graph with undirected graph")
+	}
+
+	gAdjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return diff, fmt.Errorf("failed to get adjacency map of g: %w", err)
+	}
+
+	hAdjacencyMap, err := h.AdjacencyMap()
+	if err != nil {
+		return diff, fmt.Errorf("failed to get adjacency map of h: %w", err)
+	}
+
+	for hash := range gAdjacencyMap {
+		if _, ok := hAdjacencyMap[hash]; !ok {
+			diff.RemovedVertices = append(diff.RemovedVertices, hash)
+			continue
+		}
+
+		_, gProperties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return diff, fmt.Errorf("failed to get vertex %v of g: %w", hash, err)
+		}
+
+		_, hProperties, err := h.VertexWithProperties(hash)
+		if err != nil {
+			return diff, fmt.Errorf("failed to get vertex %v of h: %w", hash, err)
+		}
+
+		if !vertexPropertiesEqual(gProperties, hProperties) {
+			diff.ChangedVertices = append(diff.ChangedVertices, VertexChange[K]{
+				Hash: hash,
+				Old:  gProperties,
+				New:  hProperties,
+			})
+		}
+	}
+
+	for hash := range hAdjacencyMap {
+		if _, ok := gAdjacencyMap[hash]; !ok {
+			diff.AddedVertices = append(diff.AddedVertices, hash)
+		}
+	}
+
+	// Edges returns each edge of an undirected graph only once, whereas the
+	// adjacency map contains it in both directions and can be used to look up
+	// the edge regardless of its orientation.
+	gEdges, err := g.Edges()
+	if err != nil {
+		return diff, fmt.Errorf("failed to get edges of g: %w", err)
+	}
+
+	for _, edge := range gEdges {
+		hEdge, ok := hAdjacencyMap[edge.Source][edge.Target]
+		if !ok {
+			diff.RemovedEdges = append(diff.RemovedEdges, edge)
+			continue
+		}
+
+		if !edgePropertiesEqual(edge.Properties, hEdge.Properties) {
+			diff.ChangedEdges = append(diff.ChangedEdges, EdgeChange[K]{
+				Source: edge.Source,
+				Target: edge.Target,
+				Old:    edge.Properties,
+				New:    hEdge.Properties,
+			})
+		}
+	}
+
+	hEdges, err := h.Edges()
+	if err != nil {
+		return diff, fmt.Errorf("failed to get edges of h: %w", err)
+	}
+
+	for _, edge := range hEdges {
+		if _, ok := gAdjacencyMap[edge.Source][edge.Target]; !ok {
+			diff.AddedEdges = append(diff.AddedEdges, edge)
+		}
+	}
+
+	return diff, nil
+}
+
+func vertexPropertiesEqual(a, b VertexProperties) bool {
+	return a.Weight == b.Weight && stringMapsEqual(a.Attributes, b.Attributes)
+}
+
+func edgePropertiesEqual(a, b EdgeProperties) bool {
+	return a.Weight == b.Weight &&
+		stringMapsEqual(a.Attributes, b.Attributes) &&
+		reflect.DeepEqual(a.Data, b.Data) &&
+		validFrom(a).Equal(validFrom(b)) &&
+		validUntil(a).Equal(validUntil(b))
+}
+
+func stringMapsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,255 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestEqual(t *testing.T) {
+	tests := map[string]struct {
+		hIsDirected bool
+		modify      func(h Graph[int, int])
+		expected    bool
+	}{
+		"equal graphs": {
+			modify:   func(Graph[int, int]) {},
+			expected: true,
+		},
+		"different directedness": {
+			hIsDirected: true,
+			modify:      func(Graph[int, int]) {},
+			expected:    false,
+		},
+		"additional vertex": {
+			modify: func(h Graph[int, int]) {
+				_ = h.AddVertex(4)
+			},
+			expected: false,
+		},
+		"additional edge": {
+			modify: func(h Graph[int, int]) {
+				_ = h.AddEdge(1, 3)
+			},
+			expected: false,
+		},
+		"different edge weight": {
+			modify: func(h Graph[int, int]) {
+				_ = h.UpdateEdge(1, 2, EdgeWeight(10))
+			},
+			expected: false,
+		},
+		"different edge attribute": {
+			modify: func(h Graph[int, int]) {
+				_ = h.UpdateEdge(2, 3, EdgeAttribute("color", "blue"))
+			},
+			expected: false,
+		},
+		"different edge data": {
+			modify: func(h Graph[int, int]) {
+				_ = h.UpdateEdge(2, 3, EdgeData([]int{2}))
+			},
+			expected: false,
+		},
+		"different edge validity": {
+			modify: func(h Graph[int, int]) {
+				_ = h.UpdateEdge(1, 2, EdgeTimestamp(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)))
+			},
+			expected: false,
+		},
+		"different vertex weight": {
+			modify: func(h Graph[int, int]) {
+				_ = h.RemoveEdge(2, 3)
+				_ = h.RemoveVertex(3)
+				_ = h.AddVertex(3, VertexWeight(4))
+				_ = h.AddEdge(2, 3, EdgeAttribute("color", "red"), EdgeData([]int{1}))
+			},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			build := func(isDirected bool) Graph[int, int] {
+				var g Graph[int, int]
+
+				if isDirected {
+					g = New(IntHash, Directed())
+				} else {
+					g = New(IntHash)
+				}
+
+				_ = g.AddVertex(1, VertexAttribute("label", "one"))
+				_ = g.AddVertex(2)
+				_ = g.AddVertex(3, VertexWeight(3))
+				_ = g.AddEdge(1, 2, EdgeWeight(5))
+				_ = g.AddEdge(2, 3, EdgeAttribute("color", "red"), EdgeData([]int{1}))
+
+				return g
+			}
+
+			g := build(false)
+			h := build(test.hIsDirected)
+
+			test.modify(h)
+
+			equal, err := Equal(g, h)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if equal != test.expected {
+				t.Errorf("expected Equal to return %v, got %v", test.expected, equal)
+			}
+
+			// Equal has to be symmetric.
+			equal, _ = Equal(h, g)
+			if equal != test.expected {
+				t.Errorf("expected Equal with swapped arguments to return %v, got %v", test.expected, equal)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := map[string]struct {
+		isDirected      bool
+		modify          func(h Graph[int, int])
+		expectedAdded   []string
+		expectedRemoved []string
+		expectedChanged []string
+	}{
+		"equal graphs": {
+			modify: func(Graph[int, int]) {},
+		},
+		"added and removed vertices": {
+			modify: func(h Graph[int, int]) {
+				_ = h.AddVertex(4)
+				_ = h.RemoveEdge(1, 3)
+				_ = h.RemoveVertex(3)
+			},
+			expectedAdded:   []string{"4"},
+			expectedRemoved: []string{"(1, 3)", "3"},
+		},
+		"changed vertex": {
+			modify: func(h Graph[int, int]) {
+				_ = h.RemoveEdge(1, 3)
+				_ = h.RemoveVertex(3)
+				_ = h.AddVertex(3, VertexWeight(7))
+				_ = h.AddEdge(1, 3)
+			},
+			expectedChanged: []string{"3: weight 0 -> 7"},
+		},
+		"added and removed edges": {
+			modify: func(h Graph[int, int]) {
+				_ = h.RemoveEdge(1, 2)
+				_ = h.AddEdge(2, 3, EdgeWeight(4))
+			},
+			expectedAdded:   []string{"(2, 3)"},
+			expectedRemoved: []string{"(1, 2)"},
+		},
+		"changed edge weight in undirected graph": {
+			modify: func(h Graph[int, int]) {
+				_ = h.UpdateEdge(2, 1, EdgeWeight(10))
+			},
+			expectedChanged: []string{"(1, 2): weight 5 -> 10"},
+		},
+		"reversed edge in directed graph": {
+			isDirected: true,
+			modify: func(h Graph[int, int]) {
+				_ = h.RemoveEdge(1, 2)
+				_ = h.AddEdge(2, 1, EdgeWeight(5))
+			},
+			expectedAdded:   []string{"(2, 1)"},
+			expectedRemoved: []string{"(1, 2)"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			build := func() Graph[int, int] {
+				g := New(IntHash)
+				if test.isDirected {
+					g = New(IntHash, Directed())
+				}
+
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddVertex(3)
+				_ = g.AddEdge(1, 2, EdgeWeight(5))
+				_ = g.AddEdge(1, 3)
+
+				return g
+			}
+
+			g := build()
+			h := build()
+
+			test.modify(h)
+
+			diff, err := Diff(g, h)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Undirected edges may be reported in either orientation, so they
+			// are normalized before formatting them.
+			formatEdge := func(edge Edge[int]) string {
+				if !test.isDirected && edge.Source > edge.Target {
+					edge.Source, edge.Target = edge.Target, edge.Source
+				}
+				return fmt.Sprintf("(%d, %d)", edge.Source, edge.Target)
+			}
+
+			var added, removed, changed []string
+
+			for _, vertex := range diff.AddedVertices {
+				added = append(added, fmt.Sprint(vertex))
+			}
+			for _, edge := range diff.AddedEdges {
+				added = append(added, formatEdge(edge))
+			}
+			for _, vertex := range diff.RemovedVertices {
+				removed = append(removed, fmt.Sprint(vertex))
+			}
+			for _, edge := range diff.RemovedEdges {
+				removed = append(removed, formatEdge(edge))
+			}
+			for _, change := range diff.ChangedVertices {
+				changed = append(changed, fmt.Sprintf("%d: weight %d -> %d", change.Hash, change.Old.Weight, change.New.Weight))
+			}
+			for _, change := range diff.ChangedEdges {
+				edge := formatEdge(Edge[int]{Source: change.Source, Target: change.Target})
+				changed = append(changed, fmt.Sprintf("%s: weight %d -> %d", edge, change.Old.Weight, change.New.Weight))
+			}
+
+			for _, c := range []struct {
+				kind     string
+				actual   []string
+				expected []string
+			}{
+				{"added", added, test.expectedAdded},
+				{"removed", removed, test.expectedRemoved},
+				{"changed", changed, test.expectedChanged},
+			} {
+				sort.Strings(c.actual)
+				if fmt.Sprint(c.actual) != fmt.Sprint(c.expected) {
+					t.Errorf("expected %s %v, got %v", c.kind, c.expected, c.actual)
+				}
+			}
+
+			isEmpty := len(test.expectedAdded) == 0 && len(test.expectedRemoved) == 0 && len(test.expectedChanged) == 0
+			if diff.IsEmpty() != isEmpty {
+				t.Errorf("expected IsEmpty to return %v, got %v", isEmpty, diff.IsEmpty())
+			}
+		})
+	}
+}
+
+func TestDiff_differentDirectedness(t *testing.T) {
+	_, err := Diff(New(IntHash), New(IntHash, Directed()))
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
@@ -0,0 +1,165 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrStaleIndex is returned by [ConnectivityIndex.Connected] if edges or
+// vertices have been removed since the index has been built.
+var ErrStaleIndex = errors.New("connectivity index is stale and has to be rebuilt")
+
+// ConnectivityIndex answers whether two vertices of an undirected graph are
+// connected in nearly constant time. It maintains the connected components of
+// the graph in a union-find data structure, which is updated cheaply whenever
+// a vertex or an edge is added.
+//
+// Removing edges can split a component, which union-find can't represent. For
+// this reason, RemoveEdge and RemoveVertex only mark the index as stale, and
+// [ConnectivityIndex.Rebuild] has to be called explicitly to recompute the
+// components. This way, many deletions can be applied before paying for a
+// single rebuild. As long as the index is stale, Connected returns
+// ErrStaleIndex.
+//
+// Like DynamicTopologicalOrder, ConnectivityIndex only reflects changes that
+// are made using its own methods. Changes made directly on the graph require a
+// rebuild as well.
+type ConnectivityIndex[K comparable, T any] struct {
+	g          Graph[K, T]
+	components *unionFind[K]
+	count      int
+	stale      bool
+}
+
+// NewConnectivityIndex computes the connected components of the given graph
+// and returns a ConnectivityIndex that maintains them. The graph has to be
+// undirected.
+func NewConnectivityIndex[K comparable, T any](g Graph[K, T]) (*ConnectivityIndex[K, T], error) {
+	if g.Traits().IsDirected {
+		return nil, errors.New("connectivity index cannot be built for directed graph")
+	}
+
+	c := &ConnectivityIndex[K, T]{
+		g: g,
+	}
+
+	if err := c.Rebuild(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Rebuild recomputes the connected components from the current state of the
+// graph and clears the stale flag. It takes O(|V|+|E|) time.
+func (c *ConnectivityIndex[K, T]) Rebuild() error {
+	adjacencyMap, err := c.g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	c.components = newUnionFind[K]()
+	c.count = len(adjacencyMap)
+
+	for vertex := range adjacencyMap {
+		c.components.add(vertex)
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			c.union(source, target)
+		}
+	}
+
+	c.stale = false
+
+	return nil
+}
+
+// Stale reports whether vertices or edges have been removed since the index
+// has been built, so that it needs to be rebuilt.
+func (c *ConnectivityIndex[K, T]) Stale() bool {
+	return c.stale
+}
+
+// Connected reports whether there is a path between the given vertices. A
+// vertex is always connected to itself. If the index is stale, ErrStaleIndex
+// is returned.
+func (c *ConnectivityIndex[K, T]) Connected(a, b K) (bool, error) {
+	if c.stale {
+		return false, ErrStaleIndex
+	}
+
+	for _, vertex := range []K{a, b} {
+		if _, ok := c.components.parents[vertex]; !ok {
+			return false, fmt.Errorf("could not get vertex with hash %v: %w", vertex, ErrVertexNotFound)
+		}
+	}
+
+	return c.components.find(a) == c.components.find(b), nil
+}
+
+// Components returns the number of connected components. If the index is
+// stale, ErrStaleIndex is returned.
+func (c *ConnectivityIndex[K, T]) Components() (int, error) {
+	if c.stale {
+		return 0, ErrStaleIndex
+	}
+
+	return c.count, nil
+}
+
+// AddVertex adds the given vertex to the graph as a new component.
+func (c *ConnectivityIndex[K, T]) AddVertex(value T, options ...func(*VertexProperties)) error {
+	if err := c.g.AddVertex(value, options...); err != nil {
+		return err
+	}
+
+	c.components.add(hashOf(c.g)(value))
+	c.count++
+
+	return nil
+}
+
+// AddEdge adds an edge between the given vertices to the graph and merges the
+// components of the vertices.
+func (c *ConnectivityIndex[K, T]) AddEdge(source, target K, options ...func(*EdgeProperties)) error {
+	if err := c.g.AddEdge(source, target, options...); err != nil {
+		return err
+	}
+
+	c.union(source, target)
+
+	return nil
+}
+
+// RemoveEdge removes the edge between the given vertices from the graph and
+// marks the index as stale.
+func (c *ConnectivityIndex[K, T]) RemoveEdge(source, target K) error {
+	if err := c.g.RemoveEdge(source, target); err != nil {
+		return err
+	}
+
+	c.stale = true
+
+	return nil
+}
+
+// RemoveVertex removes the given vertex from the graph and marks the index as
+// stale.
+func (c *ConnectivityIndex[K, T]) RemoveVertex(hash K) error {
+	if err := c.g.RemoveVertex(hash); err != nil {
+		return err
+	}
+
+	c.stale = true
+
+	return nil
+}
+
+func (c *ConnectivityIndex[K, T]) union(a, b K) {
+	if c.components.find(a) != c.components.find(b) {
+		c.components.union(a, b)
+		c.count--
+	}
+}
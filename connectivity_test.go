@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestConnectivityIndex(t *testing.T) {
+	g := New(IntHash)
+
+	for _, vertex := range []int{1, 2, 3, 4} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2)
+
+	c, err := NewConnectivityIndex(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertConnected := func(a, b int, expected bool) {
+		t.Helper()
+
+		connected, err := c.Connected(a, b)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if connected != expected {
+			t.Errorf("expected connected(%d, %d) to be %v, got %v", a, b, expected, connected)
+		}
+	}
+
+	assertComponents := func(expected int) {
+		t.Helper()
+
+		components, err := c.Components()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if components != expected {
+			t.Errorf("expected %d components, got %d", expected, components)
+		}
+	}
+
+	assertConnected(1, 2, true)
+	assertConnected(1, 3, false)
+	assertConnected(4, 4, true)
+	assertComponents(3)
+
+	_ = c.AddVertex(5)
+	_ = c.AddEdge(3, 4)
+	_ = c.AddEdge(4, 5)
+
+	assertConnected(3, 5, true)
+	assertConnected(2, 5, false)
+	assertComponents(2)
+
+	_ = c.AddEdge(2, 3)
+
+	assertConnected(1, 5, true)
+	assertComponents(1)
+
+	if err := c.AddEdge(2, 3); !errors.Is(err, ErrEdgeAlreadyExists) {
+		t.Errorf("expected error %v, got %v", ErrEdgeAlreadyExists, err)
+	}
+
+	if _, err := c.Connected(1, 6); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+
+	_ = c.RemoveEdge(2, 3)
+
+	if !c.Stale() {
+		t.Fatalf("expected index to be stale after removing an edge")
+	}
+
+	if _, err := c.Connected(1, 5); !errors.Is(err, ErrStaleIndex) {
+		t.Errorf("expected error %v, got %v", ErrStaleIndex, err)
+	}
+
+	if _, err := c.Components(); !errors.Is(err, ErrStaleIndex) {
+		t.Errorf("expected error %v, got %v", ErrStaleIndex, err)
+	}
+
+	if err := c.Rebuild(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertConnected(1, 2, true)
+	assertConnected(1, 5, false)
+	assertComponents(2)
+
+	if _, err := NewConnectivityIndex(New(IntHash, Directed())); err == nil {
+		t.Errorf("expected error for directed graph, got nil")
+	}
+}
+
+func TestConnectivityIndex_Random(t *testing.T) {
+	const n = 60
+
+	rng := rand.New(rand.NewSource(1))
+
+	g := New(IntHash)
+	c, _ := NewConnectivityIndex(g)
+
+	for i := 0; i < n; i++ {
+		_ = c.AddVertex(i)
+	}
+
+	for i := 0; i < 50; i++ {
+		_ = c.AddEdge(rng.Intn(n), rng.Intn(n))
+
+		for j := 0; j < 10; j++ {
+			a, b := rng.Intn(n), rng.Intn(n)
+
+			connected, _ := c.Connected(a, b)
+
+			path, err := ShortestPath(g, a, b)
+			expected := err == nil && len(path) > 0
+
+			if connected != expected {
+				t.Fatalf("expected connected(%d, %d) to be %v, got %v", a, b, expected, connected)
+			}
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package graph
+
+import "context"
+
+// cancellationInterval is the number of steps after which a long-running
+// algorithm checks whether its context has been cancelled. Checking the
+// context in every step would slow down tight loops noticeably.
+const cancellationInterval = 1024
+
+// cancellation keeps track of the steps performed by an algorithm that can be
+// cancelled using a context.
+type cancellation struct {
+	ctx   context.Context
+	steps int
+}
+
+// check counts a step and returns the error of the context if it has been
+// cancelled. The context is checked in the first step and then only every
+// cancellationInterval steps.
+func (c *cancellation) check() error {
+	step := c.steps
+	c.steps++
+
+	if step%cancellationInterval != 0 {
+		return nil
+	}
+
+	return c.ctx.Err()
+}
@@ -0,0 +1,407 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// ContractionHierarchy answers shortest path queries on a static graph orders
+// of magnitude faster than [ShortestPath], at the cost of a preprocessing step.
+// This makes it a good fit for applications like route planning that issue
+// millions of queries on the same graph:
+//
+//	ch, _ := graph.NewContractionHierarchy(g)
+//
+//	path, _ := ch.ShortestPath("A", "B")
+//	distance, _ := ch.Distance("A", "B")
+//
+// During preprocessing, the vertices are contracted one after another, starting
+// with the least important ones. Contracting a vertex removes it from the graph
+// and adds a shortcut edge between two of its neighbors whenever the only
+// shortest path between them leads through the contracted vertex. The order of
+// contraction determines the rank of each vertex. A query then runs a
+// bidirectional search that only follows edges towards vertices with a higher
+// rank, which visits only a small fraction of the graph.
+//
+// The hierarchy reflects the state of the graph at the time it has been built,
+// and it has to be built again after the graph has changed.
+type ContractionHierarchy[K comparable] struct {
+	indices map[K]int
+	hashes  []K
+	// upward contains the edges of each vertex to vertices with a higher rank,
+	// and downward contains the edges from vertices with a higher rank to each
+	// vertex, reversed. Both include the shortcuts.
+	upward   [][]contractionArc
+	downward [][]contractionArc
+	// middles contains the contracted vertex of each shortcut, which is used to
+	// unpack the shortcut into the original edges.
+	middles map[[2]int]int
+}
+
+// contractionArc is an edge or shortcut to the given vertex in a contraction
+// hierarchy.
+type contractionArc struct {
+	vertex int
+	weight float64
+}
+
+// maxWitnessSettled limits the number of vertices settled by a witness search
+// during the preprocessing. If the search is aborted, a shortcut is added even
+// though it might not be necessary, which doesn't affect the correctness of
+// queries but keeps the preprocessing fast.
+const maxWitnessSettled = 500
+
+// NewContractionHierarchy builds a contraction hierarchy for the given graph.
+// Like in [ShortestPath], each edge of an unweighted graph has a weight of 1.
+// Negative edge weights are rejected with an error.
+//
+// The vertices are contracted in the order of their edge difference, which is
+// the number of shortcuts added by contracting a vertex minus the number of
+// its edges, plus the number of its neighbors that have already been
+// contracted. This keeps the number of shortcuts small and spreads the ranks
+// evenly across the graph, so that queries only visit few vertices.
+func NewContractionHierarchy[K comparable, T any](g Graph[K, T]) (*ContractionHierarchy[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	c := &ContractionHierarchy[K]{
+		indices: make(map[K]int, len(adjacencyMap)),
+		hashes:  make([]K, 0, len(adjacencyMap)),
+		middles: make(map[[2]int]int),
+	}
+
+	for hash := range adjacencyMap {
+		c.indices[hash] = len(c.hashes)
+		c.hashes = append(c.hashes, hash)
+	}
+
+	n := len(c.hashes)
+
+	// out and in contain the weight of the shortest edge or shortcut between
+	// each pair of adjacent vertices, in both directions.
+	out := make([]map[int]float64, n)
+	in := make([]map[int]float64, n)
+
+	for i := range c.hashes {
+		out[i] = make(map[int]float64)
+		in[i] = make(map[int]float64)
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if source == target {
+				continue
+			}
+
+			weight := 1.0
+
+			if isWeighted {
+				if edge.Properties.Weight < 0 {
+					return nil, fmt.Errorf("edge (%v, %v) has a negative weight", source, target)
+				}
+				weight = float64(edge.Properties.Weight)
+			}
+
+			out[c.indices[source]][c.indices[target]] = weight
+			in[c.indices[target]][c.indices[source]] = weight
+		}
+	}
+
+	ranks := make([]int, n)
+	contracted := make([]bool, n)
+	deleted := make([]int, n)
+
+	// shortcutsOf determines the shortcuts that are required when contracting
+	// the given vertex, using a witness search from each ingoing neighbor.
+	shortcutsOf := func(vertex int) [][3]float64 {
+		shortcuts := make([][3]float64, 0)
+
+		for source, inWeight := range in[vertex] {
+			if contracted[source] {
+				continue
+			}
+
+			limit := 0.0
+			for target, outWeight := range out[vertex] {
+				if !contracted[target] && target != source && inWeight+outWeight > limit {
+					limit = inWeight + outWeight
+				}
+			}
+
+			distances := witnessSearch(out, contracted, source, vertex, limit)
+
+			for target, outWeight := range out[vertex] {
+				if contracted[target] || target == source {
+					continue
+				}
+
+				weight := inWeight + outWeight
+
+				if distance, ok := distances[target]; !ok || distance > weight {
+					shortcuts = append(shortcuts, [3]float64{float64(source), float64(target), weight})
+				}
+			}
+		}
+
+		return shortcuts
+	}
+
+	priorityOf := func(vertex int) float64 {
+		edges := 0
+
+		for adjacency := range out[vertex] {
+			if !contracted[adjacency] {
+				edges++
+			}
+		}
+
+		for adjacency := range in[vertex] {
+			if !contracted[adjacency] {
+				edges++
+			}
+		}
+
+		return float64(len(shortcutsOf(vertex)) - edges + deleted[vertex])
+	}
+
+	queue := newPriorityQueue[int]()
+
+	for vertex := 0; vertex < n; vertex++ {
+		queue.Push(vertex, priorityOf(vertex))
+	}
+
+	for rank := 0; queue.Len() > 0; {
+		vertex, _ := queue.Pop()
+
+		// The priorities of the remaining vertices are updated lazily: If the
+		// priority has increased since it has been computed, the vertex is
+		// put back into the queue.
+		if priority := priorityOf(vertex); priority > queue.MinPriority() {
+			queue.Push(vertex, priority)
+			continue
+		}
+
+		for _, shortcut := range shortcutsOf(vertex) {
+			source, target, weight := int(shortcut[0]), int(shortcut[1]), shortcut[2]
+
+			if current, ok := out[source][target]; ok && current <= weight {
+				continue
+			}
+
+			out[source][target] = weight
+			in[target][source] = weight
+			c.middles[[2]int{source, target}] = vertex
+		}
+
+		contracted[vertex] = true
+		ranks[vertex] = rank
+		rank++
+
+		for adjacency := range out[vertex] {
+			deleted[adjacency]++
+		}
+
+		for adjacency := range in[vertex] {
+			deleted[adjacency]++
+		}
+	}
+
+	c.upward = make([][]contractionArc, n)
+	c.downward = make([][]contractionArc, n)
+
+	for source := range out {
+		for target, weight := range out[source] {
+			if ranks[source] < ranks[target] {
+				c.upward[source] = append(c.upward[source], contractionArc{vertex: target, weight: weight})
+			} else {
+				c.downward[target] = append(c.downward[target], contractionArc{vertex: source, weight: weight})
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// witnessSearch runs Dijkstra's algorithm from the given source vertex on the
+// vertices that haven't been contracted yet, ignoring the excluded vertex. The
+// search stops at vertices whose distance exceeds the given limit or after it
+// has settled maxWitnessSettled vertices.
+func witnessSearch(out []map[int]float64, contracted []bool, source, excluded int, limit float64) map[int]float64 {
+	distances := map[int]float64{source: 0}
+	settled := 0
+
+	queue := newPriorityQueue[int]()
+	queue.Push(source, 0)
+
+	for queue.Len() > 0 && settled < maxWitnessSettled {
+		if queue.MinPriority() > limit {
+			break
+		}
+
+		vertex, _ := queue.Pop()
+		settled++
+
+		for adjacency, weight := range out[vertex] {
+			if contracted[adjacency] || adjacency == excluded {
+				continue
+			}
+
+			distance := distances[vertex] + weight
+
+			current, ok := distances[adjacency]
+			if ok && distance >= current {
+				continue
+			}
+
+			distances[adjacency] = distance
+
+			if ok {
+				queue.UpdatePriority(adjacency, distance)
+			} else {
+				queue.Push(adjacency, distance)
+			}
+		}
+	}
+
+	return distances
+}
+
+// ShortestPath returns the shortest path from the source to the target vertex
+// in the same format as [ShortestPath] does. If the target is not reachable
+// from the source, ErrTargetNotReachable will be returned. If one of the
+// vertices is not contained in the hierarchy, the returned error wraps
+// ErrVertexNotFound.
+func (c *ContractionHierarchy[K]) ShortestPath(source, target K) ([]K, error) {
+	vertices, _, err := c.query(source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	path := make([]K, len(vertices))
+	for i, vertex := range vertices {
+		path[i] = c.hashes[vertex]
+	}
+
+	return path, nil
+}
+
+// Distance returns the total weight of the shortest path from the source to the
+// target vertex. If the target is not reachable from the source, the returned
+// error is ErrTargetNotReachable. If one of the vertices is not contained in
+// the hierarchy, the returned error wraps ErrVertexNotFound.
+func (c *ContractionHierarchy[K]) Distance(source, target K) (int, error) {
+	_, distance, err := c.query(source, target)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(distance), nil
+}
+
+// query runs a bidirectional search from the source and target vertex, where
+// the forward search follows the upward edges and the backward search follows
+// the downward edges. Both searches meet at the vertex with the highest rank on
+// the shortest path. It returns the unpacked path and its total weight.
+func (c *ContractionHierarchy[K]) query(source, target K) ([]int, float64, error) {
+	from, ok := c.indices[source]
+	if !ok {
+		return nil, 0, fmt.Errorf("could not get vertex with hash %v: %w", source, ErrVertexNotFound)
+	}
+
+	to, ok := c.indices[target]
+	if !ok {
+		return nil, 0, fmt.Errorf("could not get vertex with hash %v: %w", target, ErrVertexNotFound)
+	}
+
+	forwardDistances, forwardPredecessors := c.search(from, c.upward)
+	backwardDistances, backwardPredecessors := c.search(to, c.downward)
+
+	best := math.Inf(1)
+	meeting := -1
+
+	for vertex, forwardDistance := range forwardDistances {
+		backwardDistance, ok := backwardDistances[vertex]
+		if ok && forwardDistance+backwardDistance < best {
+			best = forwardDistance + backwardDistance
+			meeting = vertex
+		}
+	}
+
+	if meeting == -1 {
+		return nil, 0, ErrTargetNotReachable
+	}
+
+	// The predecessors of the forward search lead from the meeting vertex back
+	// to the source, and those of the backward search lead to the target.
+	arcs := make([][2]int, 0)
+
+	for current := meeting; current != from; current = forwardPredecessors[current] {
+		arcs = append([][2]int{{forwardPredecessors[current], current}}, arcs...)
+	}
+
+	for current := meeting; current != to; current = backwardPredecessors[current] {
+		arcs = append(arcs, [2]int{current, backwardPredecessors[current]})
+	}
+
+	path := []int{from}
+
+	for _, arc := range arcs {
+		path = c.unpack(path, arc[0], arc[1])
+	}
+
+	return path, best, nil
+}
+
+// search runs Dijkstra's algorithm from the given vertex, only following the
+// given upward or downward edges. Because these edges form a directed acyclic
+// graph that only contains a small part of the original graph, the search can
+// run until all reachable vertices have been settled.
+func (c *ContractionHierarchy[K]) search(start int, arcs [][]contractionArc) (map[int]float64, map[int]int) {
+	distances := map[int]float64{start: 0}
+	predecessors := make(map[int]int)
+
+	queue := newPriorityQueue[int]()
+	queue.Push(start, 0)
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Pop()
+
+		for _, arc := range arcs[vertex] {
+			distance := distances[vertex] + arc.weight
+
+			current, ok := distances[arc.vertex]
+			if ok && distance >= current {
+				continue
+			}
+
+			distances[arc.vertex] = distance
+			predecessors[arc.vertex] = vertex
+
+			if ok {
+				queue.UpdatePriority(arc.vertex, distance)
+			} else {
+				queue.Push(arc.vertex, distance)
+			}
+		}
+	}
+
+	return distances, predecessors
+}
+
+// unpack appends the vertices of the given edge or shortcut to the path,
+// excluding the source vertex. Shortcuts are recursively replaced with the two
+// edges or shortcuts they have been created from.
+func (c *ContractionHierarchy[K]) unpack(path []int, source, target int) []int {
+	middle, ok := c.middles[[2]int{source, target}]
+	if !ok {
+		return append(path, target)
+	}
+
+	path = c.unpack(path, source, middle)
+
+	return c.unpack(path, middle, target)
+}
@@ -0,0 +1,184 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestContractionHierarchy(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		source           int
+		target           int
+		expectedPath     []int
+		expectedDistance int
+		expectedErr      error
+	}{
+		"directed graph with direct edge": {
+			isDirected:       true,
+			source:           1,
+			target:           3,
+			expectedPath:     []int{1, 3},
+			expectedDistance: 1,
+		},
+		"directed graph against edge direction": {
+			isDirected:  true,
+			source:      3,
+			target:      1,
+			expectedErr: ErrTargetNotReachable,
+		},
+		"undirected graph via another vertex": {
+			isDirected:       false,
+			source:           2,
+			target:           1,
+			expectedPath:     []int{2, 1},
+			expectedDistance: 3,
+		},
+		"undirected graph with longer path": {
+			isDirected:       false,
+			source:           1,
+			target:           4,
+			expectedPath:     []int{1, 3, 4},
+			expectedDistance: 6,
+		},
+		"isolated vertex": {
+			isDirected:  false,
+			source:      1,
+			target:      5,
+			expectedErr: ErrTargetNotReachable,
+		},
+		"source equal to target": {
+			isDirected:       true,
+			source:           3,
+			target:           3,
+			expectedPath:     []int{3},
+			expectedDistance: 0,
+		},
+		"missing vertex": {
+			isDirected:  true,
+			source:      1,
+			target:      10,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		for graphName, g := range newNeighborsGraphs(t, test.isDirected) {
+			t.Run(name+" with "+graphName, func(t *testing.T) {
+				ch, err := NewContractionHierarchy(g)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				path, err := ch.ShortestPath(test.source, test.target)
+
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				if !reflect.DeepEqual(path, test.expectedPath) {
+					t.Errorf("expected path %v, got %v", test.expectedPath, path)
+				}
+
+				distance, err := ch.Distance(test.source, test.target)
+
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				if distance != test.expectedDistance {
+					t.Errorf("expected distance %d, got %d", test.expectedDistance, distance)
+				}
+			})
+		}
+	}
+}
+
+func TestContractionHierarchy_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for _, traits := range [][]func(*Traits){
+		{Directed(), Weighted()},
+		{Weighted()},
+		{Directed()},
+	} {
+		g := New(IntHash, traits...)
+		n := 60
+
+		for vertex := 0; vertex < n; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for i := 0; i < 180; i++ {
+			_ = g.AddEdge(random.Intn(n), random.Intn(n), EdgeWeight(random.Intn(10)))
+		}
+
+		ch, err := NewContractionHierarchy(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for source := 0; source < n; source++ {
+			paths, err := ShortestPathTree(g, source)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for target := 0; target < n; target++ {
+				expected, expectedErr := paths.Distance(target)
+
+				distance, err := ch.Distance(source, target)
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("expected error %v from %d to %d, got %v", expectedErr, source, target, err)
+				}
+
+				if distance != expected {
+					t.Fatalf("expected distance %d from %d to %d, got %d", expected, source, target, distance)
+				}
+
+				if err != nil {
+					continue
+				}
+
+				path, _ := ch.ShortestPath(source, target)
+
+				if path[0] != source || path[len(path)-1] != target {
+					t.Fatalf("expected path from %d to %d, got %v", source, target, path)
+				}
+
+				weight := 0
+
+				for i := 1; i < len(path); i++ {
+					edge, err := g.Edge(path[i-1], path[i])
+					if err != nil {
+						t.Fatalf("expected edge (%d, %d) in path %v: %v", path[i-1], path[i], path, err)
+					}
+
+					if g.Traits().IsWeighted {
+						weight += edge.Properties.Weight
+					} else {
+						weight++
+					}
+				}
+
+				if weight != expected {
+					t.Fatalf("expected path of weight %d from %d to %d, got %v with weight %d", expected, source, target, path, weight)
+				}
+			}
+		}
+	}
+}
+
+func TestContractionHierarchy_negativeWeight(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2, EdgeWeight(-1))
+
+	if _, err := NewContractionHierarchy(g); err == nil {
+		t.Error("expected error for negative edge weight, got nil")
+	}
+}
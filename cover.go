@@ -0,0 +1,154 @@
+package graph
+
+import "fmt"
+
+// ApproximateMinimumVertexCover computes a small vertex cover of the given
+// graph. A vertex cover is a set of vertices such that each edge has at least
+// one of its vertices in the set. The direction of the edges is ignored, and
+// vertices with a self-loop are always part of the cover.
+//
+// Finding a minimum vertex cover is NP-hard, so the classic approximation is
+// used instead: For each edge whose vertices aren't covered yet, both vertices
+// are added to the cover. The resulting cover is at most twice as large as a
+// minimum vertex cover, and it is computed in O(|V|+|E|) time. To find a
+// minimum vertex cover in small graphs, use [MinimumVertexCover].
+//
+// The vertices that aren't part of a vertex cover form an independent set and
+// vice versa, see [ApproximateMaximumIndependentSet].
+func ApproximateMinimumVertexCover[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	hashes, edges, selfLoops, err := coverEdges(g)
+	if err != nil {
+		return nil, err
+	}
+
+	isCovered := make([]bool, len(hashes))
+
+	for _, vertex := range selfLoops {
+		isCovered[vertex] = true
+	}
+
+	coverEdgesGreedily(edges, isCovered)
+
+	return coverOf(hashes, isCovered), nil
+}
+
+// MinimumVertexCover computes a minimum vertex cover of the given graph, i.e. a
+// vertex cover with the smallest possible number of vertices. Vertex covers are
+// defined the same way as in [ApproximateMinimumVertexCover].
+//
+// MinimumVertexCover uses a branch and bound search: For each edge that isn't
+// covered yet, both of its vertices are tried. Branches that can't yield a
+// smaller cover than the best cover found so far are skipped. The search takes
+// O(2^c*|E|) time, where c is the size of the minimum vertex cover, which
+// makes it only suitable for small graphs or graphs with small vertex covers.
+// If there are several minimum vertex covers, an arbitrary one is returned.
+func MinimumVertexCover[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	hashes, edges, selfLoops, err := coverEdges(g)
+	if err != nil {
+		return nil, err
+	}
+
+	isCovered := make([]bool, len(hashes))
+	size := 0
+
+	for _, vertex := range selfLoops {
+		isCovered[vertex] = true
+		size++
+	}
+
+	// The approximated cover serves as the initial upper bound.
+	best := make([]bool, len(hashes))
+	copy(best, isCovered)
+	bestSize := size + coverEdgesGreedily(edges, best)
+
+	var search func(i int)
+
+	search = func(i int) {
+		for i < len(edges) && (isCovered[edges[i][0]] || isCovered[edges[i][1]]) {
+			i++
+		}
+
+		if i == len(edges) {
+			if size < bestSize {
+				copy(best, isCovered)
+				bestSize = size
+			}
+			return
+		}
+
+		if size+1 >= bestSize {
+			return
+		}
+
+		for _, vertex := range edges[i] {
+			isCovered[vertex] = true
+			size++
+
+			search(i + 1)
+
+			isCovered[vertex] = false
+			size--
+		}
+	}
+
+	search(0)
+
+	return coverOf(hashes, best), nil
+}
+
+// coverEdges returns the vertex hashes of g, all edges between distinct
+// vertices as pairs of vertex indices, and the indices of all vertices with a
+// self-loop. Each edge is only contained once, regardless of its direction.
+func coverEdges[K comparable, T any](g Graph[K, T]) ([]K, [][2]int, []int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	hashes, neighbors := undirectedNeighbors(adjacencyMap)
+
+	edges := make([][2]int, 0)
+	selfLoops := make([]int, 0)
+
+	for vertex, adjacencies := range neighbors {
+		if _, ok := adjacencyMap[hashes[vertex]][hashes[vertex]]; ok {
+			selfLoops = append(selfLoops, vertex)
+		}
+
+		for _, adjacency := range adjacencies {
+			if vertex < adjacency {
+				edges = append(edges, [2]int{vertex, adjacency})
+			}
+		}
+	}
+
+	return hashes, edges, selfLoops, nil
+}
+
+// coverEdgesGreedily adds both vertices of each edge that isn't covered yet to
+// the cover and returns the number of added vertices.
+func coverEdgesGreedily(edges [][2]int, isCovered []bool) int {
+	added := 0
+
+	for _, edge := range edges {
+		if !isCovered[edge[0]] && !isCovered[edge[1]] {
+			isCovered[edge[0]], isCovered[edge[1]] = true, true
+			added += 2
+		}
+	}
+
+	return added
+}
+
+// coverOf returns the hashes of all covered vertices.
+func coverOf[K comparable](hashes []K, isCovered []bool) []K {
+	cover := make([]K, 0)
+
+	for vertex, hash := range hashes {
+		if isCovered[vertex] {
+			cover = append(cover, hash)
+		}
+	}
+
+	return cover
+}
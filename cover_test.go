@@ -0,0 +1,153 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMinimumVertexCover(t *testing.T) {
+	tests := map[string]struct {
+		isDirected   bool
+		vertices     []int
+		edges        []Edge[int]
+		expectedSize int
+	}{
+		"empty graph": {
+			expectedSize: 0,
+		},
+		"star": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 1, Target: 5},
+			},
+			expectedSize: 1,
+		},
+		"path": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			expectedSize: 2,
+		},
+		"directed graph with self-loop": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 3, Target: 3},
+			},
+			expectedSize: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target)
+			}
+
+			cover, err := MinimumVertexCover(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(cover) != test.expectedSize {
+				t.Errorf("expected cover of size %d, got %v", test.expectedSize, cover)
+			}
+
+			if !isVertexCover(g, cover) {
+				t.Errorf("expected %v to be a vertex cover", cover)
+			}
+
+			approximation, err := ApproximateMinimumVertexCover(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(approximation) > 2*test.expectedSize {
+				t.Errorf("expected cover of size at most %d, got %v", 2*test.expectedSize, approximation)
+			}
+
+			if !isVertexCover(g, approximation) {
+				t.Errorf("expected %v to be a vertex cover", approximation)
+			}
+		})
+	}
+}
+
+func TestMinimumVertexCover_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10; i++ {
+		g := New(IntHash)
+		n := 12
+
+		for vertex := 0; vertex < n; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for j := 0; j < 20; j++ {
+			_ = g.AddEdge(random.Intn(n), random.Intn(n))
+		}
+
+		expectedSize := n
+
+		for subset := 0; subset < 1<<n; subset++ {
+			cover := make([]int, 0)
+			for vertex := 0; vertex < n; vertex++ {
+				if subset&(1<<vertex) != 0 {
+					cover = append(cover, vertex)
+				}
+			}
+
+			if len(cover) < expectedSize && isVertexCover(g, cover) {
+				expectedSize = len(cover)
+			}
+		}
+
+		cover, _ := MinimumVertexCover(g)
+
+		if len(cover) != expectedSize || !isVertexCover(g, cover) {
+			t.Fatalf("expected vertex cover of size %d, got %v", expectedSize, cover)
+		}
+
+		approximation, _ := ApproximateMinimumVertexCover(g)
+
+		if len(approximation) > 2*expectedSize || !isVertexCover(g, approximation) {
+			t.Fatalf("expected vertex cover of size at most %d, got %v", 2*expectedSize, approximation)
+		}
+	}
+}
+
+func isVertexCover(g Graph[int, int], cover []int) bool {
+	isCovered := make(map[int]bool)
+	for _, vertex := range cover {
+		isCovered[vertex] = true
+	}
+
+	edges, _ := g.Edges()
+
+	for _, edge := range edges {
+		if !isCovered[edge.Source] && !isCovered[edge.Target] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,281 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SimpleCycles enumerates all simple cycles of the given directed graph. A
+// simple cycle is a path that starts and ends at the same vertex and doesn't
+// visit any other vertex more than once. Each cycle is passed to the visit
+// function as soon as it has been found. If visit returns true, the enumeration
+// stops, which can be used to limit the number of cycles:
+//
+//	// Report the first 10 dependency cycles.
+//	count := 0
+//
+//	_ = graph.SimpleCycles(g, func(cycle []string) bool {
+//		fmt.Println(strings.Join(cycle, " -> "))
+//		count++
+//		return count == 10
+//	})
+//
+// Each cycle contains its vertices in the order of its edges, where the last
+// vertex has an edge back to the first one. The first vertex isn't repeated at
+// the end of the cycle. Each cycle is passed to visit exactly once, starting at
+// an arbitrary vertex, as a new slice that may be retained. A self-loop forms a
+// cycle consisting of a single vertex.
+//
+// SimpleCycles uses Johnson's algorithm, which takes O((|V|+|E|)*(c+1)) time,
+// where c is the number of cycles. Since a graph may have exponentially many
+// cycles, limiting the number of cycles is recommended for large graphs.
+//
+// SimpleCycles can only be used with directed graphs. For undirected graphs,
+// each edge would form a cycle in both directions.
+func SimpleCycles[K comparable, T any](g Graph[K, T], visit func(cycle []K) bool) error {
+	return SimpleCyclesContext(context.Background(), g, visit)
+}
+
+// SimpleCyclesContext works like [SimpleCycles], but stops the enumeration as
+// soon as the given context is cancelled and returns the error of the context.
+// The cycles that have been passed to visit until then remain valid.
+func SimpleCyclesContext[K comparable, T any](ctx context.Context, g Graph[K, T], visit func(cycle []K) bool) error {
+	if !g.Traits().IsDirected {
+		return errors.New("simple cycles cannot be enumerated in undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	indices := make(map[K]int, len(adjacencyMap))
+	hashes := make([]K, 0, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		indices[hash] = len(hashes)
+		hashes = append(hashes, hash)
+	}
+
+	successors := make([][]int, len(hashes))
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			successors[indices[source]] = append(successors[indices[source]], indices[target])
+		}
+	}
+
+	e := cycleEnumeration[K]{
+		hashes:     hashes,
+		successors: successors,
+		visit:      visit,
+		cancel:     cancellation{ctx: ctx},
+		isAllowed:  make([]bool, len(hashes)),
+		isBlocked:  make([]bool, len(hashes)),
+		blocked:    make([]map[int]struct{}, len(hashes)),
+	}
+
+	all := make([]int, len(hashes))
+	for i := range all {
+		all[i] = i
+	}
+
+	// Each cycle is contained in a strongly connected component. After all
+	// cycles through the start vertex of a component have been enumerated, the
+	// start vertex is removed and the remaining part of the component is split
+	// into its strongly connected components again.
+	components := e.components(all)
+
+	for len(components) > 0 {
+		component := components[len(components)-1]
+		components = components[:len(components)-1]
+
+		if len(component) == 1 && !e.hasSelfLoop(component[0]) {
+			continue
+		}
+
+		for _, vertex := range component {
+			e.isAllowed[vertex] = true
+			e.isBlocked[vertex] = false
+			e.blocked[vertex] = make(map[int]struct{})
+		}
+
+		e.start = component[0]
+
+		if stop, _ := e.search(e.start); stop {
+			return e.err
+		}
+
+		for _, vertex := range component {
+			e.isAllowed[vertex] = false
+		}
+
+		components = append(components, e.components(component[1:])...)
+	}
+
+	return nil
+}
+
+// cycleEnumeration holds the state of Johnson's algorithm. Vertices are
+// represented by their indices, and only the vertices of the current component
+// are allowed in the search.
+type cycleEnumeration[K comparable] struct {
+	hashes     []K
+	successors [][]int
+	visit      func(cycle []K) bool
+	cancel     cancellation
+	err        error
+	start      int
+	stack      []int
+	isAllowed  []bool
+	isBlocked  []bool
+	// blocked contains the vertices that have to be unblocked together with
+	// each vertex, because they have been blocked while searching from it.
+	blocked []map[int]struct{}
+}
+
+// search performs the recursive search of Johnson's algorithm. It returns
+// whether the enumeration has been stopped and whether a cycle through the
+// given vertex has been found. If no cycle has been found, the vertex stays
+// blocked until one of its successors is unblocked. If the context has been
+// cancelled, the enumeration is stopped and the error of the context is stored.
+func (e *cycleEnumeration[K]) search(vertex int) (bool, bool) {
+	if e.err = e.cancel.check(); e.err != nil {
+		return true, false
+	}
+
+	isFound := false
+
+	e.stack = append(e.stack, vertex)
+	e.isBlocked[vertex] = true
+
+	for _, successor := range e.successors[vertex] {
+		if !e.isAllowed[successor] {
+			continue
+		}
+
+		if successor == e.start {
+			cycle := make([]K, len(e.stack))
+			for i, index := range e.stack {
+				cycle[i] = e.hashes[index]
+			}
+
+			if e.visit(cycle) {
+				return true, true
+			}
+
+			isFound = true
+		} else if !e.isBlocked[successor] {
+			stop, found := e.search(successor)
+			if stop {
+				return true, true
+			}
+
+			isFound = isFound || found
+		}
+	}
+
+	if isFound {
+		e.unblock(vertex)
+	} else {
+		for _, successor := range e.successors[vertex] {
+			if e.isAllowed[successor] {
+				e.blocked[successor][vertex] = struct{}{}
+			}
+		}
+	}
+
+	e.stack = e.stack[:len(e.stack)-1]
+
+	return false, isFound
+}
+
+// unblock unblocks the given vertex and all vertices that have been blocked
+// because of it.
+func (e *cycleEnumeration[K]) unblock(vertex int) {
+	e.isBlocked[vertex] = false
+
+	for blocked := range e.blocked[vertex] {
+		delete(e.blocked[vertex], blocked)
+
+		if e.isBlocked[blocked] {
+			e.unblock(blocked)
+		}
+	}
+}
+
+func (e *cycleEnumeration[K]) hasSelfLoop(vertex int) bool {
+	for _, successor := range e.successors[vertex] {
+		if successor == vertex {
+			return true
+		}
+	}
+
+	return false
+}
+
+// components computes the strongly connected components of the subgraph
+// induced by the given vertices using Tarjan's algorithm.
+func (e *cycleEnumeration[K]) components(vertices []int) [][]int {
+	isIncluded := make(map[int]bool, len(vertices))
+	for _, vertex := range vertices {
+		isIncluded[vertex] = true
+	}
+
+	indices := make(map[int]int, len(vertices))
+	lowlinks := make(map[int]int, len(vertices))
+	onStack := make(map[int]bool)
+	stack := make([]int, 0)
+	components := make([][]int, 0)
+
+	var connect func(vertex int)
+	connect = func(vertex int) {
+		indices[vertex] = len(indices)
+		lowlinks[vertex] = indices[vertex]
+		stack = append(stack, vertex)
+		onStack[vertex] = true
+
+		for _, successor := range e.successors[vertex] {
+			if !isIncluded[successor] {
+				continue
+			}
+
+			if _, ok := indices[successor]; !ok {
+				connect(successor)
+				if lowlinks[successor] < lowlinks[vertex] {
+					lowlinks[vertex] = lowlinks[successor]
+				}
+			} else if onStack[successor] && indices[successor] < lowlinks[vertex] {
+				lowlinks[vertex] = indices[successor]
+			}
+		}
+
+		if lowlinks[vertex] != indices[vertex] {
+			return
+		}
+
+		component := make([]int, 0)
+
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+
+			if top == vertex {
+				break
+			}
+		}
+
+		components = append(components, component)
+	}
+
+	for _, vertex := range vertices {
+		if _, ok := indices[vertex]; !ok {
+			connect(vertex)
+		}
+	}
+
+	return components
+}
@@ -0,0 +1,238 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestSimpleCycles(t *testing.T) {
+	tests := map[string]struct {
+		vertices       []int
+		edges          []Edge[int]
+		expectedCycles [][]int
+	}{
+		"empty graph": {
+			expectedCycles: [][]int{},
+		},
+		"acyclic graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+			expectedCycles: [][]int{},
+		},
+		"overlapping cycles and self-loop": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 2, Target: 1},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 2},
+				{Source: 5, Target: 5},
+			},
+			expectedCycles: [][]int{{1, 2}, {1, 2, 3}, {2, 3, 4}, {5}},
+		},
+		"complete graph": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 2},
+			},
+			expectedCycles: [][]int{{1, 2}, {1, 3}, {2, 3}, {1, 2, 3}, {1, 3, 2}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target)
+			}
+
+			cycles := make([][]int, 0)
+
+			err := SimpleCycles(g, func(cycle []int) bool {
+				cycles = append(cycles, cycle)
+				return false
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !cyclesAreEqual(cycles, test.expectedCycles) {
+				t.Errorf("expected cycles %v, got %v", test.expectedCycles, cycles)
+			}
+		})
+	}
+}
+
+func TestSimpleCycles_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10; i++ {
+		g := New(IntHash, Directed())
+		n := 8
+
+		for vertex := 0; vertex < n; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for j := 0; j < 20; j++ {
+			_ = g.AddEdge(random.Intn(n), random.Intn(n))
+		}
+
+		cycles := make([][]int, 0)
+
+		_ = SimpleCycles(g, func(cycle []int) bool {
+			cycles = append(cycles, cycle)
+			return false
+		})
+
+		// Each simple cycle is a simple path from its smallest vertex to a
+		// predecessor of it, which only visits larger vertices.
+		expected := make([][]int, 0)
+
+		for start := 0; start < n; start++ {
+			if _, err := g.Edge(start, start); err == nil {
+				expected = append(expected, []int{start})
+			}
+
+			for end := start + 1; end < n; end++ {
+				if _, err := g.Edge(end, start); err != nil {
+					continue
+				}
+
+				_ = AllPaths(g, start, end, 0, func(path []int) bool {
+					for _, vertex := range path[1:] {
+						if vertex < start {
+							return false
+						}
+					}
+					expected = append(expected, path)
+					return false
+				})
+			}
+		}
+
+		if !cyclesAreEqual(cycles, expected) {
+			t.Fatalf("expected cycles %v, got %v", expected, cycles)
+		}
+	}
+}
+
+func TestSimpleCycles_stop(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for vertex := 1; vertex <= 5; vertex++ {
+		_ = g.AddVertex(vertex)
+		_ = g.AddEdge(vertex, vertex)
+	}
+
+	count := 0
+
+	_ = SimpleCycles(g, func([]int) bool {
+		count++
+		return count == 2
+	})
+
+	if count != 2 {
+		t.Errorf("expected enumeration to stop after 2 cycles, got %d", count)
+	}
+}
+
+func TestSimpleCyclesContext(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	// A complete directed graph has far more cycles than the number of steps
+	// after which the context is checked.
+	for source := 0; source < 8; source++ {
+		_ = g.AddVertex(source)
+	}
+
+	for source := 0; source < 8; source++ {
+		for target := 0; target < 8; target++ {
+			if source != target {
+				_ = g.AddEdge(source, target)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+
+	err := SimpleCyclesContext(ctx, g, func([]int) bool {
+		count++
+		cancel()
+		return false
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error %v, got %v", context.Canceled, err)
+	}
+
+	if count > cancellationInterval {
+		t.Errorf("expected enumeration to stop after at most %d cycles, got %d", cancellationInterval, count)
+	}
+
+	if err := SimpleCyclesContext(ctx, g, func([]int) bool { return false }); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected error %v for cancelled context, got %v", context.Canceled, err)
+	}
+}
+
+func TestSimpleCycles_undirected(t *testing.T) {
+	if err := SimpleCycles(New(IntHash), func([]int) bool { return false }); err == nil {
+		t.Error("expected error for undirected graph, got nil")
+	}
+}
+
+// cyclesAreEqual reports whether both slices contain the same cycles, where
+// each cycle may start at an arbitrary vertex.
+func cyclesAreEqual(a, b [][]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	keys := make(map[string]int)
+
+	key := func(cycle []int) string {
+		smallest := 0
+		for i, vertex := range cycle {
+			if vertex < cycle[smallest] {
+				smallest = i
+			}
+		}
+		return fmt.Sprint(append(append([]int(nil), cycle[smallest:]...), cycle[:smallest]...))
+	}
+
+	for _, cycle := range a {
+		keys[key(cycle)]++
+	}
+
+	for _, cycle := range b {
+		keys[key(cycle)]--
+	}
+
+	for _, count := range keys {
+		if count != 0 {
+			return false
+		}
+	}
+
+	return true
+}
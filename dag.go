@@ -13,7 +13,8 @@ import (
 //
 // Note that TopologicalSort doesn't make any guarantees about the order. If there
 // are multiple valid topological orderings, an arbitrary one will be returned.
-// To make the output deterministic, use [StableTopologicalSort].
+// To make the output deterministic, use [StableTopologicalSort] or a store
+// created by [NewOrderedStore].
 //
 // TopologicalSort only works for directed acyclic graphs. This implementation
 // works non-recursively and utilizes Kahn's algorithm.
@@ -32,10 +33,11 @@ func TopologicalSort[K comparable, T any](g Graph[K, T]) ([]K, error) {
 		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
 	}
 
+	vertices := orderedVertices(orderOf(g), predecessorMap)
 	queue := make([]K, 0)
 
-	for vertex, predecessors := range predecessorMap {
-		if len(predecessors) == 0 {
+	for _, vertex := range vertices {
+		if len(predecessorMap[vertex]) == 0 {
 			queue = append(queue, vertex)
 		}
 	}
@@ -54,7 +56,8 @@ func TopologicalSort[K comparable, T any](g Graph[K, T]) ([]K, error) {
 		order = append(order, currentVertex)
 		visited[currentVertex] = struct{}{}
 
-		for vertex, predecessors := range predecessorMap {
+		for _, vertex := range vertices {
+			predecessors := predecessorMap[vertex]
 			delete(predecessors, currentVertex)
 
 			if len(predecessors) == 0 {
@@ -72,7 +75,18 @@ func TopologicalSort[K comparable, T any](g Graph[K, T]) ([]K, error) {
 
 // StableTopologicalSort does the same as [TopologicalSort], but takes a function
 // for comparing (and then ordering) two given vertices. This allows for a stable
-// and deterministic output even for graphs with multiple topological orderings.
+// and deterministic output even for graphs with multiple topological orderings,
+// such as reproducible build plans:
+//
+//	// Order vertices that don't depend on each other lexicographically.
+//	order, _ := graph.StableTopologicalSort(g, func(a, b string) bool {
+//		return a < b
+//	})
+//
+// Whenever a vertex has been appended to the order, all vertices that become
+// available by it are sorted using less and appended after the vertices that
+// have become available before. For the same graph and less function, the
+// order is always the same.
 func StableTopologicalSort[K comparable, T any](g Graph[K, T], less func(K, K) bool) ([]K, error) {
 	if !g.Traits().IsDirected {
 		return nil, fmt.Errorf("topological sort cannot be computed on undirected graph")
@@ -147,6 +161,78 @@ func StableTopologicalSort[K comparable, T any](g Graph[K, T], less func(K, K) b
 	return order, nil
 }
 
+// TopologicalGenerations groups the vertices of a directed acyclic graph into
+// generations, where each vertex only has predecessors in earlier generations.
+// The first generation contains all vertices without predecessors, and each
+// subsequent generation contains the vertices whose predecessors are all in
+// the generations before it:
+//
+//	generations, _ := graph.TopologicalGenerations(g)
+//
+//	for _, generation := range generations {
+//		// All tasks in a generation can be run in parallel.
+//		runInParallel(generation)
+//	}
+//
+// Each vertex is placed into the earliest possible generation, so the number
+// of generations is the number of vertices on the longest path. The order of
+// the vertices within a generation is arbitrary. For graphs with cycles, an
+// error is returned. TopologicalGenerations takes O(|V|+|E|) time.
+func TopologicalGenerations[K comparable, T any](g Graph[K, T]) ([][]K, error) {
+	if !g.Traits().IsDirected {
+		return nil, fmt.Errorf("topological generations cannot be computed on undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	inDegrees := make(map[K]int, len(predecessorMap))
+	generation := make([]K, 0)
+
+	for vertex, predecessors := range predecessorMap {
+		inDegrees[vertex] = len(predecessors)
+
+		if len(predecessors) == 0 {
+			generation = append(generation, vertex)
+		}
+	}
+
+	generations := make([][]K, 0)
+	count := 0
+
+	for len(generation) > 0 {
+		generations = append(generations, generation)
+		count += len(generation)
+
+		next := make([]K, 0)
+
+		for _, vertex := range generation {
+			for adjacency := range adjacencyMap[vertex] {
+				inDegrees[adjacency]--
+
+				if inDegrees[adjacency] == 0 {
+					next = append(next, adjacency)
+				}
+			}
+		}
+
+		generation = next
+	}
+
+	if count != len(adjacencyMap) {
+		return nil, errors.New("topological generations cannot be computed on graph with cycles")
+	}
+
+	return generations, nil
+}
+
 // TransitiveReduction returns a new graph with the same vertices and the same
 // reachability as the given graph, but with as few edges as possible. The graph
 // must be a directed acyclic graph.
@@ -216,3 +302,192 @@ func TransitiveReduction[K comparable, T any](g Graph[K, T]) (Graph[K, T], error
 
 	return transitiveReduction, nil
 }
+
+// LongestPath computes the longest path in the given directed acyclic graph,
+// that is, the path with the largest sum of edge weights among all paths. For
+// unweighted graphs, each edge has a weight of 1 like in [ShortestPath], so
+// the path with the most edges is returned. Should there be multiple longest
+// paths, an arbitrary one will be returned.
+//
+// The returned path is a slice of vertex hashes that starts and ends at
+// arbitrary vertices. Unlike shortest paths, longest paths can be computed
+// efficiently only in acyclic graphs, where LongestPath takes O(|V|+|E|) time.
+// For graphs with cycles, an error is returned. For an empty graph, the path
+// is empty.
+func LongestPath[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	order, err := TopologicalSort(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute topological order: %w", err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	if len(order) == 0 {
+		return []K{}, nil
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	// Each vertex starts a path of length 0 on its own. Processing the vertices
+	// in topological order guarantees that the longest path ending at a vertex
+	// is known before it is extended.
+	lengths := make(map[K]int, len(order))
+	predecessors := make(map[K]K)
+
+	for _, vertex := range order {
+		for adjacency, edge := range adjacencyMap[vertex] {
+			weight := 1
+			if isWeighted {
+				weight = edge.Properties.Weight
+			}
+
+			if lengths[vertex]+weight > lengths[adjacency] {
+				lengths[adjacency] = lengths[vertex] + weight
+				predecessors[adjacency] = vertex
+			}
+		}
+	}
+
+	end := order[0]
+
+	for _, vertex := range order {
+		if lengths[vertex] > lengths[end] {
+			end = vertex
+		}
+	}
+
+	path := []K{end}
+
+	for current, ok := predecessors[end]; ok; current, ok = predecessors[current] {
+		path = append([]K{current}, path...)
+	}
+
+	return path, nil
+}
+
+// Schedule is the result of the critical path method, as computed by
+// [CriticalPathMethod]. All times are relative to the start of the project.
+type Schedule[K comparable] struct {
+	// Duration is the minimum duration of the entire project.
+	Duration int
+	// EarliestStart contains the earliest time each vertex can start, given
+	// that all of its predecessors have started early enough.
+	EarliestStart map[K]int
+	// LatestStart contains the latest time each vertex can start without
+	// delaying the entire project.
+	LatestStart map[K]int
+	// Slack contains the difference between the latest and the earliest start
+	// of each vertex, which is the amount of time it can be delayed.
+	Slack map[K]int
+	// CriticalPath is a longest path through the project, where each vertex
+	// has a slack of 0. Delaying one of them delays the entire project.
+	CriticalPath []K
+}
+
+// CriticalPathMethod computes a schedule for the project given as a directed
+// acyclic graph, where the vertices are tasks and an edge from A to B means
+// that B can't start until the weight of the edge has passed since A started.
+// Typically, the weight of each edge is the duration of its source task, and
+// the tasks without successors are connected to a final vertex representing
+// the end of the project:
+//
+//	_ = g.AddEdge("design", "build", graph.EdgeWeight(5))
+//	_ = g.AddEdge("build", "done", graph.EdgeWeight(10))
+//
+//	schedule, _ := graph.CriticalPathMethod(g)
+//
+//	fmt.Println(schedule.Duration)     // 15
+//	fmt.Println(schedule.CriticalPath) // [design build done]
+//
+// For unweighted graphs, each edge has a weight of 1. The duration of the
+// project is the total weight of the longest path in the graph. For graphs
+// with cycles, an error is returned. CriticalPathMethod takes O(|V|+|E|) time.
+func CriticalPathMethod[K comparable, T any](g Graph[K, T]) (*Schedule[K], error) {
+	order, err := TopologicalSort(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute topological order: %w", err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	weightOf := func(edge Edge[K]) int {
+		if isWeighted {
+			return edge.Properties.Weight
+		}
+		return 1
+	}
+
+	s := &Schedule[K]{
+		EarliestStart: make(map[K]int, len(order)),
+		LatestStart:   make(map[K]int, len(order)),
+		Slack:         make(map[K]int, len(order)),
+		CriticalPath:  []K{},
+	}
+
+	for _, vertex := range order {
+		for adjacency, edge := range adjacencyMap[vertex] {
+			if start := s.EarliestStart[vertex] + weightOf(edge); start > s.EarliestStart[adjacency] {
+				s.EarliestStart[adjacency] = start
+			}
+		}
+
+		if s.EarliestStart[vertex] > s.Duration {
+			s.Duration = s.EarliestStart[vertex]
+		}
+	}
+
+	for i := len(order) - 1; i >= 0; i-- {
+		vertex := order[i]
+		latest := s.Duration
+
+		for adjacency, edge := range adjacencyMap[vertex] {
+			if start := s.LatestStart[adjacency] - weightOf(edge); start < latest {
+				latest = start
+			}
+		}
+
+		s.LatestStart[vertex] = latest
+		s.Slack[vertex] = latest - s.EarliestStart[vertex]
+	}
+
+	// The critical path starts with a vertex without slack that can start at
+	// time 0 and follows edges between vertices without slack whose weight
+	// exactly covers the difference between their start times.
+	for _, vertex := range order {
+		if s.Slack[vertex] != 0 || s.EarliestStart[vertex] != 0 {
+			continue
+		}
+
+		s.CriticalPath = append(s.CriticalPath, vertex)
+
+		for current := vertex; ; {
+			next, ok := current, false
+
+			for adjacency, edge := range adjacencyMap[current] {
+				if s.Slack[adjacency] == 0 && s.EarliestStart[current]+weightOf(edge) == s.EarliestStart[adjacency] {
+					next, ok = adjacency, true
+					break
+				}
+			}
+
+			if !ok {
+				break
+			}
+
+			s.CriticalPath = append(s.CriticalPath, next)
+			current = next
+		}
+
+		break
+	}
+
+	return s, nil
+}
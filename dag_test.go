@@ -2,6 +2,7 @@ package graph
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -322,3 +323,243 @@ func slicesAreEqualWithFunc[T any](a, b []T, equals func(a, b T) bool) bool {
 
 	return true
 }
+
+func TestLongestPath(t *testing.T) {
+	tests := map[string]struct {
+		isWeighted     bool
+		vertices       []int
+		edges          []Edge[int]
+		expectedPath   []int
+		expectedLength int
+		shouldFail     bool
+	}{
+		"empty graph": {
+			expectedPath: []int{},
+		},
+		"weighted graph": {
+			isWeighted: true,
+			vertices:   []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: 4}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 6}},
+				{Source: 4, Target: 5, Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedPath:   []int{1, 3, 4, 5},
+			expectedLength: 9,
+		},
+		"negative weights": {
+			isWeighted: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -5}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+			},
+			expectedPath:   []int{2, 3},
+			expectedLength: 2,
+		},
+		"unweighted graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 4},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expectedPath:   []int{1, 2, 3, 4},
+			expectedLength: 3,
+		},
+		"graph with cycle": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+			if test.isWeighted {
+				g = New(IntHash, Directed(), Weighted())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			path, err := LongestPath(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if !reflect.DeepEqual(path, test.expectedPath) {
+				t.Errorf("expected path %v, got %v", test.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestCriticalPathMethod(t *testing.T) {
+	g := New(StringHash, Directed(), Weighted())
+
+	for _, task := range []string{"start", "design", "build", "docs", "test", "done"} {
+		_ = g.AddVertex(task)
+	}
+
+	_ = g.AddEdge("start", "design", EdgeWeight(0))
+	_ = g.AddEdge("start", "docs", EdgeWeight(0))
+	_ = g.AddEdge("design", "build", EdgeWeight(5))
+	_ = g.AddEdge("build", "test", EdgeWeight(10))
+	_ = g.AddEdge("docs", "done", EdgeWeight(4))
+	_ = g.AddEdge("test", "done", EdgeWeight(3))
+
+	schedule, err := CriticalPathMethod(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if schedule.Duration != 18 {
+		t.Errorf("expected duration 18, got %d", schedule.Duration)
+	}
+
+	expectedEarliest := map[string]int{"start": 0, "design": 0, "build": 5, "docs": 0, "test": 15, "done": 18}
+	expectedLatest := map[string]int{"start": 0, "design": 0, "build": 5, "docs": 14, "test": 15, "done": 18}
+
+	for task, expected := range expectedEarliest {
+		if schedule.EarliestStart[task] != expected {
+			t.Errorf("expected earliest start %d for %s, got %d", expected, task, schedule.EarliestStart[task])
+		}
+
+		if schedule.LatestStart[task] != expectedLatest[task] {
+			t.Errorf("expected latest start %d for %s, got %d", expectedLatest[task], task, schedule.LatestStart[task])
+		}
+
+		if slack := expectedLatest[task] - expected; schedule.Slack[task] != slack {
+			t.Errorf("expected slack %d for %s, got %d", slack, task, schedule.Slack[task])
+		}
+	}
+
+	expectedPath := []string{"start", "design", "build", "test", "done"}
+
+	if !reflect.DeepEqual(schedule.CriticalPath, expectedPath) {
+		t.Errorf("expected critical path %v, got %v", expectedPath, schedule.CriticalPath)
+	}
+
+	if _, err := CriticalPathMethod(New(StringHash)); err == nil {
+		t.Error("expected error for undirected graph, got nil")
+	}
+}
+
+func TestTopologicalGenerations(t *testing.T) {
+	tests := map[string]struct {
+		vertices            []int
+		edges               []Edge[int]
+		expectedGenerations [][]int
+		shouldFail          bool
+	}{
+		"empty graph": {
+			expectedGenerations: [][]int{},
+		},
+		"graph with shortcut edge": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 5},
+			},
+			expectedGenerations: [][]int{{1, 2, 6}, {3, 5}, {4}},
+		},
+		"graph with cycle": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 2},
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target)
+			}
+
+			generations, err := TopologicalGenerations(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if len(generations) != len(test.expectedGenerations) {
+				t.Fatalf("expected generations %v, got %v", test.expectedGenerations, generations)
+			}
+
+			for i, expected := range test.expectedGenerations {
+				if !slicesAreEqual(generations[i], expected) {
+					t.Errorf("expected generation %d to be %v, got %v", i, expected, generations[i])
+				}
+			}
+		})
+	}
+}
+
+func TestUndirectedTopologicalGenerations(t *testing.T) {
+	if _, err := TopologicalGenerations(New(IntHash)); err == nil {
+		t.Error("expected error for undirected graph, got nil")
+	}
+}
+
+func TestDirectedStableTopologicalSort_lexicographic(t *testing.T) {
+	g := New(StringHash, Directed())
+
+	for _, vertex := range []string{"lint", "build", "test", "deploy", "docs"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge("lint", "test")
+	_ = g.AddEdge("build", "test")
+	_ = g.AddEdge("test", "deploy")
+	_ = g.AddEdge("docs", "deploy")
+
+	expected := []string{"build", "docs", "lint", "test", "deploy"}
+
+	for i := 0; i < 10; i++ {
+		order, err := StableTopologicalSort(g, func(a, b string) bool {
+			return a < b
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(order, expected) {
+			t.Fatalf("expected order %v, got %v", expected, order)
+		}
+	}
+}
@@ -24,6 +24,46 @@ func (d *directed[K, T]) Traits() *Traits {
 }
 
 func (d *directed[K, T]) AddVertex(value T, options ...func(*VertexProperties)) error {
+	return d.AddVertexWithHash(d.hash(value), value, options...)
+}
+
+func (d *directed[K, T]) AddVertexWithHash(hash K, value T, options ...func(*VertexProperties)) error {
+	properties := VertexProperties{
+		Weight:     0,
+		Attributes: make(map[string]string),
+	}
+
+	for _, option := range options {
+		option(&properties)
+	}
+
+	if err := d.store.AddVertex(hash, value, properties); err != nil {
+		return err
+	}
+
+	vertexAdded(d.traits, hash, value)
+
+	return nil
+}
+
+func (d *directed[K, T]) AddVertices(values []T, options ...func(*VertexProperties)) error {
+	hashes, properties, err := newVertices(d.store, d.hash, values, options)
+	if err != nil {
+		return err
+	}
+
+	if err := addVertices(d.store, hashes, values, properties); err != nil {
+		return err
+	}
+
+	for i, hash := range hashes {
+		vertexAdded(d.traits, hash, values[i])
+	}
+
+	return nil
+}
+
+func (d *directed[K, T]) UpsertVertex(value T, options ...func(*VertexProperties)) error {
 	hash := d.hash(value)
 	properties := VertexProperties{
 		Weight:     0,
@@ -34,7 +74,16 @@ func (d *directed[K, T]) AddVertex(value T, options ...func(*VertexProperties))
 		option(&properties)
 	}
 
-	return d.store.AddVertex(hash, value, properties)
+	added, err := upsertVertex(d.store, hash, value, properties)
+	if err != nil {
+		return err
+	}
+
+	if added {
+		vertexAdded(d.traits, hash, value)
+	}
+
+	return nil
 }
 
 func (d *directed[K, T]) AddVerticesFrom(g Graph[K, T]) error {
@@ -72,10 +121,33 @@ func (d *directed[K, T]) VertexWithProperties(hash K) (T, VertexProperties, erro
 }
 
 func (d *directed[K, T]) RemoveVertex(hash K) error {
-	return d.store.RemoveVertex(hash)
+	if err := d.store.RemoveVertex(hash); err != nil {
+		return err
+	}
+
+	vertexRemoved(d.traits, hash)
+
+	return nil
 }
 
 func (d *directed[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
+	var created autoVertices[K, T]
+
+	if err := d.insertEdge(&created, sourceHash, targetHash, options); err != nil {
+		created.rollback(d.store)
+		return err
+	}
+
+	return nil
+}
+
+// insertEdge adds an edge along with the vertices created using AutoVertices,
+// which are recorded in created.
+func (d *directed[K, T]) insertEdge(created *autoVertices[K, T], sourceHash, targetHash K, options []func(*EdgeProperties)) error {
+	if err := addAutoVertices(d.store, d.hash, d.traits, created, sourceHash, targetHash); err != nil {
+		return err
+	}
+
 	_, _, err := d.store.Vertex(sourceHash)
 	if err != nil {
 		return fmt.Errorf("source vertex %v: %w", sourceHash, err)
@@ -87,7 +159,7 @@ func (d *directed[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*Edge
 	}
 
 	if _, err := d.Edge(sourceHash, targetHash); !errors.Is(err, ErrEdgeNotFound) {
-		return ErrEdgeAlreadyExists
+		return addDuplicateEdge[K, T](d, d.store, d.traits, sourceHash, targetHash, options)
 	}
 
 	// If the user opted in to preventing cycles, run a cycle check.
@@ -113,7 +185,58 @@ func (d *directed[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*Edge
 		option(&edge.Properties)
 	}
 
-	return d.addEdge(sourceHash, targetHash, edge)
+	if err := d.addEdge(sourceHash, targetHash, edge); err != nil {
+		return err
+	}
+
+	created.commit(d.traits)
+	edgeAdded(d.traits, edge)
+
+	return nil
+}
+
+func (d *directed[K, T]) AddEdges(edges []Edge[K]) error {
+	if d.traits.PreventCycles || hasDuplicateEdgePolicy[K](d.traits) {
+		for _, edge := range edges {
+			if err := d.AddEdge(copyEdge(edge)); err != nil {
+				return fmt.Errorf("failed to add (%v, %v): %w", edge.Source, edge.Target, err)
+			}
+		}
+		return nil
+	}
+
+	newEdges := make([]Edge[K], 0, len(edges))
+	added := make(map[EdgeKey[K]]struct{}, len(edges))
+
+	var created autoVertices[K, T]
+
+	for _, edge := range edges {
+		if err := addAutoVertices(d.store, d.hash, d.traits, &created, edge.Source, edge.Target); err != nil {
+			created.rollback(d.store)
+			return err
+		}
+
+		if err := checkNewEdge(d.store, edge, added); err != nil {
+			created.rollback(d.store)
+			return err
+		}
+
+		added[EdgeKey[K]{Source: edge.Source, Target: edge.Target}] = struct{}{}
+		newEdges = append(newEdges, newEdgeFrom(edge))
+	}
+
+	if err := addEdges(d.store, newEdges); err != nil {
+		created.rollback(d.store)
+		return err
+	}
+
+	created.commit(d.traits)
+
+	for _, edge := range newEdges {
+		edgeAdded(d.traits, edge)
+	}
+
+	return nil
 }
 
 func (d *directed[K, T]) AddEdgesFrom(g Graph[K, T]) error {
@@ -132,34 +255,32 @@ func (d *directed[K, T]) AddEdgesFrom(g Graph[K, T]) error {
 }
 
 func (d *directed[K, T]) Edge(sourceHash, targetHash K) (Edge[T], error) {
-	edge, err := d.store.Edge(sourceHash, targetHash)
+	store := readStore(d.store, d.traits)
+
+	edge, err := store.Edge(sourceHash, targetHash)
 	if err != nil {
 		return Edge[T]{}, err
 	}
 
-	sourceVertex, _, err := d.store.Vertex(sourceHash)
+	sourceVertex, _, err := store.Vertex(sourceHash)
 	if err != nil {
 		return Edge[T]{}, err
 	}
 
-	targetVertex, _, err := d.store.Vertex(targetHash)
+	targetVertex, _, err := store.Vertex(targetHash)
 	if err != nil {
 		return Edge[T]{}, err
 	}
 
 	return Edge[T]{
-		Source: sourceVertex,
-		Target: targetVertex,
-		Properties: EdgeProperties{
-			Weight:     edge.Properties.Weight,
-			Attributes: edge.Properties.Attributes,
-			Data:       edge.Properties.Data,
-		},
+		Source:     sourceVertex,
+		Target:     targetVertex,
+		Properties: edge.Properties,
 	}, nil
 }
 
 func (d *directed[K, T]) Edges() ([]Edge[K], error) {
-	return d.store.ListEdges()
+	return readStore(d.store, d.traits).ListEdges()
 }
 
 func (d *directed[K, T]) UpdateEdge(source, target K, options ...func(properties *EdgeProperties)) error {
@@ -172,7 +293,38 @@ func (d *directed[K, T]) UpdateEdge(source, target K, options ...func(properties
 		option(&existingEdge.Properties)
 	}
 
-	return d.store.UpdateEdge(source, target, existingEdge)
+	if err := d.store.UpdateEdge(source, target, existingEdge); err != nil {
+		return err
+	}
+
+	edgesUpdated(d.traits, []Edge[K]{existingEdge})
+
+	return nil
+}
+
+func (d *directed[K, T]) UpdateWeights(weights map[EdgeKey[K]]int) error {
+	edges := make([]Edge[K], 0, len(weights))
+	previous := make([]Edge[K], 0, len(weights))
+
+	for key, weight := range weights {
+		edge, err := d.store.Edge(key.Source, key.Target)
+		if err != nil {
+			return fmt.Errorf("failed to get edge (%v, %v): %w", key.Source, key.Target, err)
+		}
+
+		previous = append(previous, edge)
+
+		edge.Properties.Weight = weight
+		edges = append(edges, edge)
+	}
+
+	if err := updateEdges(d.store, edges, previous); err != nil {
+		return err
+	}
+
+	edgesUpdated(d.traits, edges)
+
+	return nil
 }
 
 func (d *directed[K, T]) RemoveEdge(source, target K) error {
@@ -180,20 +332,29 @@ func (d *directed[K, T]) RemoveEdge(source, target K) error {
 		return err
 	}
 
+	edge, err := d.store.Edge(source, target)
+	if err != nil {
+		return err
+	}
+
 	if err := d.store.RemoveEdge(source, target); err != nil {
 		return fmt.Errorf("failed to remove edge from %v to %v: %w", source, target, err)
 	}
 
+	edgeRemoved(d.traits, edge)
+
 	return nil
 }
 
 func (d *directed[K, T]) AdjacencyMap() (map[K]map[K]Edge[K], error) {
-	vertices, err := d.store.ListVertices()
+	store := readStore(d.store, d.traits)
+
+	vertices, err := store.ListVertices()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list vertices: %w", err)
 	}
 
-	edges, err := d.store.ListEdges()
+	edges, err := store.ListEdges()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list edges: %w", err)
 	}
@@ -212,12 +373,14 @@ func (d *directed[K, T]) AdjacencyMap() (map[K]map[K]Edge[K], error) {
 }
 
 func (d *directed[K, T]) PredecessorMap() (map[K]map[K]Edge[K], error) {
-	vertices, err := d.store.ListVertices()
+	store := readStore(d.store, d.traits)
+
+	vertices, err := store.ListVertices()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list vertices: %w", err)
 	}
 
-	edges, err := d.store.ListEdges()
+	edges, err := store.ListEdges()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list edges: %w", err)
 	}
@@ -249,6 +412,7 @@ func (d *directed[K, T]) Clone() (Graph[K, T], error) {
 		IsWeighted:    d.traits.IsWeighted,
 		IsRooted:      d.traits.IsRooted,
 		PreventCycles: d.traits.PreventCycles,
+		keepFirstEdge: d.traits.keepFirstEdge,
 	}
 
 	clone := &directed[K, T]{
@@ -319,7 +483,296 @@ func copyEdge[K comparable](edge Edge[K]) (K, K, func(properties *EdgeProperties
 		}
 		p.Weight = edge.Properties.Weight
 		p.Data = edge.Properties.Data
+		if validity := edge.Properties.Validity; validity != nil {
+			p.Validity = &Validity{From: validity.From, Until: validity.Until}
+		}
 	}
 
 	return edge.Source, edge.Target, copyProperties
 }
+
+// newEdgeFrom creates a new edge with the same source, target, and properties
+// as the given edge. The attributes map of the new edge is an independent copy.
+func newEdgeFrom[K comparable](edge Edge[K]) Edge[K] {
+	newEdge := Edge[K]{
+		Source: edge.Source,
+		Target: edge.Target,
+		Properties: EdgeProperties{
+			Attributes: make(map[string]string, len(edge.Properties.Attributes)),
+		},
+	}
+
+	_, _, copyProperties := copyEdge(edge)
+	copyProperties(&newEdge.Properties)
+
+	return newEdge
+}
+
+// newVertices computes the hashes and properties for a bulk insertion of the
+// given vertices. It returns ErrVertexAlreadyExists if any of the vertices
+// already exists in the store or occurs multiple times.
+func newVertices[K comparable, T any](store Store[K, T], hash Hash[K, T], values []T, options []func(*VertexProperties)) ([]K, []VertexProperties, error) {
+	hashes := make([]K, len(values))
+	properties := make([]VertexProperties, len(values))
+	seen := make(map[K]struct{}, len(values))
+
+	for i, value := range values {
+		hashes[i] = hash(value)
+
+		if _, ok := seen[hashes[i]]; ok {
+			return nil, nil, fmt.Errorf("vertex %v: %w", hashes[i], ErrVertexAlreadyExists)
+		}
+
+		if _, _, err := store.Vertex(hashes[i]); err == nil {
+			return nil, nil, fmt.Errorf("vertex %v: %w", hashes[i], ErrVertexAlreadyExists)
+		}
+
+		seen[hashes[i]] = struct{}{}
+
+		properties[i] = VertexProperties{
+			Weight:     0,
+			Attributes: make(map[string]string),
+		}
+
+		for _, option := range options {
+			option(&properties[i])
+		}
+	}
+
+	return hashes, properties, nil
+}
+
+// checkNewEdge checks whether the given edge can be added to the store as part
+// of a bulk insertion. added contains the edges of the bulk insertion so far.
+func checkNewEdge[K comparable, T any](store Store[K, T], edge Edge[K], added map[EdgeKey[K]]struct{}) error {
+	if _, _, err := store.Vertex(edge.Source); err != nil {
+		return fmt.Errorf("source vertex %v: %w", edge.Source, err)
+	}
+
+	if _, _, err := store.Vertex(edge.Target); err != nil {
+		return fmt.Errorf("target vertex %v: %w", edge.Target, err)
+	}
+
+	if _, ok := added[EdgeKey[K]{Source: edge.Source, Target: edge.Target}]; ok {
+		return fmt.Errorf("edge (%v, %v): %w", edge.Source, edge.Target, ErrEdgeAlreadyExists)
+	}
+
+	if _, err := store.Edge(edge.Source, edge.Target); !errors.Is(err, ErrEdgeNotFound) {
+		return fmt.Errorf("edge (%v, %v): %w", edge.Source, edge.Target, ErrEdgeAlreadyExists)
+	}
+
+	return nil
+}
+
+// addDuplicateEdge handles the addition of an edge that already exists. If
+// the graph has been created using KeepFirstEdge, the edge is ignored, and if
+// it has been created using MergeEdges, the existing edge is merged with the
+// duplicate edge. Otherwise, ErrEdgeAlreadyExists is returned.
+func addDuplicateEdge[K comparable, T any](g Graph[K, T], store Store[K, T], traits *Traits, sourceHash, targetHash K, options []func(*EdgeProperties)) error {
+	if traits.keepFirstEdge {
+		return nil
+	}
+
+	merge, ok := edgeMerge[K](traits)
+	if !ok {
+		return ErrEdgeAlreadyExists
+	}
+
+	existing, err := store.Edge(sourceHash, targetHash)
+	if err != nil {
+		return fmt.Errorf("failed to get edge (%v, %v): %w", sourceHash, targetHash, err)
+	}
+
+	duplicate := Edge[K]{
+		Source: sourceHash,
+		Target: targetHash,
+		Properties: EdgeProperties{
+			Attributes: make(map[string]string),
+		},
+	}
+
+	for _, option := range options {
+		option(&duplicate.Properties)
+	}
+
+	// The existing edge is copied so that merge can't modify the edge in the
+	// store directly.
+	properties := merge(newEdgeFrom(existing), duplicate)
+
+	return g.UpdateEdge(sourceHash, targetHash, setEdgeProperties(properties))
+}
+
+// autoVertices contains the vertices that have been created using AutoVertices
+// during a single operation. The OnVertexAdded hooks are only invoked for them
+// once the operation has succeeded, and if it fails, they are removed again.
+type autoVertices[K comparable, T any] struct {
+	hashes []K
+	values []T
+}
+
+// commit invokes the OnVertexAdded hooks for the created vertices.
+func (a *autoVertices[K, T]) commit(traits *Traits) {
+	for i, hash := range a.hashes {
+		vertexAdded(traits, hash, a.values[i])
+	}
+
+	a.hashes, a.values = nil, nil
+}
+
+// rollback removes the created vertices from the store.
+func (a *autoVertices[K, T]) rollback(store Store[K, T]) {
+	for _, hash := range a.hashes {
+		_ = store.RemoveVertex(hash)
+	}
+
+	a.hashes, a.values = nil, nil
+}
+
+// addAutoVertices adds the vertices with the given hashes that don't exist in
+// the store yet, if the graph has been created using AutoVertices. Otherwise,
+// it does nothing. The added vertices are recorded in created.
+func addAutoVertices[K comparable, T any](store Store[K, T], hash Hash[K, T], traits *Traits, created *autoVertices[K, T], hashes ...K) error {
+	vertex, ok := autoVertex[K, T](traits)
+	if !ok {
+		return nil
+	}
+
+	for _, h := range hashes {
+		if _, _, err := store.Vertex(h); !errors.Is(err, ErrVertexNotFound) {
+			continue
+		}
+
+		value := vertex(h)
+
+		if hash(value) != h {
+			return fmt.Errorf("vertex created for hash %v has a different hash %v", h, hash(value))
+		}
+
+		properties := VertexProperties{
+			Weight:     0,
+			Attributes: make(map[string]string),
+		}
+
+		if err := store.AddVertex(h, value, properties); err != nil {
+			return fmt.Errorf("failed to add vertex %v: %w", h, err)
+		}
+
+		created.hashes = append(created.hashes, h)
+		created.values = append(created.values, value)
+	}
+
+	return nil
+}
+
+// upsertVertex adds the given vertex to the store or replaces the vertex with
+// the same hash. It returns whether the vertex has been added. If the store
+// doesn't support updating vertices, a vertex can only be replaced if it
+// doesn't have any edges, which is checked before the vertex is removed and
+// added again. If adding it again fails, the previous vertex is restored.
+func upsertVertex[K comparable, T any](store Store[K, T], hash K, value T, properties VertexProperties) (bool, error) {
+	err := store.AddVertex(hash, value, properties)
+	if !errors.Is(err, ErrVertexAlreadyExists) {
+		return err == nil, err
+	}
+
+	if updater, ok := store.(interface {
+		UpdateVertex(hash K, value T, properties VertexProperties) error
+	}); ok {
+		return false, updater.UpdateVertex(hash, value, properties)
+	}
+
+	previousValue, previousProperties, err := store.Vertex(hash)
+	if err != nil {
+		return false, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+	}
+
+	edges, err := store.ListEdges()
+	if err != nil {
+		return false, fmt.Errorf("failed to list edges: %w", err)
+	}
+
+	for _, edge := range edges {
+		if edge.Source == hash || edge.Target == hash {
+			return false, fmt.Errorf("failed to replace vertex %v: store doesn't support updating vertices with edges: %w", hash, ErrVertexHasEdges)
+		}
+	}
+
+	if err := store.RemoveVertex(hash); err != nil {
+		return false, fmt.Errorf("failed to replace vertex %v: %w", hash, err)
+	}
+
+	if err := store.AddVertex(hash, value, properties); err != nil {
+		if restoreErr := store.AddVertex(hash, previousValue, previousProperties); restoreErr != nil {
+			return false, fmt.Errorf("failed to replace vertex %v: %v, and failed to restore it: %w", hash, err, restoreErr)
+		}
+		return false, fmt.Errorf("failed to replace vertex %v: %w", hash, err)
+	}
+
+	return false, nil
+}
+
+// addVertices adds the given vertices to the store. If the store supports bulk
+// insertions, all vertices are added in a single call.
+func addVertices[K comparable, T any](store Store[K, T], hashes []K, values []T, properties []VertexProperties) error {
+	if bulk, ok := store.(interface {
+		AddVertices(hashes []K, values []T, properties []VertexProperties) error
+	}); ok {
+		return bulk.AddVertices(hashes, values, properties)
+	}
+
+	for i := range hashes {
+		if err := store.AddVertex(hashes[i], values[i], properties[i]); err != nil {
+			return fmt.Errorf("failed to add vertex %v: %w", hashes[i], err)
+		}
+	}
+
+	return nil
+}
+
+// updateEdges updates the given edges in the store, where previous contains
+// the edges before the update. If the store supports batch updates, all edges
+// are updated atomically in a single call. Otherwise, the edges are updated one
+// by one, and if an update fails, the edges updated so far are restored.
+func updateEdges[K comparable, T any](store Store[K, T], edges, previous []Edge[K]) error {
+	if batch, ok := store.(interface {
+		UpdateEdges(edges []Edge[K]) error
+	}); ok {
+		if err := batch.UpdateEdges(edges); err != nil {
+			return fmt.Errorf("failed to update edges: %w", err)
+		}
+		return nil
+	}
+
+	for i, edge := range edges {
+		err := store.UpdateEdge(edge.Source, edge.Target, edge)
+		if err == nil {
+			continue
+		}
+
+		for _, restored := range previous[:i] {
+			_ = store.UpdateEdge(restored.Source, restored.Target, restored)
+		}
+
+		return fmt.Errorf("failed to update edge (%v, %v): %w", edge.Source, edge.Target, err)
+	}
+
+	return nil
+}
+
+// addEdges adds the given edges to the store. If the store supports bulk
+// insertions, all edges are added in a single call.
+func addEdges[K comparable, T any](store Store[K, T], edges []Edge[K]) error {
+	if bulk, ok := store.(interface {
+		AddEdges(edges []Edge[K]) error
+	}); ok {
+		return bulk.AddEdges(edges)
+	}
+
+	for _, edge := range edges {
+		if err := store.AddEdge(edge.Source, edge.Target, edge); err != nil {
+			return fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return nil
+}
@@ -211,6 +211,312 @@ func TestDirected_AddVerticesFrom(t *testing.T) {
 	}
 }
 
+func TestDirected_AddVertices(t *testing.T) {
+	tests := map[string]struct {
+		existing      []int
+		vertices      []int
+		options       []func(*VertexProperties)
+		expectedOrder int
+		expectedErr   error
+	}{
+		"empty graph": {
+			vertices:      []int{1, 2, 3},
+			options:       []func(*VertexProperties){VertexWeight(2)},
+			expectedOrder: 3,
+		},
+		"graph with existing vertices": {
+			existing:      []int{1, 2},
+			vertices:      []int{3, 4, 5},
+			expectedOrder: 5,
+		},
+		"vertex already exists": {
+			existing:      []int{1, 2},
+			vertices:      []int{3, 2},
+			expectedOrder: 2,
+			expectedErr:   ErrVertexAlreadyExists,
+		},
+		"duplicate vertices": {
+			vertices:      []int{1, 2, 1},
+			expectedOrder: 0,
+			expectedErr:   ErrVertexAlreadyExists,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for _, vertex := range test.existing {
+				_ = g.AddVertex(vertex)
+			}
+
+			err := g.AddVertices(test.vertices, test.options...)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			order, _ := g.Order()
+			if order != test.expectedOrder {
+				t.Errorf("expected order %v, got %v", test.expectedOrder, order)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			expectedProperties := VertexProperties{Attributes: map[string]string{}}
+			for _, option := range test.options {
+				option(&expectedProperties)
+			}
+
+			for _, vertex := range test.vertices {
+				_, properties, err := g.VertexWithProperties(vertex)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !vertexPropertiesAreEqual(expectedProperties, properties) {
+					t.Errorf("expected properties %v, got %v", expectedProperties, properties)
+				}
+			}
+		})
+	}
+}
+
+func TestDirected_AddVertexWithHash(t *testing.T) {
+	calls := 0
+	hash := func(v string) string {
+		calls++
+		return v
+	}
+
+	g := New(hash, Directed())
+
+	if err := g.AddVertexWithHash("A", "A", VertexWeight(3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := g.AddVertexWithHash("B", "B"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := g.AddEdge("A", "B"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls != 0 {
+		t.Errorf("expected hash function not to be called, got %d calls", calls)
+	}
+
+	_, properties, err := g.VertexWithProperties("A")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if properties.Weight != 3 {
+		t.Errorf("expected weight 3, got %d", properties.Weight)
+	}
+
+	if err := g.AddVertexWithHash("A", "A"); !errors.Is(err, ErrVertexAlreadyExists) {
+		t.Errorf("expected error %v, got %v", ErrVertexAlreadyExists, err)
+	}
+}
+
+func TestDirected_UpsertVertex(t *testing.T) {
+	type city struct {
+		name       string
+		population int
+	}
+
+	cityHash := func(c city) string {
+		return c.name
+	}
+
+	tests := map[string]struct {
+		vertex             city
+		options            []func(*VertexProperties)
+		expectedOrder      int
+		expectedProperties VertexProperties
+	}{
+		"new vertex": {
+			vertex:             city{name: "Paris", population: 2_100_000},
+			options:            []func(*VertexProperties){VertexWeight(2)},
+			expectedOrder:      3,
+			expectedProperties: VertexProperties{Weight: 2, Attributes: map[string]string{}},
+		},
+		"existing vertex": {
+			vertex:             city{name: "London", population: 8_900_000},
+			options:            []func(*VertexProperties){VertexAttribute("country", "UK")},
+			expectedOrder:      2,
+			expectedProperties: VertexProperties{Attributes: map[string]string{"country": "UK"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			added := 0
+			g := New(cityHash, Directed(), OnVertexAdded(func(string, city) { added++ }))
+
+			_ = g.AddVertex(city{name: "London", population: 8_800_000}, VertexWeight(5))
+			_ = g.AddVertex(city{name: "Berlin", population: 3_600_000})
+			_ = g.AddEdge("London", "Berlin")
+
+			added = 0
+
+			if err := g.UpsertVertex(test.vertex, test.options...); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if order, _ := g.Order(); order != test.expectedOrder {
+				t.Errorf("expected order %d, got %d", test.expectedOrder, order)
+			}
+
+			vertex, properties, err := g.VertexWithProperties(test.vertex.name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if vertex != test.vertex {
+				t.Errorf("expected vertex %v, got %v", test.vertex, vertex)
+			}
+
+			if !vertexPropertiesAreEqual(test.expectedProperties, properties) {
+				t.Errorf("expected properties %v, got %v", test.expectedProperties, properties)
+			}
+
+			if _, err := g.Edge("London", "Berlin"); err != nil {
+				t.Errorf("expected edge to be kept, got %v", err)
+			}
+
+			if expectedAdded := test.expectedOrder - 2; added != expectedAdded {
+				t.Errorf("expected %d invocations of the hook, got %d", expectedAdded, added)
+			}
+		})
+	}
+}
+
+func TestDirected_UpsertVertex_withoutUpdateVertex(t *testing.T) {
+	g := NewWithStore[int, int](IntHash, basicStore[int, int]{Store: newMemoryStore[int, int]()}, Directed())
+
+	_ = g.AddVertex(1, VertexWeight(1))
+	_ = g.AddVertex(2, VertexWeight(2))
+	_ = g.AddVertex(3, VertexWeight(3))
+	_ = g.AddEdge(1, 2)
+
+	if err := g.UpsertVertex(1, VertexWeight(10)); !errors.Is(err, ErrVertexHasEdges) {
+		t.Fatalf("expected error %v, got %v", ErrVertexHasEdges, err)
+	}
+
+	if _, properties, err := g.VertexWithProperties(1); err != nil || properties.Weight != 1 {
+		t.Errorf("expected vertex 1 to be unchanged, got weight %d and error %v", properties.Weight, err)
+	}
+
+	if err := g.UpsertVertex(3, VertexWeight(30)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, properties, _ := g.VertexWithProperties(3); properties.Weight != 30 {
+		t.Errorf("expected weight 30, got %d", properties.Weight)
+	}
+}
+
+func TestDirected_AddEdges(t *testing.T) {
+	tests := map[string]struct {
+		vertices     []int
+		existing     []Edge[int]
+		edges        []Edge[int]
+		expectedSize int
+		expectedErr  error
+	}{
+		"edges with properties": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Attributes: map[string]string{"color": "red"}}},
+				{Source: 1, Target: 3},
+			},
+			expectedSize: 3,
+		},
+		"graph with existing edges": {
+			vertices: []int{1, 2, 3},
+			existing: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			edges: []Edge[int]{
+				{Source: 2, Target: 3},
+			},
+			expectedSize: 2,
+		},
+		"edge already exists": {
+			vertices: []int{1, 2, 3},
+			existing: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			edges: []Edge[int]{
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 2},
+			},
+			expectedSize: 1,
+			expectedErr:  ErrEdgeAlreadyExists,
+		},
+		"edges in both directions": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expectedSize: 2,
+		},
+		"non-existent vertex": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedSize: 0,
+			expectedErr:  ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.existing {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			err := g.AddEdges(test.edges)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			size, _ := g.Size()
+			if size != test.expectedSize {
+				t.Errorf("expected size %v, got %v", test.expectedSize, size)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			for _, expectedEdge := range test.edges {
+				edge, err := g.Edge(expectedEdge.Source, expectedEdge.Target)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				actualEdge := Edge[int]{Source: edge.Source, Target: edge.Target, Properties: edge.Properties}
+				if !edgesAreEqual(expectedEdge, actualEdge, true) {
+					t.Errorf("expected edge %v, got %v", expectedEdge, actualEdge)
+				}
+			}
+		})
+	}
+}
+
 func TestDirected_Vertex(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []int
@@ -850,6 +1156,105 @@ func TestDirected_UpdateEdge(t *testing.T) {
 	}
 }
 
+func TestDirected_UpdateWeights(t *testing.T) {
+	tests := map[string]struct {
+		vertices      []int
+		edges         []Edge[int]
+		weights       map[EdgeKey[int]]int
+		expectedEdges []Edge[int]
+		expectedErr   error
+	}{
+		"update multiple edges": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 3}},
+			},
+			weights: map[EdgeKey[int]]int{
+				{Source: 1, Target: 2}: 10,
+				{Source: 2, Target: 3}: 20,
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 10}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 20}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 3}},
+			},
+		},
+		"non-existent edge leaves the graph unchanged": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			weights: map[EdgeKey[int]]int{
+				{Source: 1, Target: 2}: 10,
+				{Source: 2, Target: 3}: 20,
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedErr: ErrEdgeNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed(), Weighted())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			err := g.UpdateWeights(test.weights)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			for _, expectedEdge := range test.expectedEdges {
+				actualEdge, err := g.Edge(expectedEdge.Source, expectedEdge.Target)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err.Error())
+				}
+
+				if actualEdge.Properties.Weight != expectedEdge.Properties.Weight {
+					t.Errorf("expected weight %v for edge (%v, %v), got %v", expectedEdge.Properties.Weight, expectedEdge.Source, expectedEdge.Target, actualEdge.Properties.Weight)
+				}
+			}
+		})
+	}
+}
+
+func TestDirected_UpdateWeights_rollback(t *testing.T) {
+	store := &failingUpdateStore[int, int]{Store: newMemoryStore[int, int](), failAt: 1}
+	g := NewWithStore[int, int](IntHash, store, Directed(), Weighted())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddVertex(3)
+	_ = g.AddEdge(1, 2, EdgeWeight(1))
+	_ = g.AddEdge(2, 3, EdgeWeight(2))
+
+	err := g.UpdateWeights(map[EdgeKey[int]]int{
+		{Source: 1, Target: 2}: 10,
+		{Source: 2, Target: 3}: 20,
+	})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	for key, weight := range map[EdgeKey[int]]int{{Source: 1, Target: 2}: 1, {Source: 2, Target: 3}: 2} {
+		edge, _ := g.Edge(key.Source, key.Target)
+		if edge.Properties.Weight != weight {
+			t.Errorf("expected weight %d for edge (%v, %v) to be restored, got %d", weight, key.Source, key.Target, edge.Properties.Weight)
+		}
+	}
+}
+
 func TestDirected_RemoveEdge(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []int
@@ -1445,3 +1850,25 @@ func predecessors[K comparable, T any](g *directed[K, T], vertexHash K) ([]K, er
 
 	return predecessorHashes, nil
 }
+
+// failingUpdateStore is a store without batch updates whose UpdateEdge fails on
+// the call with the index failAt.
+type failingUpdateStore[K comparable, T any] struct {
+	Store[K, T]
+	failAt  int
+	updates int
+}
+
+func (s *failingUpdateStore[K, T]) UpdateEdge(sourceHash, targetHash K, edge Edge[K]) error {
+	s.updates++
+	if s.updates-1 == s.failAt {
+		return errors.New("update failed")
+	}
+	return s.Store.UpdateEdge(sourceHash, targetHash, edge)
+}
+
+// basicStore is a store that only provides the methods of the Store interface,
+// hiding the optional methods of the wrapped store.
+type basicStore[K comparable, T any] struct {
+	Store[K, T]
+}
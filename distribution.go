@@ -0,0 +1,277 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// WeightDistribution describes the distribution of the edge weights of a graph,
+// as computed by EdgeWeightDistribution. Apart from the summary statistics, it
+// provides percentiles and histograms.
+type WeightDistribution struct {
+	// Count is the number of edges.
+	Count int
+	Min   int
+	Max   int
+	Sum   int
+	Mean  float64
+
+	// weights contains all edge weights in ascending order.
+	weights []int
+}
+
+// Bucket is a bucket of a histogram, containing the number of values in the
+// range [Lower, Upper). The last bucket of a histogram also contains values
+// equal to its upper bound.
+type Bucket struct {
+	Lower float64
+	Upper float64
+	Count int
+}
+
+// EdgeWeightDistribution computes the distribution of the edge weights of g
+// in a single pass over the edges. Each edge of an undirected graph is counted
+// once. For a graph without edges, all statistics are zero.
+//
+// To remove all edges with a weight below the 95th percentile, use:
+//
+//	distribution, _ := graph.EdgeWeightDistribution(g)
+//	threshold := distribution.Percentile(95)
+//
+//	edges, _ := g.Edges()
+//	for _, edge := range edges {
+//		if float64(edge.Properties.Weight) < threshold {
+//			_ = g.RemoveEdge(edge.Source, edge.Target)
+//		}
+//	}
+func EdgeWeightDistribution[K comparable, T any](g Graph[K, T]) (WeightDistribution, error) {
+	edges, err := g.Edges()
+	if err != nil {
+		return WeightDistribution{}, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	distribution := WeightDistribution{
+		Count:   len(edges),
+		weights: make([]int, len(edges)),
+	}
+
+	if len(edges) == 0 {
+		return distribution, nil
+	}
+
+	distribution.Min = math.MaxInt
+	distribution.Max = math.MinInt
+
+	for i, edge := range edges {
+		weight := edge.Properties.Weight
+
+		if weight < distribution.Min {
+			distribution.Min = weight
+		}
+		if weight > distribution.Max {
+			distribution.Max = weight
+		}
+
+		distribution.Sum += weight
+		distribution.weights[i] = weight
+	}
+
+	distribution.Mean = float64(distribution.Sum) / float64(len(edges))
+
+	sort.Ints(distribution.weights)
+
+	return distribution, nil
+}
+
+// Percentile returns the p-th percentile of the edge weights, where p is in the
+// range [0, 100]. Percentile(50) is the median. If the percentile lies between
+// two weights, it is linearly interpolated between them. For an empty
+// distribution, Percentile returns 0.
+func (d WeightDistribution) Percentile(p float64) float64 {
+	if len(d.weights) == 0 {
+		return 0
+	}
+
+	p = math.Max(0, math.Min(100, p))
+
+	rank := p / 100 * float64(len(d.weights)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	fraction := rank - float64(lower)
+
+	return float64(d.weights[lower]) + fraction*float64(d.weights[upper]-d.weights[lower])
+}
+
+// Histogram divides the range between the minimum and the maximum weight into
+// the given number of buckets of equal width and returns the number of weights
+// in each bucket. If all weights are equal, there is a single bucket containing
+// all of them. For an empty distribution or a non-positive number of buckets,
+// Histogram returns nil.
+func (d WeightDistribution) Histogram(buckets int) []Bucket {
+	if len(d.weights) == 0 || buckets <= 0 {
+		return nil
+	}
+
+	if d.Min == d.Max {
+		return []Bucket{{Lower: float64(d.Min), Upper: float64(d.Max), Count: len(d.weights)}}
+	}
+
+	width := float64(d.Max-d.Min) / float64(buckets)
+	histogram := make([]Bucket, buckets)
+
+	for i := range histogram {
+		histogram[i].Lower = float64(d.Min) + float64(i)*width
+		histogram[i].Upper = float64(d.Min) + float64(i+1)*width
+	}
+
+	histogram[buckets-1].Upper = float64(d.Max)
+
+	for _, weight := range d.weights {
+		i := int(float64(weight-d.Min) / width)
+		if i >= buckets {
+			i = buckets - 1
+		}
+		histogram[i].Count++
+	}
+
+	return histogram
+}
+
+// DegreeDistribution computes the number of vertices for each degree. The
+// degree of a vertex is the number of edges incident to it, where self-loops
+// count twice. In a directed graph, this is the sum of the in-degree and the
+// out-degree. The returned map only contains degrees of at least one vertex.
+func DegreeDistribution[K comparable, T any](g Graph[K, T]) (map[int]int, error) {
+	degrees, err := vertexDegrees(g)
+	if err != nil {
+		return nil, err
+	}
+
+	distribution := make(map[int]int)
+
+	for _, degree := range degrees {
+		distribution[degree]++
+	}
+
+	return distribution, nil
+}
+
+// DegreeSequence returns the degrees of all vertices in non-increasing order,
+// using the same definition of the degree as [DegreeDistribution]. The degree
+// sequence of a simple undirected graph is graphical, see [IsGraphical].
+func DegreeSequence[K comparable, T any](g Graph[K, T]) ([]int, error) {
+	degrees, err := vertexDegrees(g)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence := make([]int, 0, len(degrees))
+
+	for _, degree := range degrees {
+		sequence = append(sequence, degree)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(sequence)))
+
+	return sequence, nil
+}
+
+// IsGraphical reports whether the given degree sequence is graphical, that is,
+// whether there is a simple undirected graph without self-loops whose vertices
+// have exactly these degrees. The order of the degrees doesn't matter. Such a
+// graph can be generated using generators.HavelHakimi.
+//
+// IsGraphical uses the Erdős–Gallai theorem: A sequence d1 >= ... >= dn of
+// non-negative integers is graphical if and only if its sum is even and for
+// each k, the sum of d1 to dk is at most k*(k-1) plus the sum of min(di, k)
+// for all i > k. This takes O(n*log(n)) time.
+func IsGraphical(degrees []int) bool {
+	sorted := make([]int, len(degrees))
+	copy(sorted, degrees)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+	total := 0
+
+	for _, degree := range sorted {
+		if degree < 0 || degree >= len(sorted) {
+			return false
+		}
+		total += degree
+	}
+
+	if total%2 != 0 {
+		return false
+	}
+
+	// suffix[i] is the sum of the degrees from index i on, which is used to
+	// compute the sum of min(di, k) in constant time for each k: Because the
+	// degrees are sorted, they are at least k up to some index and smaller
+	// than k from there on.
+	suffix := make([]int, len(sorted)+1)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		suffix[i] = suffix[i+1] + sorted[i]
+	}
+
+	left := 0
+	boundary := len(sorted)
+
+	for k := 1; k <= len(sorted); k++ {
+		left += sorted[k-1]
+
+		for boundary > 0 && sorted[boundary-1] < k {
+			boundary--
+		}
+
+		// The degrees at indices k to boundary-1 are at least k, and those
+		// from boundary on are smaller than k.
+		right := k * (k - 1)
+
+		if boundary > k {
+			right += k*(boundary-k) + suffix[boundary]
+		} else {
+			right += suffix[k]
+		}
+
+		if left > right {
+			return false
+		}
+	}
+
+	return true
+}
+
+// vertexDegrees computes the degree of each vertex as defined by
+// DegreeDistribution.
+func vertexDegrees[K comparable, T any](g Graph[K, T]) (map[K]int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	var predecessorMap map[K]map[K]Edge[K]
+
+	if g.Traits().IsDirected {
+		predecessorMap, err = g.PredecessorMap()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+		}
+	}
+
+	degrees := make(map[K]int, len(adjacencyMap))
+
+	for vertex, adjacencies := range adjacencyMap {
+		degree := len(adjacencies)
+
+		if predecessorMap != nil {
+			degree += len(predecessorMap[vertex])
+		} else if _, ok := adjacencies[vertex]; ok {
+			degree++
+		}
+
+		degrees[vertex] = degree
+	}
+
+	return degrees, nil
+}
@@ -0,0 +1,291 @@
+package graph
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestEdgeWeightDistribution(t *testing.T) {
+	tests := map[string]struct {
+		isDirected          bool
+		edges               []Edge[int]
+		expected            WeightDistribution
+		expectedPercentiles map[float64]float64
+	}{
+		"directed graph": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 10}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 3}},
+			},
+			expected: WeightDistribution{Count: 4, Min: 1, Max: 10, Sum: 18, Mean: 4.5},
+			expectedPercentiles: map[float64]float64{
+				0:   1,
+				50:  3.5,
+				95:  9.1,
+				100: 10,
+				150: 10,
+			},
+		},
+		"undirected graph counts each edge once": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -2}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 6}},
+			},
+			expected: WeightDistribution{Count: 2, Min: -2, Max: 6, Sum: 4, Mean: 2},
+			expectedPercentiles: map[float64]float64{
+				25: 0,
+			},
+		},
+		"no edges": {
+			isDirected: true,
+			expected:   WeightDistribution{},
+			expectedPercentiles: map[float64]float64{
+				50: 0,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newMetricsGraph(test.isDirected, test.edges)
+
+			distribution, err := EdgeWeightDistribution(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if distribution.Count != test.expected.Count {
+				t.Errorf("expected count %d, got %d", test.expected.Count, distribution.Count)
+			}
+
+			if distribution.Min != test.expected.Min || distribution.Max != test.expected.Max {
+				t.Errorf("expected range [%d, %d], got [%d, %d]", test.expected.Min, test.expected.Max, distribution.Min, distribution.Max)
+			}
+
+			if distribution.Sum != test.expected.Sum {
+				t.Errorf("expected sum %d, got %d", test.expected.Sum, distribution.Sum)
+			}
+
+			if math.Abs(distribution.Mean-test.expected.Mean) > 1e-9 {
+				t.Errorf("expected mean %v, got %v", test.expected.Mean, distribution.Mean)
+			}
+
+			for p, expected := range test.expectedPercentiles {
+				if percentile := distribution.Percentile(p); math.Abs(percentile-expected) > 1e-9 {
+					t.Errorf("expected percentile %v to be %v, got %v", p, expected, percentile)
+				}
+			}
+		})
+	}
+}
+
+func TestWeightDistribution_Histogram(t *testing.T) {
+	tests := map[string]struct {
+		weights  []int
+		buckets  int
+		expected []Bucket
+	}{
+		"equal-width buckets": {
+			weights: []int{0, 1, 2, 5, 9, 10},
+			buckets: 2,
+			expected: []Bucket{
+				{Lower: 0, Upper: 5, Count: 3},
+				{Lower: 5, Upper: 10, Count: 3},
+			},
+		},
+		"maximum in last bucket": {
+			weights: []int{1, 2, 4},
+			buckets: 3,
+			expected: []Bucket{
+				{Lower: 1, Upper: 2, Count: 1},
+				{Lower: 2, Upper: 3, Count: 1},
+				{Lower: 3, Upper: 4, Count: 1},
+			},
+		},
+		"equal weights": {
+			weights: []int{7, 7},
+			buckets: 4,
+			expected: []Bucket{
+				{Lower: 7, Upper: 7, Count: 2},
+			},
+		},
+		"no weights": {
+			buckets:  4,
+			expected: nil,
+		},
+		"no buckets": {
+			weights:  []int{1, 2},
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for i, weight := range test.weights {
+				_ = g.AddVertex(2 * i)
+				_ = g.AddVertex(2*i + 1)
+				_ = g.AddEdge(2*i, 2*i+1, EdgeWeight(weight))
+			}
+
+			distribution, _ := EdgeWeightDistribution(g)
+			histogram := distribution.Histogram(test.buckets)
+
+			if len(histogram) != len(test.expected) {
+				t.Fatalf("expected histogram %v, got %v", test.expected, histogram)
+			}
+
+			for i := range histogram {
+				if histogram[i] != test.expected[i] {
+					t.Errorf("expected histogram %v, got %v", test.expected, histogram)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestDegreeDistribution(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		edges      []Edge[int]
+		expected   map[int]int
+	}{
+		"directed graph": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+			},
+			expected: map[int]int{0: 1, 2: 3},
+		},
+		"undirected graph with self-loop": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			expected: map[int]int{0: 2, 1: 1, 3: 1},
+		},
+		"no edges": {
+			expected: map[int]int{0: 4},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newMetricsGraph(test.isDirected, test.edges)
+
+			distribution, err := DegreeDistribution(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(distribution) != len(test.expected) {
+				t.Fatalf("expected distribution %v, got %v", test.expected, distribution)
+			}
+
+			for degree, count := range test.expected {
+				if distribution[degree] != count {
+					t.Errorf("expected %d vertices with degree %d, got %d", count, degree, distribution[degree])
+				}
+			}
+		})
+	}
+}
+
+func TestDegreeSequence(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		edges      []Edge[int]
+		expected   []int
+	}{
+		"directed graph": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+			},
+			expected: []int{2, 2, 2, 0},
+		},
+		"undirected graph with self-loop": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			expected: []int{3, 1, 0, 0},
+		},
+		"no edges": {
+			expected: []int{0, 0, 0, 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newMetricsGraph(test.isDirected, test.edges)
+
+			sequence, err := DegreeSequence(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(sequence, test.expected) {
+				t.Errorf("expected sequence %v, got %v", test.expected, sequence)
+			}
+		})
+	}
+}
+
+func TestIsGraphical(t *testing.T) {
+	tests := map[string]struct {
+		degrees  []int
+		expected bool
+	}{
+		"empty sequence": {
+			degrees:  []int{},
+			expected: true,
+		},
+		"triangle": {
+			degrees:  []int{2, 2, 2},
+			expected: true,
+		},
+		"unsorted star": {
+			degrees:  []int{1, 1, 3, 1},
+			expected: true,
+		},
+		"odd sum": {
+			degrees:  []int{2, 1, 1, 1},
+			expected: false,
+		},
+		"degree too large": {
+			degrees:  []int{3, 1, 1},
+			expected: false,
+		},
+		"negative degree": {
+			degrees:  []int{1, -1},
+			expected: false,
+		},
+		"even sum but not graphical": {
+			degrees:  []int{3, 3, 1, 1},
+			expected: false,
+		},
+		"petersen graph": {
+			degrees:  []int{3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if isGraphical := IsGraphical(test.degrees); isGraphical != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, isGraphical)
+			}
+		})
+	}
+}
@@ -1,6 +1,6 @@
 // Package draw provides functions for visualizing graph structures. At this
 // time, draw supports the DOT language which can be interpreted by Graphviz,
-// Grappa, and others.
+// Grappa, and others, as well as rendering SVG images directly.
 package draw
 
 import (
@@ -108,7 +108,16 @@ func generateDOT[K comparable, T any](g graph.Graph[K, T], options ...func(*desc
 		return desc, err
 	}
 
-	for vertex, adjacencies := range adjacencyMap {
+	// The vertices and their adjacencies are sorted like in SVG, so that the
+	// output is the same each time.
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortByString(vertices)
+
+	for _, vertex := range vertices {
 		_, sourceProperties, err := g.VertexWithProperties(vertex)
 		if err != nil {
 			return desc, err
@@ -121,7 +130,15 @@ func generateDOT[K comparable, T any](g graph.Graph[K, T], options ...func(*desc
 		}
 		desc.Statements = append(desc.Statements, stmt)
 
-		for adjacency, edge := range adjacencies {
+		adjacencies := make([]K, 0, len(adjacencyMap[vertex]))
+		for adjacency := range adjacencyMap[vertex] {
+			adjacencies = append(adjacencies, adjacency)
+		}
+
+		sortByString(adjacencies)
+
+		for _, adjacency := range adjacencies {
+			edge := adjacencyMap[vertex][adjacency]
 			stmt := statement{
 				Source:         vertex,
 				Target:         adjacency,
@@ -0,0 +1,318 @@
+package draw
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/dominikbraun/graph"
+	"github.com/dominikbraun/graph/layout"
+)
+
+// Style describes the appearance of a vertex or an edge in an SVG. The colors
+// can be any value accepted by SVG, such as "red" or "#ff0000".
+type Style struct {
+	// Fill is the fill color of a vertex. It has no effect on edges.
+	Fill string
+	// Stroke is the color of the outline of a vertex or the color of an edge.
+	Stroke string
+	// StrokeWidth is the width of the outline of a vertex or an edge.
+	StrokeWidth float64
+	// Label is the text displayed inside a vertex or next to an edge. An empty
+	// label is not displayed.
+	Label string
+}
+
+type svg struct {
+	width        float64
+	height       float64
+	radius       float64
+	hierarchical bool
+	vertexStyle  func(vertex interface{}, properties graph.VertexProperties, style *Style)
+	edgeStyle    func(source, target interface{}, properties graph.EdgeProperties, style *Style)
+}
+
+// Size is a functional option for the [SVG] function that sets the width and
+// the height of the image. The default size is 800x600.
+func Size(width, height float64) func(*svg) {
+	return func(s *svg) {
+		s.width = width
+		s.height = height
+	}
+}
+
+// Radius is a functional option for the [SVG] function that sets the radius of
+// the circles representing the vertices. The default radius is 16.
+func Radius(radius float64) func(*svg) {
+	return func(s *svg) {
+		s.radius = radius
+	}
+}
+
+// Hierarchical is a functional option for the [SVG] function that arranges the
+// vertices in layers using layout.Hierarchical instead of layout.ForceDirected.
+// This only works for directed acyclic graphs.
+func Hierarchical() func(*svg) {
+	return func(s *svg) {
+		s.hierarchical = true
+	}
+}
+
+// VertexStyle is a functional option for the [SVG] function that registers a
+// hook for styling individual vertices. The hook is called for each vertex with
+// its hash, its properties, and its default style, which can be modified.
+//
+//	_ = draw.SVG(g, file, draw.VertexStyle(func(vertex interface{}, p graph.VertexProperties, style *draw.Style) {
+//		if p.Attributes["critical"] == "true" {
+//			style.Fill = "red"
+//		}
+//	}))
+func VertexStyle(hook func(vertex interface{}, properties graph.VertexProperties, style *Style)) func(*svg) {
+	return func(s *svg) {
+		s.vertexStyle = hook
+	}
+}
+
+// EdgeStyle is a functional option for the [SVG] function that registers a hook
+// for styling individual edges. The hook is called for each edge with the hashes
+// of its source and target, its properties, and its default style, which can be
+// modified.
+func EdgeStyle(hook func(source, target interface{}, properties graph.EdgeProperties, style *Style)) func(*svg) {
+	return func(s *svg) {
+		s.edgeStyle = hook
+	}
+}
+
+// SVG renders the given graph as an SVG image into an io.Writer, for example a
+// file. Unlike [DOT], it doesn't require any external tools. The positions of
+// the vertices are computed using the layout package, and the vertices are
+// drawn as labeled circles connected by straight lines. In directed graphs, the
+// lines end with an arrowhead.
+//
+// By default, the vertices are white with a black outline and are labeled with
+// their hash, and edges in weighted graphs are labeled with their weight. These
+// styles can be customized using the [VertexStyle] and [EdgeStyle] options:
+//
+//	file, _ := os.Create("./my-graph.svg")
+//	_ = draw.SVG(g, file, draw.EdgeStyle(func(source, target interface{}, p graph.EdgeProperties, style *draw.Style) {
+//		if p.Weight > 10 {
+//			style.Stroke = "red"
+//		}
+//	}))
+//
+// Since the layout algorithms don't scale well, SVG is intended for graphs with
+// up to a few hundred vertices.
+func SVG[K comparable, T any](g graph.Graph[K, T], w io.Writer, options ...func(*svg)) error {
+	s := svg{
+		width:  800,
+		height: 600,
+		radius: 16,
+	}
+
+	for _, option := range options {
+		option(&s)
+	}
+
+	var positions map[K]layout.Point
+	var err error
+
+	if s.hierarchical {
+		positions, err = layout.Hierarchical(g)
+	} else {
+		positions, err = layout.ForceDirected(g)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to compute layout: %w", err)
+	}
+
+	fit(positions, s.width, s.height, 2.5*s.radius)
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortByString(vertices)
+
+	isDirected := g.Traits().IsDirected
+	buffered := bufio.NewWriter(w)
+
+	fmt.Fprintf(buffered, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n",
+		number(s.width), number(s.height), number(s.width), number(s.height))
+
+	if isDirected {
+		fmt.Fprint(buffered, `<defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M 0 0 L 10 5 L 0 10 z"/></marker></defs>`+"\n")
+	}
+
+	fmt.Fprint(buffered, `<g class="edges">`+"\n")
+
+	drawn := make(map[K]struct{}, len(vertices))
+
+	for _, source := range vertices {
+		targets := make([]K, 0, len(adjacencyMap[source]))
+		for target := range adjacencyMap[source] {
+			targets = append(targets, target)
+		}
+
+		sortByString(targets)
+
+		for _, target := range targets {
+			// Undirected edges are contained in the adjacency map twice, but
+			// only need to be drawn once.
+			if _, ok := drawn[target]; ok && !isDirected {
+				continue
+			}
+
+			edge := adjacencyMap[source][target]
+
+			style := Style{Stroke: "black", StrokeWidth: 1}
+			if g.Traits().IsWeighted {
+				style.Label = fmt.Sprint(edge.Properties.Weight)
+			}
+
+			if s.edgeStyle != nil {
+				s.edgeStyle(source, target, edge.Properties, &style)
+			}
+
+			s.writeEdge(buffered, positions[source], positions[target], source == target, isDirected, style)
+		}
+
+		drawn[source] = struct{}{}
+	}
+
+	fmt.Fprint(buffered, "</g>\n")
+	fmt.Fprint(buffered, `<g class="vertices">`+"\n")
+
+	for _, vertex := range vertices {
+		_, properties, err := g.VertexWithProperties(vertex)
+		if err != nil {
+			return fmt.Errorf("failed to get vertex %v: %w", vertex, err)
+		}
+
+		style := Style{
+			Fill:        "white",
+			Stroke:      "black",
+			StrokeWidth: 1,
+			Label:       fmt.Sprint(vertex),
+		}
+
+		if s.vertexStyle != nil {
+			s.vertexStyle(vertex, properties, &style)
+		}
+
+		s.writeVertex(buffered, positions[vertex], style)
+	}
+
+	fmt.Fprint(buffered, "</g>\n</svg>\n")
+
+	if err := buffered.Flush(); err != nil {
+		return fmt.Errorf("failed to write SVG: %w", err)
+	}
+
+	return nil
+}
+
+// fit scales and moves the positions so that they are inside an image of the
+// given size, keeping the given margin to the borders. If all positions have
+// the same X or Y coordinate, they are centered on that axis.
+func fit[K comparable](positions map[K]layout.Point, width, height, margin float64) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+
+	for _, position := range positions {
+		minX, maxX = math.Min(minX, position.X), math.Max(maxX, position.X)
+		minY, maxY = math.Min(minY, position.Y), math.Max(maxY, position.Y)
+	}
+
+	scale := func(value, lower, upper, size float64) float64 {
+		if upper-lower < 1e-9 {
+			return size / 2
+		}
+		return margin + (value-lower)/(upper-lower)*(size-2*margin)
+	}
+
+	for vertex, position := range positions {
+		positions[vertex] = layout.Point{
+			X: scale(position.X, minX, maxX, width),
+			Y: scale(position.Y, minY, maxY, height),
+		}
+	}
+}
+
+func (s *svg) writeVertex(w io.Writer, position layout.Point, style Style) {
+	fmt.Fprintf(w, `<circle cx="%s" cy="%s" r="%s" fill="%s" stroke="%s" stroke-width="%s"/>`+"\n",
+		number(position.X), number(position.Y), number(s.radius),
+		html.EscapeString(style.Fill), html.EscapeString(style.Stroke), number(style.StrokeWidth))
+
+	if style.Label != "" {
+		fmt.Fprintf(w, `<text x="%s" y="%s" text-anchor="middle" dominant-baseline="central">%s</text>`+"\n",
+			number(position.X), number(position.Y), html.EscapeString(style.Label))
+	}
+}
+
+func (s *svg) writeEdge(w io.Writer, source, target layout.Point, isSelfLoop, isDirected bool, style Style) {
+	marker := ""
+	if isDirected {
+		marker = ` marker-end="url(#arrow)"`
+	}
+
+	var labelX, labelY float64
+
+	if isSelfLoop {
+		// Self-loops are drawn as an arc above the vertex, starting and ending
+		// on its outline.
+		offset := s.radius * math.Sqrt2 / 2
+
+		fmt.Fprintf(w, `<path d="M %s %s A %s %s 0 1 1 %s %s" fill="none" stroke="%s" stroke-width="%s"%s/>`+"\n",
+			number(source.X-offset), number(source.Y-offset),
+			number(s.radius*0.8), number(s.radius*0.8),
+			number(source.X+offset), number(source.Y-offset),
+			html.EscapeString(style.Stroke), number(style.StrokeWidth), marker)
+
+		labelX, labelY = source.X, source.Y-s.radius*2.4
+	} else {
+		// The line starts and ends on the outlines of the circles, so that the
+		// arrowhead is visible.
+		deltaX, deltaY := target.X-source.X, target.Y-source.Y
+		distance := math.Hypot(deltaX, deltaY)
+
+		if distance < 2*s.radius {
+			return
+		}
+
+		unitX, unitY := deltaX/distance, deltaY/distance
+
+		fmt.Fprintf(w, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-width="%s"%s/>`+"\n",
+			number(source.X+unitX*s.radius), number(source.Y+unitY*s.radius),
+			number(target.X-unitX*s.radius), number(target.Y-unitY*s.radius),
+			html.EscapeString(style.Stroke), number(style.StrokeWidth), marker)
+
+		labelX, labelY = (source.X+target.X)/2, (source.Y+target.Y)/2
+	}
+
+	if style.Label != "" {
+		fmt.Fprintf(w, `<text x="%s" y="%s" text-anchor="middle" dominant-baseline="text-after-edge">%s</text>`+"\n",
+			number(labelX), number(labelY), html.EscapeString(style.Label))
+	}
+}
+
+// number formats a coordinate or length with at most two decimal places.
+func number(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+func sortByString[K comparable](hashes []K) {
+	sort.Slice(hashes, func(i, j int) bool {
+		return fmt.Sprint(hashes[i]) < fmt.Sprint(hashes[j])
+	})
+}
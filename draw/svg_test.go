@@ -0,0 +1,180 @@
+package draw
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+	"github.com/dominikbraun/graph/layout"
+)
+
+func TestSVG(t *testing.T) {
+	tests := map[string]struct {
+		graph            graph.Graph[string, string]
+		vertices         []string
+		edges            []graph.Edge[string]
+		options          []func(*svg)
+		expectedCircles  int
+		expectedLines    int
+		expectedPaths    int
+		expectedContains []string
+		expectedErr      bool
+	}{
+		"directed graph": {
+			graph:           graph.New(graph.StringHash, graph.Directed()),
+			vertices:        []string{"a", "b", "c"},
+			edges:           []graph.Edge[string]{{Source: "a", Target: "b"}, {Source: "b", Target: "c"}, {Source: "c", Target: "a"}},
+			expectedCircles: 3,
+			expectedLines:   3,
+			expectedPaths:   1,
+			expectedContains: []string{
+				`<marker id="arrow"`,
+				`marker-end="url(#arrow)"`,
+				`>a</text>`,
+			},
+		},
+		"undirected graph draws each edge once": {
+			graph:           graph.New(graph.StringHash),
+			vertices:        []string{"a", "b", "c"},
+			edges:           []graph.Edge[string]{{Source: "a", Target: "b"}, {Source: "b", Target: "c"}},
+			expectedCircles: 3,
+			expectedLines:   2,
+		},
+		"weighted graph with self-loop": {
+			graph:    graph.New(graph.StringHash, graph.Directed(), graph.Weighted()),
+			vertices: []string{"a", "b"},
+			edges: []graph.Edge[string]{
+				{Source: "a", Target: "a", Properties: graph.EdgeProperties{Weight: 3}},
+				{Source: "a", Target: "b", Properties: graph.EdgeProperties{Weight: 7}},
+			},
+			expectedCircles:  2,
+			expectedLines:    1,
+			expectedPaths:    2,
+			expectedContains: []string{`>3</text>`, `>7</text>`},
+		},
+		"labels are escaped": {
+			graph:            graph.New(graph.StringHash),
+			vertices:         []string{"<a&b>"},
+			expectedCircles:  1,
+			expectedContains: []string{`>&lt;a&amp;b&gt;</text>`},
+		},
+		"style hooks": {
+			graph:    graph.New(graph.StringHash, graph.Directed()),
+			vertices: []string{"a", "b"},
+			edges:    []graph.Edge[string]{{Source: "a", Target: "b"}},
+			options: []func(*svg){
+				VertexStyle(func(vertex interface{}, _ graph.VertexProperties, style *Style) {
+					if vertex == "a" {
+						style.Fill = "red"
+						style.Label = "start"
+					}
+				}),
+				EdgeStyle(func(_, _ interface{}, _ graph.EdgeProperties, style *Style) {
+					style.Stroke = "blue"
+					style.StrokeWidth = 2.5
+				}),
+			},
+			expectedCircles: 2,
+			expectedLines:   1,
+			expectedPaths:   1,
+			expectedContains: []string{
+				`fill="red"`,
+				`>start</text>`,
+				`stroke="blue" stroke-width="2.5"`,
+			},
+		},
+		"hierarchical layout": {
+			graph:           graph.New(graph.StringHash, graph.Directed()),
+			vertices:        []string{"a", "b", "c"},
+			edges:           []graph.Edge[string]{{Source: "a", Target: "b"}, {Source: "a", Target: "c"}},
+			options:         []func(*svg){Hierarchical(), Size(300, 200)},
+			expectedCircles: 3,
+			expectedLines:   2,
+			expectedPaths:   1,
+			expectedContains: []string{
+				`width="300" height="200"`,
+				// The root is centered above the other vertices.
+				`<circle cx="150" cy="40"`,
+			},
+		},
+		"hierarchical layout with cycle": {
+			graph:       graph.New(graph.StringHash, graph.Directed()),
+			vertices:    []string{"a", "b"},
+			edges:       []graph.Edge[string]{{Source: "a", Target: "b"}, {Source: "b", Target: "a"}},
+			options:     []func(*svg){Hierarchical()},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, vertex := range test.vertices {
+				_ = test.graph.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = test.graph.AddEdge(edge.Source, edge.Target, graph.EdgeWeight(edge.Properties.Weight))
+			}
+
+			var buf bytes.Buffer
+
+			err := SVG(test.graph, &buf, test.options...)
+
+			if test.expectedErr != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedErr {
+				return
+			}
+
+			output := buf.String()
+
+			if !strings.HasPrefix(output, `<svg xmlns="http://www.w3.org/2000/svg"`) || !strings.HasSuffix(output, "</svg>\n") {
+				t.Errorf("expected SVG document, got %s", output)
+			}
+
+			if count := strings.Count(output, "<circle "); count != test.expectedCircles {
+				t.Errorf("expected %d circles, got %d", test.expectedCircles, count)
+			}
+
+			if count := strings.Count(output, "<line "); count != test.expectedLines {
+				t.Errorf("expected %d lines, got %d", test.expectedLines, count)
+			}
+
+			// Paths are used for self-loops and the arrowhead.
+			if count := strings.Count(output, "<path "); count != test.expectedPaths {
+				t.Errorf("expected %d paths, got %d", test.expectedPaths, count)
+			}
+
+			for _, expected := range test.expectedContains {
+				if !strings.Contains(output, expected) {
+					t.Errorf("expected output to contain %s, got %s", expected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestFit(t *testing.T) {
+	positions := map[int]layout.Point{
+		1: {X: -10, Y: 5},
+		2: {X: 30, Y: 5},
+		3: {X: 10, Y: 5},
+	}
+
+	fit(positions, 200, 100, 20)
+
+	expected := map[int]layout.Point{
+		1: {X: 20, Y: 50},
+		2: {X: 180, Y: 50},
+		3: {X: 100, Y: 50},
+	}
+
+	for vertex, position := range expected {
+		if positions[vertex] != position {
+			t.Errorf("expected position %v for %d, got %v", position, vertex, positions[vertex])
+		}
+	}
+}
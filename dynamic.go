@@ -0,0 +1,616 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// DynamicShortestPaths maintains the shortest path tree of a single source
+// vertex and keeps it up to date as edge weights change. Instead of running
+// Dijkstra's algorithm from scratch after each change, only the vertices whose
+// distances are actually affected by the change are re-evaluated, following the
+// approach of Ramalingam and Reps.
+//
+// DynamicShortestPaths works on a snapshot of the graph's adjacencies that is
+// taken upon creation. Weight changes have to be applied using UpdateWeight so
+// that the tree and the graph stay in sync. Structural changes like adding or
+// removing edges are not tracked: Create a new instance after such changes.
+//
+// Like [ShortestPath], DynamicShortestPaths treats each edge of an unweighted
+// graph as an edge with weight 1. Negative edge weights are not supported.
+type DynamicShortestPaths[K comparable, T any] struct {
+	g              Graph[K, T]
+	source         K
+	adjacencyMap   map[K]map[K]Edge[K]
+	predecessorMap map[K]map[K]Edge[K]
+	distances      map[K]float64
+	predecessors   map[K]K
+	children       map[K]map[K]struct{}
+}
+
+// NewDynamicShortestPaths computes the shortest path tree for the given source
+// vertex and returns a DynamicShortestPaths instance that maintains this tree.
+func NewDynamicShortestPaths[K comparable, T any](g Graph[K, T], source K) (*DynamicShortestPaths[K, T], error) {
+	if _, err := g.Vertex(source); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", source, err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get predecessor map: %w", err)
+	}
+
+	d := &DynamicShortestPaths[K, T]{
+		g:              g,
+		source:         source,
+		adjacencyMap:   adjacencyMap,
+		predecessorMap: predecessorMap,
+		distances:      make(map[K]float64, len(adjacencyMap)),
+		predecessors:   make(map[K]K, len(adjacencyMap)),
+		children:       make(map[K]map[K]struct{}, len(adjacencyMap)),
+	}
+
+	for vertex, adjacencies := range adjacencyMap {
+		d.distances[vertex] = math.Inf(1)
+
+		for adjacency, edge := range adjacencies {
+			if edge.Properties.Weight < 0 && g.Traits().IsWeighted {
+				return nil, fmt.Errorf("edge (%v, %v) has a negative weight", vertex, adjacency)
+			}
+		}
+	}
+
+	d.distances[source] = 0
+
+	queue := newPriorityQueue[K]()
+	queue.Push(source, 0)
+
+	d.propagate(queue)
+
+	return d, nil
+}
+
+// Source returns the hash of the source vertex of the shortest path tree.
+func (d *DynamicShortestPaths[K, T]) Source() K {
+	return d.source
+}
+
+// Distance returns the total weight of the shortest path from the source to the
+// given target vertex. If the target is not reachable from the source, the
+// returned error is ErrTargetNotReachable.
+func (d *DynamicShortestPaths[K, T]) Distance(target K) (int, error) {
+	distance, ok := d.distances[target]
+	if !ok {
+		return 0, ErrVertexNotFound
+	}
+
+	if math.IsInf(distance, 1) {
+		return 0, ErrTargetNotReachable
+	}
+
+	return int(distance), nil
+}
+
+// ShortestPath returns the shortest path from the source to the given target
+// vertex in the same format as [ShortestPath] does. If the target is not
+// reachable from the source, ErrTargetNotReachable will be returned.
+func (d *DynamicShortestPaths[K, T]) ShortestPath(target K) ([]K, error) {
+	if _, err := d.Distance(target); err != nil {
+		return nil, err
+	}
+
+	path := []K{target}
+	current := target
+
+	for current != d.source {
+		current = d.predecessors[current]
+		path = append([]K{current}, path...)
+	}
+
+	return path, nil
+}
+
+// UpdateWeight sets the weight of the edge between the given source and target
+// vertices in the graph and repairs the shortest path tree accordingly. If the
+// edge doesn't exist, ErrEdgeNotFound will be returned.
+//
+// In an undirected graph, the edge will be updated in both directions.
+func (d *DynamicShortestPaths[K, T]) UpdateWeight(source, target K, weight int) error {
+	return d.UpdateWeights(map[EdgeKey[K]]int{
+		{Source: source, Target: target}: weight,
+	})
+}
+
+// UpdateWeights sets the weights of multiple edges using the graph's
+// UpdateWeights method and repairs the shortest path tree in a single pass
+// for all changes, which is considerably cheaper than calling UpdateWeight
+// for each edge.
+//
+// If one of the edges doesn't exist, ErrEdgeNotFound will be returned and
+// neither the graph nor the tree are changed.
+func (d *DynamicShortestPaths[K, T]) UpdateWeights(weights map[EdgeKey[K]]int) error {
+	for key, weight := range weights {
+		if _, ok := d.adjacencyMap[key.Source][key.Target]; !ok {
+			return ErrEdgeNotFound
+		}
+
+		if weight < 0 && d.g.Traits().IsWeighted {
+			return fmt.Errorf("edge (%v, %v) cannot have a negative weight", key.Source, key.Target)
+		}
+	}
+
+	if err := d.g.UpdateWeights(weights); err != nil {
+		return fmt.Errorf("failed to update weights: %w", err)
+	}
+
+	// In an undirected graph, each edge consists of two directed arcs that
+	// both have to be updated.
+	arcs := make(map[EdgeKey[K]]int, len(weights))
+
+	for key, weight := range weights {
+		arcs[key] = weight
+
+		if !d.g.Traits().IsDirected {
+			arcs[EdgeKey[K]{Source: key.Target, Target: key.Source}] = weight
+		}
+	}
+
+	// The repair happens in two phases: First, all weight increases are
+	// applied and the subtrees that depend on them are re-computed. Then, all
+	// weight decreases are applied and propagated. Handling them separately
+	// ensures that each phase starts with correct distances.
+	increased := make([]EdgeKey[K], 0)
+	decreased := make([]EdgeKey[K], 0)
+
+	for arc, weight := range arcs {
+		edge := d.adjacencyMap[arc.Source][arc.Target]
+		oldWeight := d.weight(edge)
+
+		edge.Properties.Weight = weight
+		newWeight := d.weight(edge)
+
+		switch {
+		case newWeight > oldWeight:
+			d.setWeight(arc, weight)
+			increased = append(increased, arc)
+		case newWeight < oldWeight:
+			// Decreases are applied in the second phase.
+			decreased = append(decreased, arc)
+		default:
+			d.setWeight(arc, weight)
+		}
+	}
+
+	d.increase(increased)
+
+	for _, arc := range decreased {
+		d.setWeight(arc, arcs[arc])
+	}
+
+	d.decrease(decreased)
+
+	return nil
+}
+
+// setWeight sets the weight of the given arc in the local adjacency map and
+// predecessor map, which both hold their own copy of the edge.
+func (d *DynamicShortestPaths[K, T]) setWeight(arc EdgeKey[K], weight int) {
+	edge := d.adjacencyMap[arc.Source][arc.Target]
+	edge.Properties.Weight = weight
+	d.adjacencyMap[arc.Source][arc.Target] = edge
+
+	if predecessor, ok := d.predecessorMap[arc.Target][arc.Source]; ok {
+		predecessor.Properties.Weight = weight
+		d.predecessorMap[arc.Target][arc.Source] = predecessor
+	}
+}
+
+// increase repairs the tree after the weights of the given arcs have grown.
+// Arcs that are not part of the tree don't change anything. For those that
+// are, the distances of all vertices in the subtree of the arc's target are
+// invalidated and then re-computed using their cheapest unaffected
+// predecessors as starting points.
+func (d *DynamicShortestPaths[K, T]) increase(arcs []EdgeKey[K]) {
+	affected := make(map[K]struct{})
+	stack := newStack[K]()
+
+	for _, arc := range arcs {
+		if predecessor, ok := d.predecessors[arc.Target]; ok && predecessor == arc.Source {
+			stack.push(arc.Target)
+		}
+	}
+
+	for !stack.isEmpty() {
+		current, _ := stack.pop()
+
+		if _, ok := affected[current]; ok {
+			continue
+		}
+
+		affected[current] = struct{}{}
+
+		for child := range d.children[current] {
+			stack.push(child)
+		}
+	}
+
+	if len(affected) == 0 {
+		return
+	}
+
+	for vertex := range affected {
+		d.distances[vertex] = math.Inf(1)
+		d.removePredecessor(vertex)
+	}
+
+	queue := newPriorityQueue[K]()
+
+	for vertex := range affected {
+		for predecessor, edge := range d.predecessorMap[vertex] {
+			if _, ok := affected[predecessor]; ok {
+				continue
+			}
+
+			if math.IsInf(d.distances[predecessor], 1) {
+				continue
+			}
+
+			distance := d.distances[predecessor] + d.weight(edge)
+
+			if distance < d.distances[vertex] {
+				d.distances[vertex] = distance
+				d.setPredecessor(vertex, predecessor)
+			}
+		}
+
+		if !math.IsInf(d.distances[vertex], 1) {
+			queue.Push(vertex, d.distances[vertex])
+		}
+	}
+
+	d.propagate(queue)
+}
+
+// decrease repairs the tree after the weights of the given arcs have dropped.
+// Such an arc can only make paths via its target vertex cheaper, so a single
+// Dijkstra run starting at all improved target vertices restores the tree.
+func (d *DynamicShortestPaths[K, T]) decrease(arcs []EdgeKey[K]) {
+	queue := newPriorityQueue[K]()
+
+	for _, arc := range arcs {
+		if math.IsInf(d.distances[arc.Source], 1) {
+			continue
+		}
+
+		distance := d.distances[arc.Source] + d.weight(d.adjacencyMap[arc.Source][arc.Target])
+
+		if distance >= d.distances[arc.Target] {
+			continue
+		}
+
+		d.distances[arc.Target] = distance
+		d.setPredecessor(arc.Target, arc.Source)
+
+		if _, ok := queue.cache[arc.Target]; ok {
+			queue.UpdatePriority(arc.Target, distance)
+		} else {
+			queue.Push(arc.Target, distance)
+		}
+	}
+
+	d.propagate(queue)
+}
+
+// propagate runs Dijkstra's algorithm seeded with the vertices in the given
+// queue, relaxing all edges leaving the popped vertices.
+func (d *DynamicShortestPaths[K, T]) propagate(queue *priorityQueue[K]) {
+	for queue.Len() > 0 {
+		vertex, _ := queue.Pop()
+
+		for adjacency, edge := range d.adjacencyMap[vertex] {
+			distance := d.distances[vertex] + d.weight(edge)
+
+			if distance >= d.distances[adjacency] {
+				continue
+			}
+
+			d.distances[adjacency] = distance
+			d.setPredecessor(adjacency, vertex)
+
+			if _, ok := queue.cache[adjacency]; ok {
+				queue.UpdatePriority(adjacency, distance)
+			} else {
+				queue.Push(adjacency, distance)
+			}
+		}
+	}
+}
+
+func (d *DynamicShortestPaths[K, T]) setPredecessor(vertex, predecessor K) {
+	d.removePredecessor(vertex)
+
+	d.predecessors[vertex] = predecessor
+
+	if _, ok := d.children[predecessor]; !ok {
+		d.children[predecessor] = make(map[K]struct{})
+	}
+	d.children[predecessor][vertex] = struct{}{}
+}
+
+func (d *DynamicShortestPaths[K, T]) removePredecessor(vertex K) {
+	predecessor, ok := d.predecessors[vertex]
+	if !ok {
+		return
+	}
+
+	delete(d.children[predecessor], vertex)
+	delete(d.predecessors, vertex)
+}
+
+// weight returns the weight of the given edge as used for computing distances.
+// Just as in ShortestPath, all edges of unweighted graphs have a weight of 1.
+func (d *DynamicShortestPaths[K, T]) weight(edge Edge[K]) float64 {
+	if !d.g.Traits().IsWeighted {
+		return 1
+	}
+
+	return float64(edge.Properties.Weight)
+}
+
+// DynamicTopologicalOrder maintains a topological order of a directed acyclic
+// graph and keeps it up to date as vertices and edges are added. Instead of
+// sorting the entire graph again after each insertion, it uses the algorithm
+// by Pearce and Kelly: When an edge (A,B) is added and B precedes A in the
+// current order, only the vertices between B and A that are affected by the
+// new edge are reordered.
+//
+// Like DynamicShortestPaths, DynamicTopologicalOrder works on a snapshot of
+// the graph's structure that is taken upon creation. All changes have to be
+// made using its own methods so that the order and the graph stay in sync.
+// Adding an edge that would create a cycle is rejected with ErrEdgeCreatesCycle,
+// which is detected as a by-product of the reordering.
+type DynamicTopologicalOrder[K comparable, T any] struct {
+	g            Graph[K, T]
+	adjacencies  map[K]map[K]struct{}
+	predecessors map[K]map[K]struct{}
+
+	// order contains the vertices in topological order, and positions maps
+	// each vertex to its index in order.
+	order     []K
+	positions map[K]int
+}
+
+// NewDynamicTopologicalOrder computes a topological order of the given graph
+// and returns a DynamicTopologicalOrder instance that maintains this order. The
+// graph has to be directed and acyclic.
+func NewDynamicTopologicalOrder[K comparable, T any](g Graph[K, T]) (*DynamicTopologicalOrder[K, T], error) {
+	order, err := TopologicalSort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	d := &DynamicTopologicalOrder[K, T]{
+		g:            g,
+		adjacencies:  make(map[K]map[K]struct{}, len(adjacencyMap)),
+		predecessors: make(map[K]map[K]struct{}, len(adjacencyMap)),
+		order:        order,
+		positions:    make(map[K]int, len(order)),
+	}
+
+	for i, vertex := range order {
+		d.positions[vertex] = i
+		d.adjacencies[vertex] = make(map[K]struct{})
+		d.predecessors[vertex] = make(map[K]struct{})
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			d.adjacencies[source][target] = struct{}{}
+			d.predecessors[target][source] = struct{}{}
+		}
+	}
+
+	return d, nil
+}
+
+// Order returns all vertices in the current topological order.
+func (d *DynamicTopologicalOrder[K, T]) Order() []K {
+	order := make([]K, len(d.order))
+	copy(order, d.order)
+
+	return order
+}
+
+// Position returns the index of the given vertex in the current topological
+// order. For the first vertex, this is 0.
+func (d *DynamicTopologicalOrder[K, T]) Position(vertex K) (int, error) {
+	position, ok := d.positions[vertex]
+	if !ok {
+		return 0, fmt.Errorf("could not get vertex with hash %v: %w", vertex, ErrVertexNotFound)
+	}
+
+	return position, nil
+}
+
+// AddVertex adds the given vertex to the graph and appends it to the order.
+func (d *DynamicTopologicalOrder[K, T]) AddVertex(value T, options ...func(*VertexProperties)) error {
+	if err := d.g.AddVertex(value, options...); err != nil {
+		return err
+	}
+
+	hash := hashOf(d.g)(value)
+
+	d.positions[hash] = len(d.order)
+	d.order = append(d.order, hash)
+	d.adjacencies[hash] = make(map[K]struct{})
+	d.predecessors[hash] = make(map[K]struct{})
+
+	return nil
+}
+
+// AddEdge adds an edge between the given vertices to the graph and updates the
+// order. If the edge would create a cycle, ErrEdgeCreatesCycle is returned and
+// neither the graph nor the order are changed.
+//
+// If the source already precedes the target, the order doesn't change and no
+// search is required. Otherwise, the time required for the update depends on
+// the number of vertices between the target and the source that are connected
+// to them, which is usually much smaller than the size of the graph.
+func (d *DynamicTopologicalOrder[K, T]) AddEdge(source, target K, options ...func(*EdgeProperties)) error {
+	if _, ok := d.positions[source]; !ok {
+		return fmt.Errorf("source vertex %v: %w", source, ErrVertexNotFound)
+	}
+
+	if _, ok := d.positions[target]; !ok {
+		return fmt.Errorf("target vertex %v: %w", target, ErrVertexNotFound)
+	}
+
+	if _, ok := d.adjacencies[source][target]; ok {
+		return ErrEdgeAlreadyExists
+	}
+
+	if source == target {
+		return ErrEdgeCreatesCycle
+	}
+
+	lower, upper := d.positions[target], d.positions[source]
+
+	var forward, backward []K
+
+	if lower < upper {
+		var ok bool
+
+		// Find all vertices reachable from the target that precede the source.
+		// If the source itself is reachable, the edge would close a cycle.
+		forward, ok = d.search(target, d.adjacencies, func(position int) bool {
+			return position < upper
+		}, source)
+		if !ok {
+			return ErrEdgeCreatesCycle
+		}
+
+		// Find all vertices that reach the source and succeed the target.
+		backward, _ = d.search(source, d.predecessors, func(position int) bool {
+			return position > lower
+		}, target)
+	}
+
+	if err := d.g.AddEdge(source, target, options...); err != nil {
+		return err
+	}
+
+	d.adjacencies[source][target] = struct{}{}
+	d.predecessors[target][source] = struct{}{}
+
+	if len(forward) > 0 {
+		d.reorder(backward, forward)
+	}
+
+	return nil
+}
+
+// RemoveEdge removes the edge between the given vertices from the graph. The
+// current order remains a valid topological order.
+func (d *DynamicTopologicalOrder[K, T]) RemoveEdge(source, target K) error {
+	if err := d.g.RemoveEdge(source, target); err != nil {
+		return err
+	}
+
+	delete(d.adjacencies[source], target)
+	delete(d.predecessors[target], source)
+
+	return nil
+}
+
+// RemoveVertex removes the given vertex from the graph and the order. As with
+// the graph's RemoveVertex method, the vertex must not have any edges. Since
+// the positions of all following vertices change, this takes O(|V|) time.
+func (d *DynamicTopologicalOrder[K, T]) RemoveVertex(vertex K) error {
+	if err := d.g.RemoveVertex(vertex); err != nil {
+		return err
+	}
+
+	position := d.positions[vertex]
+
+	d.order = append(d.order[:position], d.order[position+1:]...)
+
+	for i := position; i < len(d.order); i++ {
+		d.positions[d.order[i]] = i
+	}
+
+	delete(d.positions, vertex)
+	delete(d.adjacencies, vertex)
+	delete(d.predecessors, vertex)
+
+	return nil
+}
+
+// search performs a DFS from the given start vertex along the given edges,
+// only visiting vertices whose positions are within the bounds. It returns all
+// visited vertices, or false if the forbidden vertex has been reached.
+func (d *DynamicTopologicalOrder[K, T]) search(start K, edges map[K]map[K]struct{}, withinBounds func(int) bool, forbidden K) ([]K, bool) {
+	visited := map[K]struct{}{start: {}}
+	result := []K{start}
+
+	stack := newStack[K]()
+	stack.push(start)
+
+	for !stack.isEmpty() {
+		current, _ := stack.pop()
+
+		for next := range edges[current] {
+			if next == forbidden {
+				return nil, false
+			}
+
+			if _, ok := visited[next]; ok || !withinBounds(d.positions[next]) {
+				continue
+			}
+
+			visited[next] = struct{}{}
+			result = append(result, next)
+			stack.push(next)
+		}
+	}
+
+	return result, true
+}
+
+// reorder moves the vertices that reach the source of the new edge in front of
+// the vertices reachable from its target. Both groups keep their relative
+// order, and together they occupy the same positions as before.
+func (d *DynamicTopologicalOrder[K, T]) reorder(backward, forward []K) {
+	byPosition := func(vertices []K) {
+		sort.Slice(vertices, func(i, j int) bool {
+			return d.positions[vertices[i]] < d.positions[vertices[j]]
+		})
+	}
+
+	byPosition(backward)
+	byPosition(forward)
+
+	vertices := append(backward, forward...)
+
+	positions := make([]int, len(vertices))
+	for i, vertex := range vertices {
+		positions[i] = d.positions[vertex]
+	}
+
+	sort.Ints(positions)
+
+	for i, vertex := range vertices {
+		d.positions[vertex] = positions[i]
+		d.order[positions[i]] = vertex
+	}
+}
@@ -0,0 +1,496 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestDynamicShortestPaths_UpdateWeight(t *testing.T) {
+	type update struct {
+		source, target string
+		weight         int
+	}
+
+	tests := map[string]struct {
+		isDirected        bool
+		vertices          []string
+		edges             []Edge[string]
+		source            string
+		updates           []update
+		expectedDistances map[string]int
+		expectedPaths     map[string][]string
+		unreachable       []string
+		expectedErr       error
+	}{
+		"decrease a non-tree edge": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 5}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 5}},
+			},
+			source: "A",
+			updates: []update{
+				{source: "A", target: "C", weight: 0},
+				{source: "C", target: "D", weight: 1},
+			},
+			expectedDistances: map[string]int{"A": 0, "B": 1, "C": 0, "D": 1},
+			expectedPaths: map[string][]string{
+				"C": {"A", "C"},
+			},
+		},
+		"increase a tree edge": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C", "D", "E"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "D", Target: "E", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 2}},
+			},
+			source: "A",
+			updates: []update{
+				{source: "B", target: "D", weight: 10},
+			},
+			expectedDistances: map[string]int{"A": 0, "B": 1, "C": 2, "D": 4, "E": 5},
+			expectedPaths: map[string][]string{
+				"E": {"A", "C", "D", "E"},
+			},
+		},
+		"increase a non-tree edge": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 1}},
+			},
+			source: "A",
+			updates: []update{
+				{source: "A", target: "C", weight: 7},
+			},
+			expectedDistances: map[string]int{"A": 0, "B": 1, "C": 2},
+		},
+		"undirected graph": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "D", Properties: EdgeProperties{Weight: 10}},
+			},
+			source: "A",
+			updates: []update{
+				{source: "D", target: "A", weight: 1},
+				{source: "B", target: "C", weight: 5},
+			},
+			expectedDistances: map[string]int{"A": 0, "B": 1, "C": 2, "D": 1},
+			expectedPaths: map[string][]string{
+				"C": {"A", "D", "C"},
+			},
+		},
+		"increase makes vertices unreachable": {
+			isDirected: true,
+			vertices:   []string{"A", "B", "C"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+			},
+			source: "A",
+			updates: []update{
+				{source: "A", target: "B", weight: 3},
+			},
+			expectedDistances: map[string]int{"A": 0, "B": 3},
+			unreachable:       []string{"C"},
+		},
+		"non-existent edge": {
+			isDirected: true,
+			vertices:   []string{"A", "B"},
+			edges:      []Edge[string]{},
+			source:     "A",
+			updates: []update{
+				{source: "A", target: "B", weight: 3},
+			},
+			expectedErr: ErrEdgeNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var g Graph[string, string]
+
+			if test.isDirected {
+				g = New(StringHash, Directed(), Weighted())
+			} else {
+				g = New(StringHash, Weighted())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(copyEdge(edge)); err != nil {
+					t.Fatalf("failed to add edge: %s", err.Error())
+				}
+			}
+
+			d, err := NewDynamicShortestPaths(g, test.source)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, u := range test.updates {
+				err = d.UpdateWeight(u.source, u.target, u.weight)
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			for vertex, expectedDistance := range test.expectedDistances {
+				distance, err := d.Distance(vertex)
+				if err != nil {
+					t.Fatalf("unexpected error for %v: %v", vertex, err)
+				}
+				if distance != expectedDistance {
+					t.Errorf("expected distance %v to %v, got %v", expectedDistance, vertex, distance)
+				}
+			}
+
+			for vertex, expectedPath := range test.expectedPaths {
+				path, err := d.ShortestPath(vertex)
+				if err != nil {
+					t.Fatalf("unexpected error for %v: %v", vertex, err)
+				}
+				if len(path) != len(expectedPath) {
+					t.Fatalf("expected path %v, got %v", expectedPath, path)
+				}
+				for i := range path {
+					if path[i] != expectedPath[i] {
+						t.Errorf("expected path %v, got %v", expectedPath, path)
+					}
+				}
+			}
+
+			for _, vertex := range test.unreachable {
+				if _, err := d.Distance(vertex); !errors.Is(err, ErrTargetNotReachable) {
+					t.Errorf("expected error %v for %v, got %v", ErrTargetNotReachable, vertex, err)
+				}
+			}
+
+			// The repaired tree has to match a tree computed from scratch.
+			fresh, err := NewDynamicShortestPaths(g, test.source)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, vertex := range test.vertices {
+				expected, expectedErr := fresh.Distance(vertex)
+				actual, actualErr := d.Distance(vertex)
+				if expected != actual || !errors.Is(actualErr, expectedErr) {
+					t.Errorf("expected distance %v (%v) to %v, got %v (%v)", expected, expectedErr, vertex, actual, actualErr)
+				}
+			}
+		})
+	}
+}
+
+func TestDynamicShortestPaths_Grid(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	const size = 6
+
+	for i := 0; i < size*size; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	for i := 0; i < size*size; i++ {
+		if i%size < size-1 {
+			_ = g.AddEdge(i, i+1, EdgeWeight(1+i%3))
+		}
+		if i+size < size*size {
+			_ = g.AddEdge(i, i+size, EdgeWeight(1+i%4))
+		}
+	}
+
+	d, err := NewDynamicShortestPaths(g, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	edges, _ := g.Edges()
+
+	for i, edge := range edges {
+		weight := (i*7 + 3) % 9
+
+		if err := d.UpdateWeight(edge.Source, edge.Target, weight); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		fresh, _ := NewDynamicShortestPaths(g, 0)
+
+		for vertex := 0; vertex < size*size; vertex++ {
+			expected, _ := fresh.Distance(vertex)
+			actual, _ := d.Distance(vertex)
+			if expected != actual {
+				t.Fatalf("update %d: expected distance %v to %v, got %v", i, expected, vertex, actual)
+			}
+		}
+	}
+}
+
+func TestDynamicShortestPaths_UpdateWeights(t *testing.T) {
+	for _, isDirected := range []bool{true, false} {
+		var g Graph[int, int]
+
+		if isDirected {
+			g = New(IntHash, Directed(), Weighted())
+		} else {
+			g = New(IntHash, Weighted())
+		}
+
+		const size = 6
+
+		for i := 0; i < size*size; i++ {
+			_ = g.AddVertex(i)
+		}
+
+		for i := 0; i < size*size; i++ {
+			if i%size < size-1 {
+				_ = g.AddEdge(i, i+1, EdgeWeight(2+i%3))
+			}
+			if i+size < size*size {
+				_ = g.AddEdge(i, i+size, EdgeWeight(1+i%4))
+			}
+		}
+
+		d, err := NewDynamicShortestPaths(g, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		edges, _ := g.Edges()
+
+		for round := 0; round < 5; round++ {
+			weights := make(map[EdgeKey[int]]int)
+
+			// Update every third edge with weights that are partly higher and
+			// partly lower than before.
+			for i := round % 3; i < len(edges); i += 3 {
+				key := EdgeKey[int]{Source: edges[i].Source, Target: edges[i].Target}
+				weights[key] = (i*5 + round*3) % 7
+			}
+
+			if err := d.UpdateWeights(weights); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			fresh, _ := NewDynamicShortestPaths(g, 0)
+
+			for vertex := 0; vertex < size*size; vertex++ {
+				expected, _ := fresh.Distance(vertex)
+				actual, _ := d.Distance(vertex)
+				if expected != actual {
+					t.Fatalf("directed=%v, round %d: expected distance %v to %v, got %v", isDirected, round, expected, vertex, actual)
+				}
+			}
+		}
+
+		err = d.UpdateWeights(map[EdgeKey[int]]int{
+			{Source: 0, Target: 1}:  3,
+			{Source: 0, Target: 35}: 3,
+		})
+		if !errors.Is(err, ErrEdgeNotFound) {
+			t.Errorf("expected error %v, got %v", ErrEdgeNotFound, err)
+		}
+	}
+}
+
+func TestDynamicTopologicalOrder_AddEdge(t *testing.T) {
+	tests := map[string]struct {
+		edges         []Edge[int]
+		expectedOrder []int
+		expectedErr   error
+	}{
+		"edge in order": {
+			edges:         []Edge[int]{{Source: 1, Target: 4}},
+			expectedOrder: []int{1, 2, 3, 4},
+		},
+		"edge against order": {
+			edges:         []Edge[int]{{Source: 4, Target: 1}},
+			expectedOrder: []int{4, 1, 2, 3},
+		},
+		"edges against order with dependencies": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			expectedOrder: []int{3, 4, 1, 2},
+		},
+		"cycle": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedOrder: []int{1, 2, 3, 4},
+			expectedErr:   ErrEdgeCreatesCycle,
+		},
+		"self-loop": {
+			edges:         []Edge[int]{{Source: 2, Target: 2}},
+			expectedOrder: []int{1, 2, 3, 4},
+			expectedErr:   ErrEdgeCreatesCycle,
+		},
+		"existing edge": {
+			edges:         []Edge[int]{{Source: 1, Target: 2}, {Source: 1, Target: 2}},
+			expectedOrder: []int{1, 2, 3, 4},
+			expectedErr:   ErrEdgeAlreadyExists,
+		},
+		"unknown vertex": {
+			edges:         []Edge[int]{{Source: 1, Target: 5}},
+			expectedOrder: []int{1, 2, 3, 4},
+			expectedErr:   ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+			d, err := NewDynamicTopologicalOrder(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, vertex := range []int{1, 2, 3, 4} {
+				if err := d.AddVertex(vertex); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			var lastErr error
+
+			for _, edge := range test.edges {
+				lastErr = d.AddEdge(edge.Source, edge.Target)
+			}
+
+			if !errors.Is(lastErr, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, lastErr)
+			}
+
+			if order := d.Order(); !slicesAreEqual(order, test.expectedOrder) {
+				t.Errorf("expected order %v, got %v", test.expectedOrder, order)
+			}
+
+			if test.expectedErr == ErrEdgeCreatesCycle {
+				last := test.edges[len(test.edges)-1]
+				if _, err := g.Edge(last.Source, last.Target); !errors.Is(err, ErrEdgeNotFound) {
+					t.Errorf("expected rejected edge not to be added, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestDynamicTopologicalOrder_Random(t *testing.T) {
+	const n = 40
+
+	for seed := int64(0); seed < 10; seed++ {
+		rng := rand.New(rand.NewSource(seed))
+
+		g := New(IntHash, Directed())
+		for i := 0; i < n; i++ {
+			_ = g.AddVertex(i)
+		}
+
+		d, err := NewDynamicTopologicalOrder(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for i := 0; i < 300; i++ {
+			source, target := rng.Intn(n), rng.Intn(n)
+
+			if _, err := g.Edge(source, target); err == nil {
+				if err := d.RemoveEdge(source, target); err != nil {
+					t.Fatalf("seed %d: unexpected error: %v", seed, err)
+				}
+				continue
+			}
+
+			createsCycle, _ := CreatesCycle(g, source, target)
+
+			err := d.AddEdge(source, target)
+
+			if createsCycle != errors.Is(err, ErrEdgeCreatesCycle) {
+				t.Fatalf("seed %d: expected cycle: %v, got error %v for edge (%d, %d)", seed, createsCycle, err, source, target)
+			}
+
+			if !createsCycle && err != nil {
+				t.Fatalf("seed %d: unexpected error: %v", seed, err)
+			}
+		}
+
+		order := d.Order()
+
+		edges, _ := g.Edges()
+		for _, edge := range edges {
+			sourcePosition, _ := d.Position(edge.Source)
+			targetPosition, _ := d.Position(edge.Target)
+
+			if sourcePosition >= targetPosition {
+				t.Fatalf("seed %d: expected %d to precede %d in order %v", seed, edge.Source, edge.Target, order)
+			}
+		}
+
+		for i, vertex := range order {
+			if position, _ := d.Position(vertex); position != i {
+				t.Fatalf("seed %d: expected position %d for %d, got %d", seed, i, vertex, position)
+			}
+		}
+	}
+}
+
+func TestDynamicTopologicalOrder_RemoveVertex(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(3, 2)
+
+	d, err := NewDynamicTopologicalOrder(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := d.RemoveVertex(2); !errors.Is(err, ErrVertexHasEdges) {
+		t.Fatalf("expected error %v, got %v", ErrVertexHasEdges, err)
+	}
+
+	_ = d.RemoveEdge(3, 2)
+
+	if err := d.RemoveVertex(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if order := d.Order(); len(order) != 2 {
+		t.Fatalf("expected 2 vertices, got %v", order)
+	}
+
+	if _, err := d.Position(2); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+
+	if _, err := NewDynamicTopologicalOrder(New(IntHash)); err == nil {
+		t.Errorf("expected error for undirected graph, got nil")
+	}
+}
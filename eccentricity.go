@@ -0,0 +1,201 @@
+package graph
+
+import "fmt"
+
+// Eccentricity computes the eccentricity of the given vertex, which is the
+// greatest distance between the vertex and any other vertex. The distances are
+// computed using [ShortestPathTree], so for weighted graphs, the eccentricity is
+// the total weight of the longest shortest path starting at the vertex.
+//
+// If not all vertices are reachable from the given vertex, its eccentricity is
+// infinite and the returned error wraps ErrTargetNotReachable. If the vertex
+// doesn't exist, the returned error wraps ErrVertexNotFound.
+func Eccentricity[K comparable, T any](g Graph[K, T], vertex K) (int, error) {
+	if _, err := g.Vertex(vertex); err != nil {
+		return 0, fmt.Errorf("could not get vertex with hash %v: %w", vertex, err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	eccentricity, _, err := eccentricityOf(g, adjacencyMap, vertex)
+
+	return eccentricity, err
+}
+
+// Diameter computes the diameter of the given graph, which is the greatest
+// eccentricity of all vertices, i.e. the length of the longest shortest path.
+// For an empty graph, the diameter is 0.
+//
+// Diameter computes the shortest paths from every vertex, which takes
+// O(|V|*(|V|+|E|)) time for unweighted graphs and O(|V|*(|V|+|E|log(|V|)))
+// time for weighted graphs. For large graphs, consider [ApproximateDiameter].
+//
+// If the graph is not connected, or not strongly connected in case of a
+// directed graph, the diameter is infinite and the returned error wraps
+// ErrTargetNotReachable.
+func Diameter[K comparable, T any](g Graph[K, T]) (int, error) {
+	eccentricities, err := eccentricitiesOf(g)
+	if err != nil {
+		return 0, err
+	}
+
+	_, diameter := eccentricityBounds(eccentricities)
+
+	return diameter, nil
+}
+
+// Radius computes the radius of the given graph, which is the smallest
+// eccentricity of all vertices. For an empty graph, the radius is 0. It has the
+// same time complexity and requirements as [Diameter].
+func Radius[K comparable, T any](g Graph[K, T]) (int, error) {
+	eccentricities, err := eccentricitiesOf(g)
+	if err != nil {
+		return 0, err
+	}
+
+	radius, _ := eccentricityBounds(eccentricities)
+
+	return radius, nil
+}
+
+// Center returns the center of the given graph, which is the set of vertices
+// whose eccentricity equals the radius of the graph. These are the vertices
+// that minimize the greatest distance to any other vertex, making them the
+// optimal locations for a single facility that serves all vertices. For an
+// empty graph, the center is empty.
+//
+// Center has the same time complexity and requirements as [Diameter].
+func Center[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	eccentricities, err := eccentricitiesOf(g)
+	if err != nil {
+		return nil, err
+	}
+
+	radius, _ := eccentricityBounds(eccentricities)
+
+	return verticesWithEccentricity(eccentricities, radius), nil
+}
+
+// Periphery returns the periphery of the given graph, which is the set of
+// vertices whose eccentricity equals the diameter of the graph. For an empty
+// graph, the periphery is empty.
+//
+// Periphery has the same time complexity and requirements as [Diameter].
+func Periphery[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	eccentricities, err := eccentricitiesOf(g)
+	if err != nil {
+		return nil, err
+	}
+
+	_, diameter := eccentricityBounds(eccentricities)
+
+	return verticesWithEccentricity(eccentricities, diameter), nil
+}
+
+// ApproximateDiameter approximates the diameter of the given graph using the
+// double sweep heuristic: Starting at an arbitrary vertex, it searches for the
+// vertex farthest away from it. The eccentricity of that vertex is returned as
+// the approximated diameter.
+//
+// This only takes two shortest path searches, and the result is a lower bound
+// for the actual diameter. For trees, it is exact. Since the start vertex is
+// chosen arbitrarily, the result may vary between calls for other graphs. As
+// with [Diameter], the returned error wraps ErrTargetNotReachable if the graph
+// is not (strongly) connected.
+func ApproximateDiameter[K comparable, T any](g Graph[K, T]) (int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	for start := range adjacencyMap {
+		_, farthest, err := eccentricityOf(g, adjacencyMap, start)
+		if err != nil {
+			return 0, err
+		}
+
+		diameter, _, err := eccentricityOf(g, adjacencyMap, farthest)
+
+		return diameter, err
+	}
+
+	return 0, nil
+}
+
+// eccentricitiesOf computes the eccentricities of all vertices in g.
+func eccentricitiesOf[K comparable, T any](g Graph[K, T]) (map[K]int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	eccentricities := make(map[K]int, len(adjacencyMap))
+
+	for vertex := range adjacencyMap {
+		eccentricity, _, err := eccentricityOf(g, adjacencyMap, vertex)
+		if err != nil {
+			return nil, err
+		}
+
+		eccentricities[vertex] = eccentricity
+	}
+
+	return eccentricities, nil
+}
+
+// eccentricityBounds returns the smallest and the greatest of the given
+// eccentricities, which are the radius and the diameter of the graph.
+func eccentricityBounds[K comparable](eccentricities map[K]int) (int, int) {
+	radius, diameter, isFirst := 0, 0, true
+
+	for _, eccentricity := range eccentricities {
+		if isFirst || eccentricity < radius {
+			radius = eccentricity
+		}
+		if eccentricity > diameter {
+			diameter = eccentricity
+		}
+		isFirst = false
+	}
+
+	return radius, diameter
+}
+
+// verticesWithEccentricity returns all vertices with the given eccentricity.
+func verticesWithEccentricity[K comparable](eccentricities map[K]int, eccentricity int) []K {
+	vertices := make([]K, 0)
+
+	for vertex, e := range eccentricities {
+		if e == eccentricity {
+			vertices = append(vertices, vertex)
+		}
+	}
+
+	return vertices
+}
+
+// eccentricityOf computes the eccentricity of the given vertex along with a
+// vertex at the greatest distance from it.
+func eccentricityOf[K comparable, T any](g Graph[K, T], adjacencyMap map[K]map[K]Edge[K], vertex K) (int, K, error) {
+	paths, err := shortestPathTree(g, adjacencyMap, vertex)
+	if err != nil {
+		return 0, vertex, fmt.Errorf("failed to compute shortest paths from vertex %v: %w", vertex, err)
+	}
+
+	if len(paths.distances) < len(adjacencyMap) {
+		return 0, vertex, fmt.Errorf("not all vertices are reachable from vertex %v: %w", vertex, ErrTargetNotReachable)
+	}
+
+	eccentricity, farthest := 0.0, vertex
+
+	for target, distance := range paths.distances {
+		if distance > eccentricity {
+			eccentricity, farthest = distance, target
+		}
+	}
+
+	return int(eccentricity), farthest, nil
+}
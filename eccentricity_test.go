@@ -0,0 +1,255 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEccentricity(t *testing.T) {
+	tests := map[string]struct {
+		g                      Graph[int, int]
+		expectedEccentricities map[int]int
+		expectedDiameter       int
+		expectedRadius         int
+		expectedErr            error
+	}{
+		"undirected tree": {
+			g: newEccentricityGraph(false, []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 2, Target: 6},
+			}),
+			expectedEccentricities: map[int]int{1: 4, 2: 3, 3: 2, 4: 3, 5: 4, 6: 4},
+			expectedDiameter:       4,
+			expectedRadius:         2,
+		},
+		"weighted graph": {
+			g: newEccentricityGraph(true, []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 5}},
+			}),
+			expectedEccentricities: map[int]int{1: 2, 2: 1, 3: 2},
+			expectedDiameter:       2,
+			expectedRadius:         1,
+		},
+		"directed cycle": {
+			g: newEccentricityGraph(false, []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			}, Directed()),
+			expectedEccentricities: map[int]int{1: 2, 2: 2, 3: 2},
+			expectedDiameter:       2,
+			expectedRadius:         2,
+		},
+		"disconnected graph": {
+			g: newEccentricityGraph(false, []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 4},
+			}),
+			expectedErr: ErrTargetNotReachable,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diameter, err := Diameter(test.g)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			radius, err := Radius(test.g)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			for vertex, expected := range test.expectedEccentricities {
+				eccentricity, err := Eccentricity(test.g, vertex)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if eccentricity != expected {
+					t.Errorf("expected eccentricity %d for vertex %d, got %d", expected, vertex, eccentricity)
+				}
+			}
+
+			if diameter != test.expectedDiameter {
+				t.Errorf("expected diameter %d, got %d", test.expectedDiameter, diameter)
+			}
+
+			if radius != test.expectedRadius {
+				t.Errorf("expected radius %d, got %d", test.expectedRadius, radius)
+			}
+		})
+	}
+}
+
+func TestEccentricity_missingVertex(t *testing.T) {
+	g := New(IntHash)
+
+	if _, err := Eccentricity(g, 1); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+}
+
+func TestDiameter_emptyGraph(t *testing.T) {
+	g := New(IntHash)
+
+	if diameter, err := Diameter(g); err != nil || diameter != 0 {
+		t.Errorf("expected diameter 0, got %d, %v", diameter, err)
+	}
+
+	if radius, err := Radius(g); err != nil || radius != 0 {
+		t.Errorf("expected radius 0, got %d, %v", radius, err)
+	}
+
+	if diameter, err := ApproximateDiameter(g); err != nil || diameter != 0 {
+		t.Errorf("expected approximated diameter 0, got %d, %v", diameter, err)
+	}
+}
+
+func TestApproximateDiameter(t *testing.T) {
+	// For trees, the double sweep heuristic yields the exact diameter
+	// regardless of the start vertex.
+	g := newEccentricityGraph(false, []Edge[int]{
+		{Source: 1, Target: 2},
+		{Source: 2, Target: 3},
+		{Source: 3, Target: 4},
+		{Source: 4, Target: 5},
+		{Source: 2, Target: 6},
+		{Source: 6, Target: 7},
+	})
+
+	for i := 0; i < 10; i++ {
+		diameter, err := ApproximateDiameter(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if diameter != 5 {
+			t.Fatalf("expected diameter 5, got %d", diameter)
+		}
+	}
+
+	disconnected := newEccentricityGraph(false, []Edge[int]{
+		{Source: 1, Target: 2},
+		{Source: 3, Target: 4},
+	})
+
+	if _, err := ApproximateDiameter(disconnected); !errors.Is(err, ErrTargetNotReachable) {
+		t.Errorf("expected error %v, got %v", ErrTargetNotReachable, err)
+	}
+}
+
+func TestCenter(t *testing.T) {
+	tests := map[string]struct {
+		edges             []Edge[int]
+		expectedCenter    []int
+		expectedPeriphery []int
+	}{
+		"path with odd number of vertices": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			expectedCenter:    []int{3},
+			expectedPeriphery: []int{1, 5},
+		},
+		"path with even number of vertices": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expectedCenter:    []int{2, 3},
+			expectedPeriphery: []int{1, 4},
+		},
+		"tree with branch": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 2, Target: 6},
+			},
+			expectedCenter:    []int{3},
+			expectedPeriphery: []int{1, 5, 6},
+		},
+		"cycle": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedCenter:    []int{1, 2, 3},
+			expectedPeriphery: []int{1, 2, 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newEccentricityGraph(false, test.edges)
+
+			center, err := Center(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slicesAreEqual(center, test.expectedCenter) {
+				t.Errorf("expected center %v, got %v", test.expectedCenter, center)
+			}
+
+			periphery, err := Periphery(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slicesAreEqual(periphery, test.expectedPeriphery) {
+				t.Errorf("expected periphery %v, got %v", test.expectedPeriphery, periphery)
+			}
+		})
+	}
+}
+
+func TestCenter_disconnectedGraph(t *testing.T) {
+	g := newEccentricityGraph(false, []Edge[int]{
+		{Source: 1, Target: 2},
+		{Source: 3, Target: 4},
+	})
+
+	if _, err := Center(g); !errors.Is(err, ErrTargetNotReachable) {
+		t.Errorf("expected error %v, got %v", ErrTargetNotReachable, err)
+	}
+
+	if _, err := Periphery(g); !errors.Is(err, ErrTargetNotReachable) {
+		t.Errorf("expected error %v, got %v", ErrTargetNotReachable, err)
+	}
+}
+
+// newEccentricityGraph creates a graph with the vertices used by the given
+// edges.
+func newEccentricityGraph(isWeighted bool, edges []Edge[int], options ...func(*Traits)) Graph[int, int] {
+	if isWeighted {
+		options = append(options, Weighted())
+	}
+
+	g := New(IntHash, options...)
+
+	for _, edge := range edges {
+		_ = g.AddVertex(edge.Source)
+		_ = g.AddVertex(edge.Target)
+		_ = g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight))
+	}
+
+	return g
+}
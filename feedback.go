@@ -0,0 +1,178 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FeedbackArcSet computes a small feedback arc set of the given directed graph.
+// A feedback arc set is a set of edges whose removal makes the graph acyclic.
+// This is useful for breaking cycles in almost acyclic graphs, for example
+// before drawing the graph in layers or before determining a build order:
+//
+//	edges, _ := graph.FeedbackArcSet(g)
+//
+//	for _, edge := range edges {
+//		_ = g.RemoveEdge(edge.Source, edge.Target)
+//	}
+//
+// Instead of removing the edges, they can also be reversed, which makes the
+// graph acyclic as well unless a reversed edge already exists. Self-loops are
+// always part of the feedback arc set, and can't be reversed.
+//
+// Finding a minimum feedback arc set is NP-hard, so the heuristic of Eades,
+// Lin, and Smyth is used: It arranges the vertices in a sequence that starts
+// with sources and ends with sinks, and where the remaining vertices are added
+// in the order of the difference between their out-degree and in-degree. All
+// edges pointing backwards in this sequence form the feedback arc set. For a
+// weighted graph, the edge weights are used instead of the degrees, so that
+// the total weight of the feedback arc set is kept small. This takes
+// O((|V|+|E|)*log(|V|)) time.
+//
+// FeedbackArcSet can only be computed on directed graphs.
+func FeedbackArcSet[K comparable, T any](g Graph[K, T]) ([]Edge[K], error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("feedback arc set cannot be computed on undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	weightOf := func(edge Edge[K]) float64 {
+		if isWeighted {
+			return float64(edge.Properties.Weight)
+		}
+		return 1
+	}
+
+	// For each vertex, the number of ingoing and outgoing edges to vertices
+	// that haven't been arranged yet is tracked, as well as the difference
+	// between the weights of these outgoing and ingoing edges.
+	inDegrees := make(map[K]int, len(adjacencyMap))
+	outDegrees := make(map[K]int, len(adjacencyMap))
+	deltas := make(map[K]float64, len(adjacencyMap))
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if source == target {
+				continue
+			}
+			outDegrees[source]++
+			inDegrees[target]++
+			deltas[source] += weightOf(edge)
+			deltas[target] -= weightOf(edge)
+		}
+	}
+
+	sources := make([]K, 0)
+	sinks := make([]K, 0)
+	queue := newPriorityQueue[K]()
+
+	for vertex := range adjacencyMap {
+		if inDegrees[vertex] == 0 {
+			sources = append(sources, vertex)
+		} else if outDegrees[vertex] == 0 {
+			sinks = append(sinks, vertex)
+		}
+		queue.Push(vertex, -deltas[vertex])
+	}
+
+	arranged := make(map[K]bool, len(adjacencyMap))
+
+	// head contains the vertices arranged at the start of the sequence, tail
+	// contains the vertices arranged at the end of the sequence in reverse.
+	head := make([]K, 0, len(adjacencyMap))
+	tail := make([]K, 0)
+
+	arrange := func(vertex K) {
+		arranged[vertex] = true
+
+		for target, edge := range adjacencyMap[vertex] {
+			if arranged[target] {
+				continue
+			}
+			inDegrees[target]--
+			deltas[target] += weightOf(edge)
+			queue.UpdatePriority(target, -deltas[target])
+
+			if inDegrees[target] == 0 {
+				sources = append(sources, target)
+			}
+		}
+
+		for source, edge := range predecessorMap[vertex] {
+			if arranged[source] {
+				continue
+			}
+			outDegrees[source]--
+			deltas[source] -= weightOf(edge)
+			queue.UpdatePriority(source, -deltas[source])
+
+			if outDegrees[source] == 0 {
+				sinks = append(sinks, source)
+			}
+		}
+	}
+
+	for len(head)+len(tail) < len(adjacencyMap) {
+		switch {
+		case len(sinks) > 0:
+			vertex := sinks[len(sinks)-1]
+			sinks = sinks[:len(sinks)-1]
+
+			if !arranged[vertex] {
+				tail = append(tail, vertex)
+				arrange(vertex)
+			}
+		case len(sources) > 0:
+			vertex := sources[len(sources)-1]
+			sources = sources[:len(sources)-1]
+
+			if !arranged[vertex] {
+				head = append(head, vertex)
+				arrange(vertex)
+			}
+		default:
+			vertex, err := queue.Pop()
+			if err != nil {
+				return nil, fmt.Errorf("failed to pop vertex: %w", err)
+			}
+
+			if !arranged[vertex] {
+				head = append(head, vertex)
+				arrange(vertex)
+			}
+		}
+	}
+
+	positions := make(map[K]int, len(adjacencyMap))
+
+	for i, vertex := range head {
+		positions[vertex] = i
+	}
+
+	for i, vertex := range tail {
+		positions[vertex] = len(adjacencyMap) - 1 - i
+	}
+
+	feedbackArcSet := make([]Edge[K], 0)
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if positions[source] >= positions[target] {
+				feedbackArcSet = append(feedbackArcSet, edge)
+			}
+		}
+	}
+
+	return feedbackArcSet, nil
+}
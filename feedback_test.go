@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestFeedbackArcSet(t *testing.T) {
+	tests := map[string]struct {
+		isWeighted    bool
+		vertices      []int
+		edges         []Edge[int]
+		expectedEdges []Edge[int]
+		expectedSize  int
+	}{
+		"acyclic graph": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expectedSize: 0,
+		},
+		"single cycle": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expectedSize: 1,
+		},
+		"self-loop": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			expectedEdges: []Edge[int]{{Source: 1, Target: 1}},
+			expectedSize:  1,
+		},
+		"weighted cycle": {
+			isWeighted: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 5}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedEdges: []Edge[int]{{Source: 3, Target: 1}},
+			expectedSize:  1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+			if test.isWeighted {
+				g = New(IntHash, Directed(), Weighted())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight))
+			}
+
+			edges, err := FeedbackArcSet(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(edges) != test.expectedSize {
+				t.Fatalf("expected %d edges, got %v", test.expectedSize, edges)
+			}
+
+			for i, expected := range test.expectedEdges {
+				if edges[i].Source != expected.Source || edges[i].Target != expected.Target {
+					t.Errorf("expected edge (%d, %d), got (%d, %d)", expected.Source, expected.Target, edges[i].Source, edges[i].Target)
+				}
+			}
+
+			for _, edge := range edges {
+				_ = g.RemoveEdge(edge.Source, edge.Target)
+			}
+
+			if _, err := TopologicalSort(g); err != nil {
+				t.Errorf("expected graph to be acyclic: %v", err)
+			}
+		})
+	}
+}
+
+func TestFeedbackArcSet_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10; i++ {
+		g := New(IntHash, Directed())
+
+		for vertex := 0; vertex < 50; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for j := 0; j < 150; j++ {
+			if source, target := random.Intn(50), random.Intn(50); source != target {
+				_ = g.AddEdge(source, target)
+			}
+		}
+
+		edges, err := FeedbackArcSet(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		size, _ := g.Size()
+
+		// Without self-loops, the heuristic guarantees that at most half of the
+		// edges are removed.
+		if len(edges) > size/2 {
+			t.Errorf("expected at most %d edges, got %d", size/2, len(edges))
+		}
+
+		for _, edge := range edges {
+			_ = g.RemoveEdge(edge.Source, edge.Target)
+		}
+
+		if _, err := TopologicalSort(g); err != nil {
+			t.Fatalf("expected graph to be acyclic: %v", err)
+		}
+	}
+}
+
+func TestFeedbackArcSet_undirected(t *testing.T) {
+	if _, err := FeedbackArcSet(New(IntHash)); err == nil {
+		t.Error("expected error for undirected graph, got nil")
+	}
+}
@@ -0,0 +1,270 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Flow is a flow through a directed graph from a source to a sink vertex, as
+// computed by [MinCostMaxFlow].
+type Flow[K comparable] struct {
+	// Value is the total amount of flow leaving the source and entering the
+	// sink.
+	Value int
+	// Cost is the total cost of the flow, which is the sum of the flow on each
+	// edge multiplied by the cost of the edge.
+	Cost int
+	// Edges contains the flow on each edge that carries a positive flow, keyed
+	// by the source and target vertex of the edge.
+	Edges map[K]map[K]int
+}
+
+// MinCostMaxFlow computes a maximum flow from the source to the sink vertex
+// that has the minimum total cost among all maximum flows. The capacity of each
+// edge, that is, the maximum amount of flow it can carry, is determined by the
+// given function. In a weighted graph, the cost of sending one unit of flow
+// through an edge is its weight. In an unweighted graph, it is 1 for each edge,
+// as in [ShortestPath]. This solves optimization problems such as distributing
+// goods from a warehouse at the lowest cost, or assigning workers to tasks:
+//
+//	flow, _ := graph.MinCostMaxFlow(g, "warehouse", "store", func(edge graph.Edge[string]) int {
+//		capacity, _ := strconv.Atoi(edge.Properties.Attributes["capacity"])
+//		return capacity
+//	})
+//
+//	fmt.Printf("shipping %d units costs %d\n", flow.Value, flow.Cost)
+//
+// MinCostMaxFlow uses the successive shortest path algorithm: It repeatedly
+// sends as much flow as possible along the cheapest path from the source to the
+// sink in the residual graph, which also allows undoing flow sent earlier. The
+// cheapest paths are found using Dijkstra's algorithm on costs reweighted with
+// vertex potentials, like in [AllPairsShortestPaths]. Hence, edges may have a
+// negative cost as long as there is no cycle with a negative total cost. This
+// takes O(F*|E|log(|V|)) time, where F is the value of the maximum flow.
+//
+// MinCostMaxFlow can only be computed on directed graphs. Edges with a capacity
+// of 0 are ignored, and negative capacities are rejected with an error. If the
+// source or sink vertex doesn't exist, the returned error wraps
+// ErrVertexNotFound.
+func MinCostMaxFlow[K comparable, T any](g Graph[K, T], source, sink K, capacity func(Edge[K]) int) (*Flow[K], error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("min-cost max-flow cannot be computed on undirected graph")
+	}
+
+	if _, err := g.Vertex(source); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", source, err)
+	}
+
+	if _, err := g.Vertex(sink); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", sink, err)
+	}
+
+	if source == sink {
+		return nil, errors.New("source and sink must be different vertices")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	// costs contains the edges that have a positive capacity, where the weight
+	// of each edge is its cost.
+	costs := make(map[K]map[K]Edge[K], len(adjacencyMap))
+
+	r := &residualGraph[K]{
+		indices: make(map[K]int, len(adjacencyMap)),
+		hashes:  make([]K, 0, len(adjacencyMap)),
+	}
+
+	for hash := range adjacencyMap {
+		r.indices[hash] = len(r.hashes)
+		r.hashes = append(r.hashes, hash)
+		costs[hash] = make(map[K]Edge[K])
+	}
+
+	r.arcs = make([][]int, len(r.hashes))
+
+	for from, adjacencies := range adjacencyMap {
+		for to, edge := range adjacencies {
+			c := capacity(edge)
+
+			if c < 0 {
+				return nil, fmt.Errorf("edge (%v, %v) has a negative capacity", from, to)
+			}
+
+			if c == 0 || from == to {
+				continue
+			}
+
+			cost := 1
+			if isWeighted {
+				cost = edge.Properties.Weight
+			}
+
+			r.add(r.indices[from], r.indices[to], c, cost)
+			costs[from][to] = Edge[K]{Source: from, Target: to, Properties: EdgeProperties{Weight: cost}}
+		}
+	}
+
+	potentials, err := johnsonPotentials(costs)
+	if err != nil {
+		return nil, err
+	}
+
+	h := make([]int, len(r.hashes))
+	for hash, potential := range potentials {
+		h[r.indices[hash]] = potential
+	}
+
+	from, to := r.indices[source], r.indices[sink]
+	flow := &Flow[K]{}
+
+	for {
+		distances, predecessors := r.cheapestPaths(from, h)
+
+		if _, ok := distances[to]; !ok {
+			break
+		}
+
+		// Vertices that are farther away than the sink or not reachable from
+		// the source are treated as if they were as far away as the sink,
+		// which keeps all reweighted costs non-negative.
+		for vertex := range h {
+			if distance, ok := distances[vertex]; ok && distance < distances[to] {
+				h[vertex] += distance
+			} else {
+				h[vertex] += distances[to]
+			}
+		}
+
+		// Determine the bottleneck capacity along the path, then send that
+		// amount of flow through each arc and back through its reverse arc.
+		amount := math.MaxInt
+
+		for vertex := to; vertex != from; {
+			arc := r.residual[predecessors[vertex]]
+			if arc.capacity < amount {
+				amount = arc.capacity
+			}
+			vertex = r.residual[arc.reverse].target
+		}
+
+		for vertex := to; vertex != from; {
+			index := predecessors[vertex]
+			arc := r.residual[index]
+
+			r.residual[index].capacity -= amount
+			r.residual[arc.reverse].capacity += amount
+			flow.Cost += amount * arc.cost
+
+			vertex = r.residual[arc.reverse].target
+		}
+
+		flow.Value += amount
+	}
+
+	flow.Edges = make(map[K]map[K]int)
+
+	// The flow on each original edge equals the capacity of its reverse arc,
+	// which starts at 0 and grows with each unit of flow sent.
+	for _, arc := range r.residual {
+		if !arc.isOriginal {
+			continue
+		}
+
+		amount := r.residual[arc.reverse].capacity
+		if amount == 0 {
+			continue
+		}
+
+		source, target := r.hashes[r.residual[arc.reverse].target], r.hashes[arc.target]
+
+		if _, ok := flow.Edges[source]; !ok {
+			flow.Edges[source] = make(map[K]int)
+		}
+		flow.Edges[source][target] = amount
+	}
+
+	return flow, nil
+}
+
+// residualGraph is the residual graph of a flow, where each edge is represented
+// by an arc with its remaining capacity and a reverse arc whose capacity is the
+// flow on the edge, which can be sent back to undo it.
+type residualGraph[K comparable] struct {
+	indices  map[K]int
+	hashes   []K
+	residual []residualArc
+	// arcs contains the indices of the outgoing arcs of each vertex.
+	arcs [][]int
+}
+
+type residualArc struct {
+	target     int
+	capacity   int
+	cost       int
+	reverse    int
+	isOriginal bool
+}
+
+// add adds an arc for the edge between the given vertices as well as its
+// reverse arc, which initially has no capacity left.
+func (r *residualGraph[K]) add(source, target, capacity, cost int) {
+	index := len(r.residual)
+
+	r.residual = append(r.residual,
+		residualArc{target: target, capacity: capacity, cost: cost, reverse: index + 1, isOriginal: true},
+		residualArc{target: source, capacity: 0, cost: -cost, reverse: index},
+	)
+
+	r.arcs[source] = append(r.arcs[source], index)
+	r.arcs[target] = append(r.arcs[target], index+1)
+}
+
+// cheapestPaths runs Dijkstra's algorithm from the given vertex on the arcs with
+// remaining capacity, using the costs reweighted with the given potentials. It
+// returns the reweighted distance to each reachable vertex and the index of the
+// arc leading to it.
+func (r *residualGraph[K]) cheapestPaths(source int, potentials []int) (map[int]int, map[int]int) {
+	distances := map[int]int{source: 0}
+	predecessors := make(map[int]int)
+	settled := make(map[int]bool)
+
+	queue := newPriorityQueue[int]()
+	queue.Push(source, 0)
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Pop()
+		settled[vertex] = true
+
+		for _, index := range r.arcs[vertex] {
+			arc := r.residual[index]
+
+			if arc.capacity == 0 || settled[arc.target] {
+				continue
+			}
+
+			distance := distances[vertex] + arc.cost + potentials[vertex] - potentials[arc.target]
+
+			current, ok := distances[arc.target]
+			if ok && distance >= current {
+				continue
+			}
+
+			distances[arc.target] = distance
+			predecessors[arc.target] = index
+
+			if ok {
+				queue.UpdatePriority(arc.target, float64(distance))
+			} else {
+				queue.Push(arc.target, float64(distance))
+			}
+		}
+	}
+
+	return distances, predecessors
+}
@@ -0,0 +1,265 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestMinCostMaxFlow(t *testing.T) {
+	tests := map[string]struct {
+		isWeighted    bool
+		edges         []Edge[int]
+		capacities    map[[2]int]int
+		expectedValue int
+		expectedCost  int
+		expectedEdges map[int]map[int]int
+		shouldFail    bool
+	}{
+		"cheaper path is used first": {
+			isWeighted: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 5}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 5}},
+			},
+			capacities: map[[2]int]int{
+				{1, 2}: 2,
+				{2, 4}: 3,
+				{1, 3}: 4,
+				{3, 4}: 1,
+			},
+			expectedValue: 3,
+			expectedCost:  14,
+			expectedEdges: map[int]map[int]int{
+				1: {2: 2, 3: 1},
+				2: {4: 2},
+				3: {4: 1},
+			},
+		},
+		"flow is rerouted through reverse arc": {
+			isWeighted: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: -2}},
+				{Source: 2, Target: 4, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 1}},
+			},
+			capacities: map[[2]int]int{
+				{1, 2}: 1,
+				{1, 3}: 1,
+				{2, 3}: 1,
+				{2, 4}: 1,
+				{3, 4}: 1,
+			},
+			expectedValue: 2,
+			expectedCost:  4,
+			expectedEdges: map[int]map[int]int{
+				1: {2: 1, 3: 1},
+				2: {4: 1},
+				3: {4: 1},
+			},
+		},
+		"unweighted graph": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 4},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 2},
+			},
+			capacities: map[[2]int]int{
+				{1, 2}: 1,
+				{2, 4}: 5,
+				{1, 3}: 1,
+				{3, 2}: 1,
+			},
+			expectedValue: 2,
+			expectedCost:  5,
+			expectedEdges: map[int]map[int]int{
+				1: {2: 1, 3: 1},
+				2: {4: 2},
+				3: {2: 1},
+			},
+		},
+		"sink not reachable": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 4, Target: 3},
+			},
+			capacities: map[[2]int]int{
+				{1, 2}: 1,
+				{4, 3}: 1,
+			},
+			expectedValue: 0,
+			expectedCost:  0,
+			expectedEdges: map[int]map[int]int{},
+		},
+		"negative capacity": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 4},
+			},
+			capacities: map[[2]int]int{
+				{1, 4}: -1,
+			},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+			if test.isWeighted {
+				g = New(IntHash, Directed(), Weighted())
+			}
+
+			for _, vertex := range []int{1, 2, 3, 4} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			flow, err := MinCostMaxFlow(g, 1, 4, func(edge Edge[int]) int {
+				return test.capacities[[2]int{edge.Source, edge.Target}]
+			})
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if flow.Value != test.expectedValue {
+				t.Errorf("expected value %d, got %d", test.expectedValue, flow.Value)
+			}
+
+			if flow.Cost != test.expectedCost {
+				t.Errorf("expected cost %d, got %d", test.expectedCost, flow.Cost)
+			}
+
+			if len(flow.Edges) != len(test.expectedEdges) {
+				t.Fatalf("expected flows %v, got %v", test.expectedEdges, flow.Edges)
+			}
+
+			for source, targets := range test.expectedEdges {
+				for target, expected := range targets {
+					if flow.Edges[source][target] != expected {
+						t.Errorf("expected flow %d on edge (%d, %d), got %d", expected, source, target, flow.Edges[source][target])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMinCostMaxFlow_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20; i++ {
+		g := New(IntHash, Directed(), Weighted())
+		n := 12
+
+		for vertex := 0; vertex < n; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		capacities := make(map[[2]int]int)
+
+		for j := 0; j < 40; j++ {
+			source, target := random.Intn(n), random.Intn(n)
+			if source == target {
+				continue
+			}
+
+			if err := g.AddEdge(source, target, EdgeWeight(random.Intn(10))); err == nil {
+				capacities[[2]int{source, target}] = random.Intn(5)
+			}
+		}
+
+		flow, err := MinCostMaxFlow(g, 0, n-1, func(edge Edge[int]) int {
+			return capacities[[2]int{edge.Source, edge.Target}]
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		edges, _ := g.Edges()
+		balances := make([]int, n)
+		cost := 0
+
+		for _, edge := range edges {
+			amount := flow.Edges[edge.Source][edge.Target]
+			if amount > capacities[[2]int{edge.Source, edge.Target}] {
+				t.Fatalf("expected flow on edge (%d, %d) to be at most its capacity, got %d", edge.Source, edge.Target, amount)
+			}
+
+			balances[edge.Source] -= amount
+			balances[edge.Target] += amount
+			cost += amount * edge.Properties.Weight
+		}
+
+		if balances[0] != -flow.Value || balances[n-1] != flow.Value {
+			t.Fatalf("expected flow of %d from source to sink, got balances %v", flow.Value, balances)
+		}
+
+		for vertex := 1; vertex < n-1; vertex++ {
+			if balances[vertex] != 0 {
+				t.Fatalf("expected flow conservation at vertex %d, got balances %v", vertex, balances)
+			}
+		}
+
+		if cost != flow.Cost {
+			t.Fatalf("expected cost %d, got %d", cost, flow.Cost)
+		}
+
+		// The flow is maximal if the sink isn't reachable in the residual
+		// graph, and it has a minimum cost if the residual graph doesn't
+		// contain a cycle with negative cost.
+		residual := New(IntHash, Directed(), Weighted())
+
+		for vertex := 0; vertex < n; vertex++ {
+			_ = residual.AddVertex(vertex)
+		}
+
+		for _, edge := range edges {
+			amount := flow.Edges[edge.Source][edge.Target]
+
+			if amount < capacities[[2]int{edge.Source, edge.Target}] {
+				_ = residual.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight))
+			}
+
+			if amount > 0 {
+				if err := residual.AddEdge(edge.Target, edge.Source, EdgeWeight(-edge.Properties.Weight)); errors.Is(err, ErrEdgeAlreadyExists) {
+					existing, _ := residual.Edge(edge.Target, edge.Source)
+					if -edge.Properties.Weight < existing.Properties.Weight {
+						_ = residual.UpdateEdge(edge.Target, edge.Source, EdgeWeight(-edge.Properties.Weight))
+					}
+				}
+			}
+		}
+
+		if hasPath, _ := HasPath(residual, 0, n-1); hasPath {
+			t.Fatalf("expected flow of %d to be maximal", flow.Value)
+		}
+
+		if _, err := AllPairsShortestPaths(residual); err != nil {
+			t.Fatalf("expected flow of cost %d to be minimal: %v", flow.Cost, err)
+		}
+	}
+}
+
+func TestMinCostMaxFlow_undirected(t *testing.T) {
+	g := New(IntHash)
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+
+	if _, err := MinCostMaxFlow(g, 1, 2, func(Edge[int]) int { return 1 }); err == nil {
+		t.Error("expected error for undirected graph, got nil")
+	}
+}
@@ -0,0 +1,149 @@
+package graph
+
+import "fmt"
+
+// frozenStore is an immutable store that is built once from an existing graph.
+// Since it never changes after construction, it doesn't need any locking and
+// can be read by multiple goroutines at the same time.
+type frozenStore[K comparable, T any] struct {
+	hashes           []K
+	vertices         map[K]T
+	vertexProperties map[K]VertexProperties
+	edges            []Edge[K]
+	outEdges         map[K]map[K]Edge[K] // source -> target
+	inEdges          map[K]map[K]Edge[K] // target -> source
+}
+
+// Freeze returns an immutable copy of the given graph. The copy has the same
+// hashing function and traits as the given graph, and all operations that would
+// modify it return ErrReadOnly.
+//
+// Because a frozen graph never changes, it doesn't acquire any locks and can be
+// shared across goroutines without synchronization. The vertex and edge
+// attributes are copied, so changes to the given graph don't affect the frozen
+// graph. Callers must not modify the attribute maps returned by a frozen graph.
+//
+// To obtain a mutable copy of a frozen graph, use [Thaw] or Graph.Clone.
+func Freeze[K comparable, T any](g Graph[K, T]) (Graph[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	s := &frozenStore[K, T]{
+		hashes:           make([]K, 0, len(adjacencyMap)),
+		vertices:         make(map[K]T, len(adjacencyMap)),
+		vertexProperties: make(map[K]VertexProperties, len(adjacencyMap)),
+		outEdges:         make(map[K]map[K]Edge[K], len(adjacencyMap)),
+		inEdges:          make(map[K]map[K]Edge[K], len(adjacencyMap)),
+	}
+
+	for hash := range adjacencyMap {
+		value, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		s.hashes = append(s.hashes, hash)
+		s.vertices[hash] = value
+		s.vertexProperties[hash] = newVertexPropertiesFrom(properties)
+		s.outEdges[hash] = make(map[K]Edge[K], len(adjacencyMap[hash]))
+		s.inEdges[hash] = make(map[K]Edge[K])
+	}
+
+	// For undirected graphs, the adjacency map contains each edge in both
+	// directions, which matches the way the in-memory store keeps them.
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			edge = newEdgeFrom(edge)
+
+			s.edges = append(s.edges, edge)
+			s.outEdges[source][target] = edge
+			s.inEdges[target][source] = edge
+		}
+	}
+
+	return NewWithStore[K, T](hashOf(g), s, copyTraits(g.Traits())), nil
+}
+
+// Thaw returns a mutable copy of the given graph, which is typically a graph
+// created by [Freeze]. The copy uses the default in-memory store and has the
+// same hashing function and traits as the given graph. It is equivalent to
+// calling Graph.Clone.
+func Thaw[K comparable, T any](g Graph[K, T]) (Graph[K, T], error) {
+	return g.Clone()
+}
+
+func (s *frozenStore[K, T]) AddVertex(K, T, VertexProperties) error {
+	return ErrReadOnly
+}
+
+func (s *frozenStore[K, T]) Vertex(k K) (T, VertexProperties, error) {
+	value, ok := s.vertices[k]
+	if !ok {
+		return value, VertexProperties{}, ErrVertexNotFound
+	}
+
+	return value, s.vertexProperties[k], nil
+}
+
+func (s *frozenStore[K, T]) RemoveVertex(K) error {
+	return ErrReadOnly
+}
+
+func (s *frozenStore[K, T]) ListVertices() ([]K, error) {
+	hashes := make([]K, len(s.hashes))
+	copy(hashes, s.hashes)
+
+	return hashes, nil
+}
+
+func (s *frozenStore[K, T]) VertexCount() (int, error) {
+	return len(s.hashes), nil
+}
+
+func (s *frozenStore[K, T]) AddEdge(K, K, Edge[K]) error {
+	return ErrReadOnly
+}
+
+func (s *frozenStore[K, T]) UpdateEdge(K, K, Edge[K]) error {
+	return ErrReadOnly
+}
+
+func (s *frozenStore[K, T]) RemoveEdge(K, K) error {
+	return ErrReadOnly
+}
+
+func (s *frozenStore[K, T]) Edge(sourceHash, targetHash K) (Edge[K], error) {
+	edge, ok := s.outEdges[sourceHash][targetHash]
+	if !ok {
+		return Edge[K]{}, ErrEdgeNotFound
+	}
+
+	return edge, nil
+}
+
+func (s *frozenStore[K, T]) ListEdges() ([]Edge[K], error) {
+	edges := make([]Edge[K], len(s.edges))
+	copy(edges, s.edges)
+
+	return edges, nil
+}
+
+// InEdges returns all ingoing edges of the given vertex in O(deg) time.
+func (s *frozenStore[K, T]) InEdges(k K) ([]Edge[K], error) {
+	if _, ok := s.vertices[k]; !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	return edgesOf(s.inEdges[k]), nil
+}
+
+// OutEdges returns all outgoing edges of the given vertex in O(deg) time.
+func (s *frozenStore[K, T]) OutEdges(k K) ([]Edge[K], error) {
+	if _, ok := s.vertices[k]; !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	return edgesOf(s.outEdges[k]), nil
+}
@@ -0,0 +1,170 @@
+package graph
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestFreeze(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		expectedEdges int
+	}{
+		"directed graph": {
+			traits:        []func(*Traits){Directed(), Weighted()},
+			expectedEdges: 3,
+		},
+		"undirected graph": {
+			traits:        []func(*Traits){Weighted()},
+			expectedEdges: 3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range []int{1, 2, 3} {
+				_ = g.AddVertex(vertex, VertexAttribute("name", "vertex"))
+			}
+
+			_ = g.AddEdge(1, 2, EdgeWeight(3), EdgeAttribute("color", "red"))
+			_ = g.AddEdge(2, 3, EdgeWeight(4))
+			_ = g.AddEdge(1, 3, EdgeWeight(5))
+
+			frozen, err := Freeze(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if equal, err := Equal(g, frozen); err != nil || !equal {
+				t.Fatalf("expected frozen graph to equal the original graph, got %v, %v", equal, err)
+			}
+
+			if size, _ := frozen.Size(); size != test.expectedEdges {
+				t.Errorf("expected %d edges, got %d", test.expectedEdges, size)
+			}
+
+			if frozen.Traits().IsDirected != g.Traits().IsDirected || !frozen.Traits().IsWeighted {
+				t.Errorf("expected traits %v, got %v", g.Traits(), frozen.Traits())
+			}
+
+			// Changes to the original graph must not affect the frozen graph.
+			_ = g.UpdateEdge(1, 2, EdgeAttribute("color", "blue"))
+			_ = g.AddVertex(4)
+
+			edge, err := frozen.Edge(1, 2)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if edge.Properties.Attributes["color"] != "red" {
+				t.Errorf("expected color red, got %s", edge.Properties.Attributes["color"])
+			}
+
+			if _, err := frozen.Vertex(4); !errors.Is(err, ErrVertexNotFound) {
+				t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+			}
+		})
+	}
+}
+
+func TestFreeze_mutations(t *testing.T) {
+	g := New(IntHash, Directed())
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2)
+
+	frozen, err := Freeze(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mutations := map[string]func() error{
+		"AddVertex":    func() error { return frozen.AddVertex(3) },
+		"RemoveVertex": func() error { return frozen.RemoveVertex(2) },
+		"AddEdge":      func() error { return frozen.AddEdge(2, 1) },
+		"UpdateEdge":   func() error { return frozen.UpdateEdge(1, 2, EdgeWeight(2)) },
+		"RemoveEdge":   func() error { return frozen.RemoveEdge(1, 2) },
+	}
+
+	for name, mutate := range mutations {
+		if err := mutate(); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("%s: expected error %v, got %v", name, ErrReadOnly, err)
+		}
+	}
+
+	if order, _ := frozen.Order(); order != 2 {
+		t.Errorf("expected 2 vertices, got %d", order)
+	}
+}
+
+func TestThaw(t *testing.T) {
+	g := New(IntHash, Directed(), Acyclic())
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2)
+
+	frozen, _ := Freeze(g)
+
+	thawed, err := Thaw(frozen)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := thawed.AddVertex(3); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := thawed.AddEdge(2, 3); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := frozen.Vertex(3); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected frozen graph to be unchanged, got %v", err)
+	}
+
+	if !thawed.Traits().IsAcyclic {
+		t.Errorf("expected traits %v, got %v", frozen.Traits(), thawed.Traits())
+	}
+}
+
+func TestFreeze_concurrentReads(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for vertex := 0; vertex < 100; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	for vertex := 0; vertex < 99; vertex++ {
+		_ = g.AddEdge(vertex, vertex+1)
+	}
+
+	frozen, _ := Freeze(g)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			count := 0
+			_ = BFS(frozen, 0, func(int) bool {
+				count++
+				return false
+			})
+
+			if count != 100 {
+				t.Errorf("expected 100 visited vertices, got %d", count)
+			}
+
+			if _, err := frozen.PredecessorMap(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
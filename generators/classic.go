@@ -0,0 +1,223 @@
+package generators
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dominikbraun/graph"
+)
+
+// Complete generates the complete graph K_n with n vertices, where each vertex
+// is connected to all other vertices. In a directed graph, there are edges in
+// both directions between each pair of vertices, unless the graph is acyclic,
+// in which case only the edges (i,j) with i < j are generated.
+func Complete(n int, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	g, err := newGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	forward := onlyForward(g.Traits())
+	edges := make([]graph.Edge[int], 0, maxEdges(n, forward))
+
+	for index := 0; index < maxEdges(n, forward); index++ {
+		source, target := edgeAt(index, n, forward)
+		edges = append(edges, graph.Edge[int]{Source: source, Target: target})
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// Path generates a path graph with n vertices, where vertex i is connected to
+// vertex i+1. In a directed graph, the edges point from i to i+1.
+func Path(n int, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	g, err := newGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, n)
+
+	for i := 0; i+1 < n; i++ {
+		edges = append(edges, graph.Edge[int]{Source: i, Target: i + 1})
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// Cycle generates a cycle graph with n vertices, which is a path graph with an
+// additional edge from vertex n-1 to vertex 0. n has to be at least 3.
+func Cycle(n int, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	if n < 3 {
+		return nil, fmt.Errorf("a cycle needs at least 3 vertices, got %d", n)
+	}
+
+	g, err := newGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, n)
+
+	for i := 0; i < n; i++ {
+		edges = append(edges, graph.Edge[int]{Source: i, Target: (i + 1) % n})
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// Star generates a star graph with n vertices, where vertex 0 is the center and
+// connected to all other vertices. In a directed graph, the edges point from the
+// center to the other vertices.
+func Star(n int, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	g, err := newGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, n)
+
+	for i := 1; i < n; i++ {
+		edges = append(edges, graph.Edge[int]{Source: 0, Target: i})
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// Grid generates a two-dimensional grid graph with the given number of rows and
+// columns. The vertex in row r and column c is the vertex r*columns+c, and it is
+// connected to its right and lower neighbors. In a directed graph, the edges
+// point to the right and downwards.
+func Grid(rows, columns int, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	if rows < 0 || columns < 0 {
+		return nil, fmt.Errorf("number of rows and columns must not be negative, got %d and %d", rows, columns)
+	}
+
+	g, err := newGraph(rows*columns, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, 2*rows*columns)
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < columns; c++ {
+			vertex := r*columns + c
+
+			if c+1 < columns {
+				edges = append(edges, graph.Edge[int]{Source: vertex, Target: vertex + 1})
+			}
+			if r+1 < rows {
+				edges = append(edges, graph.Edge[int]{Source: vertex, Target: vertex + columns})
+			}
+		}
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// BinaryTree generates a complete binary tree with n vertices, where vertex 0
+// is the root and the children of vertex i are the vertices 2i+1 and 2i+2. All
+// levels except the last one are full. In a directed graph, the edges point from
+// the parents to their children.
+func BinaryTree(n int, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	g, err := newGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, n)
+
+	for child := 1; child < n; child++ {
+		edges = append(edges, graph.Edge[int]{Source: (child - 1) / 2, Target: child})
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// HavelHakimi generates a simple graph that realizes the given degree sequence,
+// where vertex i has the degree degrees[i]. It returns an error if there is no
+// such graph, which can be checked upfront using graph.IsGraphical.
+//
+// HavelHakimi uses the Havel–Hakimi algorithm, which repeatedly connects the
+// vertex with the highest remaining degree to the vertices with the next highest
+// remaining degrees. Ties are broken by the vertex number, so the same sequence
+// always yields the same graph. In a directed graph, the edges point from the
+// lower to the higher vertex, so the generated graph is always acyclic and the
+// in-degree plus the out-degree of each vertex equals its degree.
+func HavelHakimi(degrees []int, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	if !graph.IsGraphical(degrees) {
+		return nil, fmt.Errorf("degree sequence %v is not graphical", degrees)
+	}
+
+	g, err := newGraph(len(degrees), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := make([]int, len(degrees))
+	copy(remaining, degrees)
+
+	vertices := make([]int, len(degrees))
+	for i := range vertices {
+		vertices[i] = i
+	}
+
+	edges := make([]graph.Edge[int], 0)
+
+	for len(vertices) > 0 {
+		sort.Slice(vertices, func(i, j int) bool {
+			if remaining[vertices[i]] != remaining[vertices[j]] {
+				return remaining[vertices[i]] > remaining[vertices[j]]
+			}
+			return vertices[i] < vertices[j]
+		})
+
+		vertex := vertices[0]
+		if remaining[vertex] == 0 {
+			break
+		}
+
+		for _, adjacency := range vertices[1 : remaining[vertex]+1] {
+			source, target := vertex, adjacency
+			if source > target {
+				source, target = target, source
+			}
+
+			edges = append(edges, graph.Edge[int]{Source: source, Target: target})
+			remaining[adjacency]--
+		}
+
+		remaining[vertex] = 0
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
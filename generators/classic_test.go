@@ -0,0 +1,168 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+func TestClassicGraphs(t *testing.T) {
+	tests := map[string]struct {
+		generate      func() (graph.Graph[int, int], error)
+		expectedOrder int
+		expectedSize  int
+		expectedEdges [][2]int
+		shouldFail    bool
+	}{
+		"complete undirected graph": {
+			generate:      func() (graph.Graph[int, int], error) { return Complete(5) },
+			expectedOrder: 5,
+			expectedSize:  10,
+			expectedEdges: [][2]int{{0, 4}, {2, 3}},
+		},
+		"complete directed graph": {
+			generate:      func() (graph.Graph[int, int], error) { return Complete(5, graph.Directed()) },
+			expectedOrder: 5,
+			expectedSize:  20,
+			expectedEdges: [][2]int{{0, 4}, {4, 0}},
+		},
+		"complete directed acyclic graph": {
+			generate:      func() (graph.Graph[int, int], error) { return Complete(5, graph.Directed(), graph.Acyclic()) },
+			expectedOrder: 5,
+			expectedSize:  10,
+			expectedEdges: [][2]int{{0, 4}, {3, 4}},
+		},
+		"empty complete graph": {
+			generate:      func() (graph.Graph[int, int], error) { return Complete(0) },
+			expectedOrder: 0,
+			expectedSize:  0,
+		},
+		"path": {
+			generate:      func() (graph.Graph[int, int], error) { return Path(4, graph.Directed()) },
+			expectedOrder: 4,
+			expectedSize:  3,
+			expectedEdges: [][2]int{{0, 1}, {1, 2}, {2, 3}},
+		},
+		"cycle": {
+			generate:      func() (graph.Graph[int, int], error) { return Cycle(4, graph.Directed()) },
+			expectedOrder: 4,
+			expectedSize:  4,
+			expectedEdges: [][2]int{{0, 1}, {3, 0}},
+		},
+		"too small cycle": {
+			generate:   func() (graph.Graph[int, int], error) { return Cycle(2) },
+			shouldFail: true,
+		},
+		"star": {
+			generate:      func() (graph.Graph[int, int], error) { return Star(6) },
+			expectedOrder: 6,
+			expectedSize:  5,
+			expectedEdges: [][2]int{{0, 1}, {0, 5}},
+		},
+		"grid": {
+			generate:      func() (graph.Graph[int, int], error) { return Grid(3, 4) },
+			expectedOrder: 12,
+			expectedSize:  17,
+			expectedEdges: [][2]int{{0, 1}, {0, 4}, {10, 11}, {7, 11}},
+		},
+		"negative grid": {
+			generate:   func() (graph.Graph[int, int], error) { return Grid(-1, 4) },
+			shouldFail: true,
+		},
+		"binary tree": {
+			generate:      func() (graph.Graph[int, int], error) { return BinaryTree(6, graph.Directed()) },
+			expectedOrder: 6,
+			expectedSize:  5,
+			expectedEdges: [][2]int{{0, 1}, {0, 2}, {1, 3}, {1, 4}, {2, 5}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := test.generate()
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if order, _ := g.Order(); order != test.expectedOrder {
+				t.Errorf("expected order %d, got %d", test.expectedOrder, order)
+			}
+
+			if size, _ := g.Size(); size != test.expectedSize {
+				t.Errorf("expected size %d, got %d", test.expectedSize, size)
+			}
+
+			for _, edge := range test.expectedEdges {
+				if _, err := g.Edge(edge[0], edge[1]); err != nil {
+					t.Errorf("expected edge (%d, %d): %v", edge[0], edge[1], err)
+				}
+			}
+		})
+	}
+}
+
+func TestHavelHakimi(t *testing.T) {
+	tests := map[string]struct {
+		degrees    []int
+		options    []func(*graph.Traits)
+		shouldFail bool
+	}{
+		"star": {
+			degrees: []int{3, 1, 1, 1},
+		},
+		"unsorted sequence": {
+			degrees: []int{1, 2, 3, 2, 2, 0},
+		},
+		"regular graph": {
+			degrees: []int{3, 3, 3, 3, 3, 3, 3, 3},
+		},
+		"directed graph": {
+			degrees: []int{2, 2, 2, 1, 1},
+			options: []func(*graph.Traits){graph.Directed(), graph.PreventCycles()},
+		},
+		"empty sequence": {
+			degrees: []int{},
+		},
+		"not graphical": {
+			degrees:    []int{3, 3, 1, 1},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := HavelHakimi(test.degrees, test.options...)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			adjacencyMap, _ := g.AdjacencyMap()
+			predecessorMap, _ := g.PredecessorMap()
+
+			for vertex, expected := range test.degrees {
+				degree := len(adjacencyMap[vertex])
+				if g.Traits().IsDirected {
+					degree += len(predecessorMap[vertex])
+				}
+
+				if _, ok := adjacencyMap[vertex][vertex]; ok {
+					t.Errorf("expected no self-loop at vertex %d", vertex)
+				}
+
+				if degree != expected {
+					t.Errorf("expected degree %d for vertex %d, got %d", expected, vertex, degree)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Package generators provides functions for generating graphs, for example for
+// benchmarking algorithms or for property-based testing. All generated graphs
+// have integer vertices from 0 to n-1, using graph.IntHash as hashing function.
+//
+// Generators that produce random graphs take a *rand.Rand as randomness source,
+// so that the same seed always yields the same graph:
+//
+//	rng := rand.New(rand.NewSource(42))
+//	g, _ := generators.GNP(1000, 0.01, rng)
+//
+// Each generator accepts functional options for the traits of the generated
+// graph, like graph.Directed().
+package generators
+
+import (
+	"fmt"
+
+	"github.com/dominikbraun/graph"
+)
+
+// newGraph creates a graph with the vertices 0 to n-1.
+func newGraph(n int, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	if n < 0 {
+		return nil, fmt.Errorf("number of vertices must not be negative, got %d", n)
+	}
+
+	g := graph.New(graph.IntHash, options...)
+
+	vertices := make([]int, n)
+	for i := range vertices {
+		vertices[i] = i
+	}
+
+	if err := g.AddVertices(vertices); err != nil {
+		return nil, fmt.Errorf("failed to add vertices: %w", err)
+	}
+
+	return g, nil
+}
+
+// addEdges adds the given edges to g.
+func addEdges(g graph.Graph[int, int], edges []graph.Edge[int]) error {
+	if err := g.AddEdges(edges); err != nil {
+		return fmt.Errorf("failed to add edges: %w", err)
+	}
+
+	return nil
+}
+
+// onlyForward reports whether only edges (i,j) with i < j may be generated for
+// g. This is the case for undirected graphs, where (j,i) is the same edge, and
+// for directed acyclic graphs, where edges that point forward can't form cycles.
+func onlyForward(traits *graph.Traits) bool {
+	return !traits.IsDirected || traits.IsAcyclic || traits.PreventCycles
+}
+
+// maxEdges returns the number of possible edges between n vertices, not taking
+// self-loops into account.
+func maxEdges(n int, forward bool) int {
+	if forward {
+		return n * (n - 1) / 2
+	}
+	return n * (n - 1)
+}
@@ -0,0 +1,308 @@
+package generators
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/dominikbraun/graph"
+)
+
+// GNP generates an Erdős–Rényi random graph G(n, p) with n vertices, where each
+// possible edge exists with probability p independently of all other edges.
+// Self-loops are never generated. For directed graphs, the edges (i,j) and (j,i)
+// are considered separately, unless the graph is acyclic, in which case only
+// edges (i,j) with i < j are generated.
+//
+// GNP runs in O(n + m) time for m generated edges by skipping the edges that
+// don't exist using geometrically distributed jumps, so sparse graphs with many
+// vertices can be generated quickly.
+func GNP(n int, p float64, rng *rand.Rand, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	if p < 0 || p > 1 {
+		return nil, fmt.Errorf("probability must be in [0, 1], got %v", p)
+	}
+
+	g, err := newGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == 0 || n < 2 {
+		return g, nil
+	}
+
+	forward := onlyForward(g.Traits())
+	edges := make([]graph.Edge[int], 0, int(p*float64(maxEdges(n, forward))))
+
+	// The possible edges are enumerated as a sequence of indices. Instead of
+	// rolling the dice for each index, the gap to the next existing edge is
+	// drawn from a geometric distribution (Batagelj and Brandes, 2005).
+	total := maxEdges(n, forward)
+	logQ := math.Log(1 - p)
+
+	for index := -1; ; {
+		// For p = 1, logQ is -Inf and the skip is always 0.
+		skip := math.Floor(math.Log(1-rng.Float64()) / logQ)
+		if float64(index)+1+skip >= float64(total) {
+			break
+		}
+
+		index += 1 + int(skip)
+
+		source, target := edgeAt(index, n, forward)
+		edges = append(edges, graph.Edge[int]{Source: source, Target: target})
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// GNM generates an Erdős–Rényi random graph G(n, m) with n vertices and exactly
+// m edges, chosen uniformly at random among all possible edges. The same rules
+// for self-loops and directed graphs as for [GNP] apply. If m exceeds the number
+// of possible edges, an error is returned.
+func GNM(n, m int, rng *rand.Rand, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	if m < 0 {
+		return nil, fmt.Errorf("number of edges must not be negative, got %d", m)
+	}
+
+	g, err := newGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	forward := onlyForward(g.Traits())
+	total := maxEdges(n, forward)
+
+	if m > total {
+		return nil, fmt.Errorf("a graph with %d vertices has at most %d edges, got %d", n, total, m)
+	}
+
+	// Robert Floyd's algorithm samples m distinct indices out of all possible
+	// edge indices using m random numbers, regardless of the graph's density.
+	chosen := make(map[int]struct{}, m)
+	edges := make([]graph.Edge[int], 0, m)
+
+	for j := total - m; j < total; j++ {
+		index := rng.Intn(j + 1)
+		if _, ok := chosen[index]; ok {
+			index = j
+		}
+		chosen[index] = struct{}{}
+
+		source, target := edgeAt(index, n, forward)
+		edges = append(edges, graph.Edge[int]{Source: source, Target: target})
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// edgeAt maps an index in [0, maxEdges(n, forward)) to an edge. If forward is
+// true, the edges (i,j) with i < j are enumerated row by row. Otherwise, all
+// edges (i,j) with i != j are enumerated row by row.
+func edgeAt(index, n int, forward bool) (int, int) {
+	if !forward {
+		source := index / (n - 1)
+		target := index % (n - 1)
+		if target >= source {
+			target++
+		}
+		return source, target
+	}
+
+	// Row i contains the n-1-i edges (i,i+1) to (i,n-1). Find the row using the
+	// closed form of the number of edges in the preceding rows, then correct
+	// possible floating point errors.
+	source := int(float64(2*n-1)/2 - math.Sqrt(float64((2*n-1)*(2*n-1))/4-2*float64(index)))
+	if source < 0 {
+		source = 0
+	}
+
+	rowStart := func(i int) int {
+		return i * (2*n - i - 1) / 2
+	}
+
+	for source > 0 && rowStart(source) > index {
+		source--
+	}
+	for source+1 < n && rowStart(source+1) <= index {
+		source++
+	}
+
+	return source, source + 1 + index - rowStart(source)
+}
+
+// BarabasiAlbert generates a scale-free random graph with n vertices using the
+// Barabási–Albert preferential attachment model. The graph starts as a star of
+// m+1 vertices. Each further vertex is connected to m distinct existing vertices,
+// where a vertex is chosen with a probability proportional to its degree. This
+// yields a power-law degree distribution with a few highly connected hubs.
+//
+// m has to be at least 1 and smaller than n. In a directed graph, the edges
+// point from the new vertex to the existing vertices, so the generated graph is
+// always acyclic.
+func BarabasiAlbert(n, m int, rng *rand.Rand, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	if m < 1 || m >= n {
+		return nil, fmt.Errorf("number of edges per vertex must be in [1, %d), got %d", n, m)
+	}
+
+	g, err := newGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, m+(n-m-1)*m)
+
+	// Each vertex appears in this slice once per incident edge, so choosing a
+	// uniformly random element of it means choosing a vertex with a probability
+	// proportional to its degree.
+	endpoints := make([]int, 0, 2*cap(edges))
+
+	for target := 1; target <= m; target++ {
+		edges = append(edges, graph.Edge[int]{Source: 0, Target: target})
+		endpoints = append(endpoints, 0, target)
+	}
+
+	targets := make([]int, 0, m)
+	chosen := make(map[int]struct{}, m)
+
+	for source := m + 1; source < n; source++ {
+		targets = targets[:0]
+		for target := range chosen {
+			delete(chosen, target)
+		}
+
+		for len(targets) < m {
+			target := endpoints[rng.Intn(len(endpoints))]
+			if _, ok := chosen[target]; ok {
+				continue
+			}
+			chosen[target] = struct{}{}
+			targets = append(targets, target)
+		}
+
+		for _, target := range targets {
+			edges = append(edges, graph.Edge[int]{Source: source, Target: target})
+			endpoints = append(endpoints, source, target)
+		}
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// WattsStrogatz generates a small-world random graph with n vertices using the
+// Watts–Strogatz model. The graph starts as a ring lattice where each vertex is
+// connected to its k nearest neighbors, k/2 on each side. Then, each edge (i,j)
+// is rewired with probability p by replacing j with a uniformly chosen vertex,
+// avoiding self-loops and duplicate edges. Small values of p yield graphs with
+// a high clustering coefficient and short average path lengths.
+//
+// k has to be even and smaller than n. In a directed graph, the lattice edges
+// point from each vertex to its k/2 successors on the ring and to its k/2
+// predecessors. Because the ring lattice contains cycles, WattsStrogatz returns
+// an error for graphs that prevent cycles.
+func WattsStrogatz(n, k int, p float64, rng *rand.Rand, options ...func(*graph.Traits)) (graph.Graph[int, int], error) {
+	if k < 0 || k%2 != 0 || k >= n {
+		return nil, fmt.Errorf("number of neighbors must be even and in [0, %d), got %d", n, k)
+	}
+
+	if p < 0 || p > 1 {
+		return nil, fmt.Errorf("probability must be in [0, 1], got %v", p)
+	}
+
+	g, err := newGraph(n, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	isDirected := g.Traits().IsDirected
+
+	key := func(source, target int) [2]int {
+		if !isDirected && source > target {
+			source, target = target, source
+		}
+		return [2]int{source, target}
+	}
+
+	lattice := make([][2]int, 0, n*k)
+
+	for source := 0; source < n; source++ {
+		for offset := 1; offset <= k/2; offset++ {
+			lattice = append(lattice, [2]int{source, (source + offset) % n})
+			if isDirected {
+				lattice = append(lattice, [2]int{source, (source - offset + n) % n})
+			}
+		}
+	}
+
+	existing := make(map[[2]int]struct{}, len(lattice))
+
+	// degrees contains the number of edges starting at each vertex, which in
+	// an undirected graph are all edges of the vertex.
+	degrees := make([]int, n)
+
+	for _, edge := range lattice {
+		existing[key(edge[0], edge[1])] = struct{}{}
+		degrees[edge[0]]++
+		if !isDirected {
+			degrees[edge[1]]++
+		}
+	}
+
+	for i, edge := range lattice {
+		if rng.Float64() >= p {
+			continue
+		}
+
+		source, oldTarget := edge[0], edge[1]
+
+		// If the source vertex is already connected to all other vertices, the
+		// edge can't be rewired.
+		if degrees[source] >= n-1 {
+			continue
+		}
+
+		var target int
+		for {
+			target = rng.Intn(n)
+			if target == source {
+				continue
+			}
+			if _, ok := existing[key(source, target)]; !ok {
+				break
+			}
+		}
+
+		delete(existing, key(source, oldTarget))
+		existing[key(source, target)] = struct{}{}
+
+		if !isDirected {
+			degrees[oldTarget]--
+			degrees[target]++
+		}
+
+		lattice[i] = [2]int{source, target}
+	}
+
+	edges := make([]graph.Edge[int], len(lattice))
+	for i, edge := range lattice {
+		edges[i] = graph.Edge[int]{Source: edge[0], Target: edge[1]}
+	}
+
+	if err := addEdges(g, edges); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
@@ -0,0 +1,366 @@
+package generators
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+func TestGNP(t *testing.T) {
+	tests := map[string]struct {
+		n           int
+		p           float64
+		options     []func(*graph.Traits)
+		minSize     int
+		maxSize     int
+		shouldFail  bool
+		forwardOnly bool
+	}{
+		"empty probability": {
+			n:       50,
+			p:       0,
+			minSize: 0,
+			maxSize: 0,
+		},
+		"complete undirected graph": {
+			n:       20,
+			p:       1,
+			minSize: 190,
+			maxSize: 190,
+		},
+		"complete directed graph": {
+			n:       20,
+			p:       1,
+			options: []func(*graph.Traits){graph.Directed()},
+			minSize: 380,
+			maxSize: 380,
+		},
+		"sparse undirected graph": {
+			n: 1000,
+			p: 0.01,
+			// The expected size is 4995, so this range is very generous.
+			minSize: 4500,
+			maxSize: 5500,
+		},
+		"directed acyclic graph": {
+			n:           100,
+			p:           0.5,
+			options:     []func(*graph.Traits){graph.Directed(), graph.Acyclic()},
+			minSize:     2200,
+			maxSize:     2750,
+			forwardOnly: true,
+		},
+		"invalid probability": {
+			n:          10,
+			p:          1.5,
+			shouldFail: true,
+		},
+		"negative number of vertices": {
+			n:          -1,
+			p:          0.5,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := GNP(test.n, test.p, rand.New(rand.NewSource(1)), test.options...)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			assertRandomGraph(t, g, test.n, test.forwardOnly)
+
+			size, _ := g.Size()
+			if size < test.minSize || size > test.maxSize {
+				t.Errorf("expected size in [%d, %d], got %d", test.minSize, test.maxSize, size)
+			}
+		})
+	}
+}
+
+func TestGNM(t *testing.T) {
+	tests := map[string]struct {
+		n           int
+		m           int
+		options     []func(*graph.Traits)
+		shouldFail  bool
+		forwardOnly bool
+	}{
+		"no edges": {
+			n: 10,
+			m: 0,
+		},
+		"sparse undirected graph": {
+			n: 500,
+			m: 1000,
+		},
+		"complete undirected graph": {
+			n: 30,
+			m: 435,
+		},
+		"dense directed graph": {
+			n:       30,
+			m:       800,
+			options: []func(*graph.Traits){graph.Directed()},
+		},
+		"directed acyclic graph": {
+			n:           30,
+			m:           400,
+			options:     []func(*graph.Traits){graph.Directed(), graph.PreventCycles()},
+			forwardOnly: true,
+		},
+		"too many edges": {
+			n:          10,
+			m:          46,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := GNM(test.n, test.m, rand.New(rand.NewSource(1)), test.options...)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			assertRandomGraph(t, g, test.n, test.forwardOnly)
+
+			size, _ := g.Size()
+			if size != test.m {
+				t.Errorf("expected size %d, got %d", test.m, size)
+			}
+		})
+	}
+}
+
+func TestGNM_Seed(t *testing.T) {
+	a, _ := GNM(100, 300, rand.New(rand.NewSource(7)))
+	b, _ := GNM(100, 300, rand.New(rand.NewSource(7)))
+
+	edgesA, _ := a.Edges()
+
+	for _, edge := range edgesA {
+		if _, err := b.Edge(edge.Source, edge.Target); err != nil {
+			t.Fatalf("expected edge (%d, %d) in both graphs: %v", edge.Source, edge.Target, err)
+		}
+	}
+}
+
+func TestEdgeAt(t *testing.T) {
+	for _, forward := range []bool{true, false} {
+		for _, n := range []int{2, 3, 10, 57} {
+			seen := make(map[[2]int]struct{})
+
+			for index := 0; index < maxEdges(n, forward); index++ {
+				source, target := edgeAt(index, n, forward)
+
+				if source == target || source < 0 || target < 0 || source >= n || target >= n {
+					t.Fatalf("n=%d, index %d: invalid edge (%d, %d)", n, index, source, target)
+				}
+				if forward && source > target {
+					t.Fatalf("n=%d, index %d: edge (%d, %d) doesn't point forward", n, index, source, target)
+				}
+
+				seen[[2]int{source, target}] = struct{}{}
+			}
+
+			if len(seen) != maxEdges(n, forward) {
+				t.Errorf("n=%d, forward=%v: expected %d distinct edges, got %d", n, forward, maxEdges(n, forward), len(seen))
+			}
+		}
+	}
+}
+
+func assertRandomGraph(t *testing.T, g graph.Graph[int, int], n int, forwardOnly bool) {
+	order, _ := g.Order()
+	if order != n {
+		t.Errorf("expected order %d, got %d", n, order)
+	}
+
+	edges, _ := g.Edges()
+
+	for _, edge := range edges {
+		if edge.Source == edge.Target {
+			t.Errorf("unexpected self-loop at %d", edge.Source)
+		}
+		if forwardOnly && edge.Source > edge.Target {
+			t.Errorf("expected edge (%d, %d) to point forward", edge.Source, edge.Target)
+		}
+	}
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	tests := map[string]struct {
+		n          int
+		m          int
+		options    []func(*graph.Traits)
+		shouldFail bool
+	}{
+		"undirected graph": {
+			n: 2000,
+			m: 3,
+		},
+		"directed graph": {
+			n:       500,
+			m:       2,
+			options: []func(*graph.Traits){graph.Directed(), graph.Acyclic()},
+		},
+		"minimal graph": {
+			n: 2,
+			m: 1,
+		},
+		"too many edges per vertex": {
+			n:          5,
+			m:          5,
+			shouldFail: true,
+		},
+		"no edges per vertex": {
+			n:          5,
+			m:          0,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := BarabasiAlbert(test.n, test.m, rand.New(rand.NewSource(1)), test.options...)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			assertRandomGraph(t, g, test.n, false)
+
+			expectedSize := test.m + (test.n-test.m-1)*test.m
+			if size, _ := g.Size(); size != expectedSize {
+				t.Errorf("expected size %d, got %d", expectedSize, size)
+			}
+
+			if g.Traits().IsDirected {
+				if _, err := graph.TopologicalSort(g); err != nil {
+					t.Errorf("expected an acyclic graph, got error %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestBarabasiAlbert_DegreeDistribution(t *testing.T) {
+	g, _ := BarabasiAlbert(5000, 2, rand.New(rand.NewSource(3)))
+
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	maxDegree := 0
+	for _, adjacencies := range adjacencyMap {
+		if len(adjacencies) > maxDegree {
+			maxDegree = len(adjacencies)
+		}
+	}
+
+	// The average degree is about 4. In a power-law graph of this size, the
+	// largest hub has a degree that is far above average, whereas it would be
+	// around 15 in an Erdős–Rényi graph with the same average degree.
+	if maxDegree < 50 {
+		t.Errorf("expected a hub with degree of at least 50, got maximum degree %d", maxDegree)
+	}
+}
+
+func TestWattsStrogatz(t *testing.T) {
+	tests := map[string]struct {
+		n          int
+		k          int
+		p          float64
+		options    []func(*graph.Traits)
+		shouldFail bool
+	}{
+		"ring lattice": {
+			n: 20,
+			k: 4,
+			p: 0,
+		},
+		"small-world graph": {
+			n: 1000,
+			k: 6,
+			p: 0.1,
+		},
+		"random graph": {
+			n: 100,
+			k: 4,
+			p: 1,
+		},
+		"dense graph": {
+			n: 7,
+			k: 6,
+			p: 1,
+		},
+		"directed graph": {
+			n:       200,
+			k:       4,
+			p:       0.2,
+			options: []func(*graph.Traits){graph.Directed()},
+		},
+		"odd number of neighbors": {
+			n:          10,
+			k:          3,
+			shouldFail: true,
+		},
+		"too many neighbors": {
+			n:          4,
+			k:          4,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := WattsStrogatz(test.n, test.k, test.p, rand.New(rand.NewSource(1)), test.options...)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			assertRandomGraph(t, g, test.n, false)
+
+			expectedSize := test.n * test.k / 2
+			if g.Traits().IsDirected {
+				expectedSize *= 2
+			}
+
+			if size, _ := g.Size(); size != expectedSize {
+				t.Errorf("expected size %d, got %d", expectedSize, size)
+			}
+		})
+	}
+}
+
+func TestWattsStrogatz_RingLattice(t *testing.T) {
+	g, _ := WattsStrogatz(10, 4, 0, rand.New(rand.NewSource(1)))
+
+	for i := 0; i < 10; i++ {
+		for _, offset := range []int{1, 2} {
+			if _, err := g.Edge(i, (i+offset)%10); err != nil {
+				t.Errorf("expected edge (%d, %d): %v", i, (i+offset)%10, err)
+			}
+		}
+	}
+}
@@ -25,6 +25,10 @@
 //
 //	g := graph.New(cityHash)
 //
+// Values of comparable types can serve as their own hash values using
+// [Identity], and vertices identified by several fields can be hashed using
+// [FieldHash].
+//
 // # Operations
 //
 // Adding vertices to a graph of integers is simple. [graph.Graph.AddVertex]
@@ -49,7 +53,13 @@
 // For detailed usage examples, take a look at the README.
 package graph
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 var (
 	ErrVertexNotFound      = errors.New("vertex not found")
@@ -60,11 +70,68 @@ var (
 	ErrVertexHasEdges      = errors.New("vertex has edges")
 )
 
+// MultiError is an error that consists of multiple errors. It is returned by
+// operations that don't stop at the first error but collect all of them, such
+// as [Builder.Build].
+//
+// errors.Is reports whether any of the contained errors matches the target:
+//
+//	_, err := builder.Build()
+//	if errors.Is(err, graph.ErrVertexNotFound) {
+//		// At least one edge has a non-existent vertex.
+//	}
+type MultiError struct {
+	Errors []error
+}
+
+func (m *MultiError) Error() string {
+	if len(m.Errors) == 1 {
+		return m.Errors[0].Error()
+	}
+
+	messages := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		messages[i] = err.Error()
+	}
+
+	return fmt.Sprintf("%d errors occurred: %s", len(m.Errors), strings.Join(messages, "; "))
+}
+
+// Is reports whether any of the contained errors matches the target error.
+func (m *MultiError) Is(target error) bool {
+	for _, err := range m.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns the contained errors.
+func (m *MultiError) Unwrap() []error {
+	return m.Errors
+}
+
+// multiError returns a *MultiError for the given errors, or nil if there are no
+// errors at all.
+func multiError(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: errs}
+}
+
 // Graph represents a generic graph data structure consisting of vertices of
 // type T identified by a hash of type K.
 type Graph[K comparable, T any] interface {
 	// Traits returns the graph's traits. Those traits must be set when creating
-	// a graph using New.
+	// a graph using New. They can be read to branch on the kind of graph:
+	//
+	//	if g.Traits().IsDirected {
+	//		// Handle edges as ordered pairs.
+	//	}
+	//
+	// The returned traits must not be modified.
 	Traits() *Traits
 
 	// AddVertex creates a new vertex in the graph. If the vertex already exists
@@ -77,6 +144,28 @@ type Graph[K comparable, T any] interface {
 	//
 	AddVertex(value T, options ...func(*VertexProperties)) error
 
+	// AddVertexWithHash creates a new vertex with the given hash value, which
+	// saves computing the hash for vertices whose hash is already known or is
+	// expensive to compute, for example when loading vertices from a database
+	// that also stores their hashes:
+	//
+	//	_ = g.AddVertexWithHash(row.ID, row.City)
+	//
+	// The hash has to be the hash of the vertex as computed by the hashing
+	// function of the graph, which is not verified. Apart from that, it works
+	// like AddVertex. Edges and queries use the hashes of the vertices anyway,
+	// so the hash doesn't have to be computed again later.
+	AddVertexWithHash(hash K, value T, options ...func(*VertexProperties)) error
+
+	// AddVertices adds all given vertices to the graph in a single call, which
+	// is considerably faster than calling AddVertex for each vertex when adding
+	// large amounts of vertices. The given functional options are applied to
+	// each vertex.
+	//
+	// If one of the vertices already exists in the graph or occurs multiple
+	// times, ErrVertexAlreadyExists will be returned and no vertex is added.
+	AddVertices(values []T, options ...func(*VertexProperties)) error
+
 	// AddVerticesFrom adds all vertices along with their properties from the
 	// given graph to the receiving graph.
 	//
@@ -84,6 +173,26 @@ type Graph[K comparable, T any] interface {
 	// already exists, ErrVertexAlreadyExists will be returned.
 	AddVerticesFrom(g Graph[K, T]) error
 
+	// UpsertVertex adds the given vertex to the graph, or replaces the value
+	// and properties of the vertex with the same hash if it already exists.
+	// Unlike AddVertex, which returns ErrVertexAlreadyExists so that accidental
+	// hash collisions don't go unnoticed, UpsertVertex is meant for updating a
+	// vertex intentionally:
+	//
+	//	_ = g.UpsertVertex(City{Name: "London", Population: 8_982_000})
+	//
+	// The edges of a replaced vertex are kept. The functional options are the
+	// same as for AddVertex, and the replaced vertex only has the properties
+	// set by them. Hooks registered using OnVertexAdded are only invoked if the
+	// vertex has been added.
+	//
+	// The stores of this package support replacing any vertex. A custom Store
+	// has to provide an UpdateVertex(hash K, value T, properties
+	// VertexProperties) error method for that. Otherwise, only vertices without
+	// edges can be replaced, and UpsertVertex returns an error that wraps
+	// ErrVertexHasEdges for a vertex with edges, leaving the vertex unchanged.
+	UpsertVertex(value T, options ...func(*VertexProperties)) error
+
 	// Vertex returns the vertex with the given hash or ErrVertexNotFound if it
 	// doesn't exist.
 	Vertex(hash K) (T, error)
@@ -113,6 +222,25 @@ type Graph[K comparable, T any] interface {
 	//
 	AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error
 
+	// AddEdges creates all given edges in a single call, which is considerably
+	// faster than calling AddEdge for each edge when adding large amounts of
+	// edges. Each edge is defined by its source and target vertex hashes and
+	// its properties:
+	//
+	//	_ = g.AddEdges([]graph.Edge[string]{
+	//		{Source: "A", Target: "B"},
+	//		{Source: "B", Target: "C", Properties: graph.EdgeProperties{Weight: 4}},
+	//	})
+	//
+	// All edges are checked before any of them is added: If either vertex of an
+	// edge cannot be found, ErrVertexNotFound will be returned. If an edge
+	// already exists or occurs multiple times, ErrEdgeAlreadyExists will be
+	// returned. In both cases, the graph remains unchanged.
+	//
+	// If cycle prevention has been activated using PreventCycles, the edges are
+	// added one by one instead, stopping at the first edge that can't be added.
+	AddEdges(edges []Edge[K]) error
+
 	// AddEdgesFrom adds all edges along with their properties from the given
 	// graph to the receiving graph.
 	//
@@ -147,6 +275,27 @@ type Graph[K comparable, T any] interface {
 	// overwrite the existing attributes using the EdgeAttributes option.
 	UpdateEdge(source, target K, options ...func(properties *EdgeProperties)) error
 
+	// UpdateWeights sets the weights of multiple edges at once. The given map
+	// contains the new weight for each edge, identified by its EdgeKey.
+	//
+	// All edges are looked up before any weight is changed: If one of them
+	// doesn't exist, ErrEdgeNotFound will be returned and the graph remains
+	// unchanged. In an undirected graph, an EdgeKey with swapped source and
+	// target vertices does match.
+	//
+	// With the stores of this package, the weights are updated atomically, so
+	// that concurrent readers either see all or none of the new weights. Other
+	// stores can provide the same guarantee by implementing an UpdateEdges
+	// method. Otherwise, the edges are updated one by one, and if an update
+	// fails, the edges updated so far are restored before returning the error.
+	//
+	//	_ = g.UpdateWeights(map[graph.EdgeKey[string]]int{
+	//		{Source: "A", Target: "B"}: 4,
+	//		{Source: "B", Target: "C"}: 2,
+	//	})
+	//
+	UpdateWeights(weights map[EdgeKey[K]]int) error
+
 	// RemoveEdge removes the edge between the given source and target vertices.
 	// If the edge cannot be found, ErrEdgeNotFound will be returned.
 	RemoveEdge(source, target K) error
@@ -219,6 +368,13 @@ type Edge[T any] struct {
 	Properties EdgeProperties
 }
 
+// EdgeKey identifies an edge by the hash values of its source and target
+// vertices. Unlike Edge, it is comparable and can be used as a map key.
+type EdgeKey[K comparable] struct {
+	Source K
+	Target K
+}
+
 // EdgeProperties represents a set of properties that each edge possesses. They
 // can be set when adding a new edge using the corresponding functional options:
 //
@@ -226,10 +382,16 @@ type Edge[T any] struct {
 //
 // The example above will create an edge with a weight of 2 and an attribute
 // "color" with value "red".
+//
+// Validity defines the time interval in which the edge exists, which is used by
+// [Snapshot] and [Window]. It is nil for edges without a validity, which are
+// always valid. Since few graphs are temporal, the interval is stored behind a
+// pointer, which keeps the size of the edges of other graphs small.
 type EdgeProperties struct {
 	Attributes map[string]string
 	Weight     int
 	Data       any
+	Validity   *Validity
 }
 
 // Hash is a hashing function that takes a vertex of type T and returns a hash
@@ -245,6 +407,11 @@ type EdgeProperties struct {
 //
 // The cityHash function returns the city name as a hash value. The types of T
 // and K, in this case City and string, also define the types of the graph.
+//
+// The zero value of K, such as 0 or "", is a valid hash value like any other.
+// Functions of this library never use it to indicate a missing vertex: Vertex
+// and all functions that take vertex hashes return an error that wraps
+// [ErrVertexNotFound] if a vertex doesn't exist.
 type Hash[K comparable, T any] func(T) K
 
 // New creates a new graph with vertices of type T, identified by hash values of
@@ -256,6 +423,43 @@ func New[K comparable, T any](hash Hash[K, T], options ...func(*Traits)) Graph[K
 	return NewWithStore(hash, newMemoryStore[K, T](), options...)
 }
 
+// NewWithCapacity creates a new graph same as [New] but pre-sizes the default
+// in-memory store for the expected number of vertices and edges. Loading a
+// large graph into a graph created using New spends much of its time on
+// growing the internal maps, which NewWithCapacity avoids:
+//
+//	g := graph.NewWithCapacity(graph.IntHash, 1_000_000, 10_000_000, graph.Directed())
+//
+// The numbers are only hints. The graph may hold more vertices and edges, and
+// it is fine to add fewer. The edges of each vertex are pre-sized for the
+// average degree, so that vertices with a typical number of edges don't have
+// to grow their edge maps either.
+func NewWithCapacity[K comparable, T any](hash Hash[K, T], vertices, edges int, options ...func(*Traits)) Graph[K, T] {
+	var p Traits
+
+	for _, option := range options {
+		option(&p)
+	}
+
+	// An undirected edge is stored in both directions.
+	if !p.IsDirected {
+		edges *= 2
+	}
+
+	return NewWithStore(hash, newMemoryStoreWithCapacity[K, T](vertices, edges), options...)
+}
+
+// NewComparable creates a new graph same as [New] but uses the vertices
+// themselves as their hash values, which saves writing a hashing function for
+// vertices of a comparable type:
+//
+//	g := graph.NewComparable[City](graph.Directed())
+//
+// This is equivalent to calling New with the [Identity] hashing function.
+func NewComparable[T comparable](options ...func(*Traits)) Graph[T, T] {
+	return New(Identity[T](), options...)
+}
+
 // NewWithStore creates a new graph same as [New] but uses the provided store
 // instead of the default memory store.
 func NewWithStore[K comparable, T any](hash Hash[K, T], store Store[K, T], options ...func(*Traits)) Graph[K, T] {
@@ -281,23 +485,76 @@ func NewWithStore[K comparable, T any](hash Hash[K, T], store Store[K, T], optio
 //
 // In the example above, h is a new directed graph of integers derived from g.
 func NewLike[K comparable, T any](g Graph[K, T]) Graph[K, T] {
-	copyTraits := func(t *Traits) {
-		t.IsDirected = g.Traits().IsDirected
-		t.IsAcyclic = g.Traits().IsAcyclic
-		t.IsWeighted = g.Traits().IsWeighted
-		t.IsRooted = g.Traits().IsRooted
-		t.PreventCycles = g.Traits().PreventCycles
-	}
+	return New(hashOf(g), copyTraits(g.Traits()))
+}
 
-	var hash Hash[K, T]
+// wrapper is implemented by the graph types of this package that wrap another
+// graph, like History. Internal functions that require the graph implementation
+// use unwrap to get to the wrapped graph.
+type wrapper[K comparable, T any] interface {
+	unwrap() Graph[K, T]
+}
+
+// hashOf returns the hashing function of the given graph.
+func hashOf[K comparable, T any](g Graph[K, T]) Hash[K, T] {
+	g = unwrap(g)
 
 	if g.Traits().IsDirected {
-		hash = g.(*directed[K, T]).hash
-	} else {
-		hash = g.(*undirected[K, T]).hash
+		return g.(*directed[K, T]).hash
+	}
+
+	return g.(*undirected[K, T]).hash
+}
+
+// storeOf returns the store of the given graph, or nil if the graph is not one
+// of the graph implementations of this package.
+func storeOf[K comparable, T any](g Graph[K, T]) Store[K, T] {
+	switch g := unwrap(g).(type) {
+	case *directed[K, T]:
+		return readStore(g.store, g.traits)
+	case *undirected[K, T]:
+		return readStore(g.store, g.traits)
+	}
+
+	return nil
+}
+
+// rawStoreOf returns the store of the given graph like storeOf, but without
+// the weights computed by a function registered using WeightFunc.
+func rawStoreOf[K comparable, T any](g Graph[K, T]) Store[K, T] {
+	switch g := unwrap(g).(type) {
+	case *directed[K, T]:
+		return g.store
+	case *undirected[K, T]:
+		return g.store
+	}
+
+	return nil
+}
+
+// unwrap returns the innermost graph wrapped by the given graph, or the graph
+// itself if it doesn't wrap another graph.
+func unwrap[K comparable, T any](g Graph[K, T]) Graph[K, T] {
+	for {
+		w, ok := g.(wrapper[K, T])
+		if !ok {
+			return g
+		}
+		g = w.unwrap()
 	}
+}
 
-	return New(hash, copyTraits)
+// copyTraits returns a functional option that sets the traits of a new graph
+// to the given traits.
+func copyTraits(traits *Traits) func(*Traits) {
+	return func(t *Traits) {
+		t.IsDirected = traits.IsDirected
+		t.IsAcyclic = traits.IsAcyclic
+		t.IsWeighted = traits.IsWeighted
+		t.IsRooted = traits.IsRooted
+		t.PreventCycles = traits.PreventCycles
+		t.keepFirstEdge = traits.keepFirstEdge
+	}
 }
 
 // StringHash is a hashing function that accepts a string and uses that exact
@@ -312,6 +569,57 @@ func IntHash(v int) int {
 	return v
 }
 
+// UintHash is a hashing function that accepts an unsigned integer and uses that
+// exact integer as a hash value. Using it as Hash will yield a Graph[uint, uint].
+func UintHash(v uint) uint {
+	return v
+}
+
+// Int64Hash is a hashing function that accepts a 64-bit integer and uses that
+// exact integer as a hash value. Using it as Hash will yield a
+// Graph[int64, int64].
+func Int64Hash(v int64) int64 {
+	return v
+}
+
+// Identity returns a hashing function that uses the vertex itself as its hash
+// value. It works for any comparable vertex type, for example a struct type
+// with comparable fields:
+//
+//	g := graph.New(graph.Identity[City]())
+func Identity[K comparable]() Hash[K, K] {
+	return func(v K) K {
+		return v
+	}
+}
+
+// FieldHash returns a hashing function that combines the values returned by
+// the given field functions into a single string hash value. This is useful for
+// vertex types that are identified by several fields, none of which is unique
+// on its own:
+//
+//	hash := graph.FieldHash(
+//		func(c City) any { return c.Name },
+//		func(c City) any { return c.Country },
+//	)
+//
+// Each value is formatted using its default format. Two vertices yield the same
+// hash value if and only if all of their formatted values are equal.
+func FieldHash[T any](fields ...func(T) any) Hash[string, T] {
+	return func(v T) string {
+		var builder strings.Builder
+
+		for i, field := range fields {
+			if i > 0 {
+				builder.WriteByte(',')
+			}
+			builder.WriteString(strconv.Quote(fmt.Sprint(field(v))))
+		}
+
+		return builder.String()
+	}
+}
+
 // EdgeWeight returns a function that sets the weight of an edge to the given
 // weight. This is a functional option for the [graph.Graph.Edge] and
 // [graph.Graph.AddEdge] methods.
@@ -348,6 +656,24 @@ func EdgeData(data any) func(*EdgeProperties) {
 	}
 }
 
+// EdgeValidity returns a function that sets the time interval in which an edge
+// is valid, including both from and until. A zero time leaves the interval
+// unbounded on that side. This is a functional option for the
+// [graph.Graph.AddEdge] and [graph.Graph.UpdateEdge] methods.
+func EdgeValidity(from, until time.Time) func(*EdgeProperties) {
+	return func(e *EdgeProperties) {
+		e.Validity = &Validity{From: from, Until: until}
+	}
+}
+
+// EdgeTimestamp returns a function that marks an edge as an event that only
+// exists at the given point in time, such as a message or a transaction. This
+// is the same as an EdgeValidity interval that starts and ends at the given
+// time.
+func EdgeTimestamp(at time.Time) func(*EdgeProperties) {
+	return EdgeValidity(at, at)
+}
+
 // VertexProperties represents a set of properties that each vertex has. They
 // can be set when adding a vertex using the corresponding functional options:
 //
@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -33,6 +35,92 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewComparable(t *testing.T) {
+	type city struct {
+		name    string
+		country string
+	}
+
+	g := NewComparable[city](Directed())
+
+	berlin := city{name: "Berlin", country: "Germany"}
+	paris := city{name: "Paris", country: "France"}
+
+	_ = g.AddVertex(berlin)
+	_ = g.AddVertex(paris)
+
+	if err := g.AddEdge(berlin, paris); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := g.AddVertex(city{name: "Berlin", country: "Germany"}); !errors.Is(err, ErrVertexAlreadyExists) {
+		t.Errorf("expected error %v, got %v", ErrVertexAlreadyExists, err)
+	}
+
+	if !g.Traits().IsDirected {
+		t.Errorf("expected directed graph")
+	}
+}
+
+func TestNewWithCapacity(t *testing.T) {
+	tests := map[string]struct {
+		options        []func(*Traits)
+		vertices       int
+		edges          int
+		expectedDegree int
+	}{
+		"directed": {
+			options:        []func(*Traits){Directed()},
+			vertices:       10,
+			edges:          25,
+			expectedDegree: 3,
+		},
+		"undirected": {
+			options:        []func(*Traits){},
+			vertices:       10,
+			edges:          25,
+			expectedDegree: 5,
+		},
+		"no capacity": {
+			options:        []func(*Traits){Directed()},
+			vertices:       0,
+			edges:          0,
+			expectedDegree: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithCapacity(IntHash, test.vertices, test.edges, test.options...)
+
+			store := storeOf(g).(*memoryStore[int, int])
+
+			if store.capacity != test.vertices {
+				t.Errorf("expected capacity %d, got %d", test.vertices, store.capacity)
+			}
+
+			if store.degree != test.expectedDegree {
+				t.Errorf("expected degree %d, got %d", test.expectedDegree, store.degree)
+			}
+
+			// The graph may hold more vertices and edges than expected.
+			for i := 0; i <= test.vertices; i++ {
+				_ = g.AddVertex(i)
+			}
+
+			for i := 0; i < test.vertices; i++ {
+				if err := g.AddEdge(i, i+1); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if order, _ := g.Order(); order != test.vertices+1 {
+				t.Errorf("expected order %d, got %d", test.vertices+1, order)
+			}
+		})
+	}
+}
+
 func TestNewLike(t *testing.T) {
 	tests := map[string]struct {
 		g        Graph[int, int]
@@ -133,6 +221,81 @@ func TestIntHash(t *testing.T) {
 	}
 }
 
+func TestUintHash(t *testing.T) {
+	if hash := UintHash(3); hash != 3 {
+		t.Errorf("hash expectancy doesn't match: expected %v, got %v", 3, hash)
+	}
+}
+
+func TestInt64Hash(t *testing.T) {
+	if hash := Int64Hash(-3); hash != -3 {
+		t.Errorf("hash expectancy doesn't match: expected %v, got %v", -3, hash)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	type city struct {
+		name    string
+		country string
+	}
+
+	g := New(Identity[city]())
+
+	berlin := city{name: "Berlin", country: "Germany"}
+	_ = g.AddVertex(berlin)
+
+	if _, err := g.Vertex(city{name: "Berlin", country: "Germany"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if hash := Identity[string]()("London"); hash != "London" {
+		t.Errorf("hash expectancy doesn't match: expected %v, got %v", "London", hash)
+	}
+}
+
+func TestFieldHash(t *testing.T) {
+	type city struct {
+		name       string
+		country    string
+		population int
+	}
+
+	hash := FieldHash(
+		func(c city) any { return c.name },
+		func(c city) any { return c.country },
+	)
+
+	tests := map[string]struct {
+		first         city
+		second        city
+		expectedEqual bool
+	}{
+		"equal fields": {
+			first:         city{name: "Paris", country: "France", population: 2},
+			second:        city{name: "Paris", country: "France", population: 3},
+			expectedEqual: true,
+		},
+		"different fields": {
+			first:         city{name: "Paris", country: "France"},
+			second:        city{name: "Paris", country: "United States"},
+			expectedEqual: false,
+		},
+		"separator in field": {
+			first:         city{name: "a\",\"b", country: "c"},
+			second:        city{name: "a", country: "b\",\"c"},
+			expectedEqual: false,
+		},
+	}
+
+	for name, test := range tests {
+		equal := hash(test.first) == hash(test.second)
+
+		if equal != test.expectedEqual {
+			t.Errorf("%s: expected hashes %q and %q to be equal: %v", name, hash(test.first), hash(test.second), test.expectedEqual)
+		}
+	}
+}
+
 func TestEdgeWeight(t *testing.T) {
 	tests := map[string]struct {
 		expected EdgeProperties
@@ -296,3 +459,59 @@ func TestVertexAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiError(t *testing.T) {
+	tests := map[string]struct {
+		errs            []error
+		expectedMessage string
+		expectedNil     bool
+		matches         []error
+		doesNotMatch    []error
+	}{
+		"no errors": {
+			errs:        []error{},
+			expectedNil: true,
+		},
+		"single error": {
+			errs:            []error{ErrVertexNotFound},
+			expectedMessage: "vertex not found",
+			matches:         []error{ErrVertexNotFound},
+			doesNotMatch:    []error{ErrEdgeNotFound},
+		},
+		"multiple errors": {
+			errs:            []error{ErrVertexNotFound, fmt.Errorf("edge: %w", ErrEdgeAlreadyExists)},
+			expectedMessage: "2 errors occurred: vertex not found; edge: edge already exists",
+			matches:         []error{ErrVertexNotFound, ErrEdgeAlreadyExists},
+			doesNotMatch:    []error{ErrEdgeNotFound},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := multiError(test.errs)
+
+			if test.expectedNil {
+				if err != nil {
+					t.Fatalf("expected nil error, got %v", err)
+				}
+				return
+			}
+
+			if err.Error() != test.expectedMessage {
+				t.Errorf("expected message %q, got %q", test.expectedMessage, err.Error())
+			}
+
+			for _, target := range test.matches {
+				if !errors.Is(err, target) {
+					t.Errorf("expected error to match %v", target)
+				}
+			}
+
+			for _, target := range test.doesNotMatch {
+				if errors.Is(err, target) {
+					t.Errorf("expected error not to match %v", target)
+				}
+			}
+		})
+	}
+}
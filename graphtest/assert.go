@@ -0,0 +1,190 @@
+package graphtest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+	"github.com/dominikbraun/graph/generators"
+)
+
+// AssertHasVertex reports a test failure if g doesn't contain a vertex with the
+// given hash. It returns whether the assertion holds.
+func AssertHasVertex[K comparable, T any](t testing.TB, g graph.Graph[K, T], hash K) bool {
+	t.Helper()
+
+	if _, err := g.Vertex(hash); err != nil {
+		t.Errorf("expected vertex %v: %v", hash, err)
+		return false
+	}
+
+	return true
+}
+
+// AssertHasEdge reports a test failure if g doesn't contain an edge between the
+// given source and target vertices. It returns whether the assertion holds.
+func AssertHasEdge[K comparable, T any](t testing.TB, g graph.Graph[K, T], source, target K) bool {
+	t.Helper()
+
+	if _, err := g.Edge(source, target); err != nil {
+		t.Errorf("expected edge (%v, %v): %v", source, target, err)
+		return false
+	}
+
+	return true
+}
+
+// AssertNotHasEdge reports a test failure if g contains an edge between the
+// given source and target vertices. It returns whether the assertion holds.
+func AssertNotHasEdge[K comparable, T any](t testing.TB, g graph.Graph[K, T], source, target K) bool {
+	t.Helper()
+
+	if _, err := g.Edge(source, target); err == nil {
+		t.Errorf("expected no edge (%v, %v)", source, target)
+		return false
+	}
+
+	return true
+}
+
+// AssertEqual reports a test failure if the graphs expected and actual aren't
+// structurally equal as defined by [graph.Equal]. It returns whether the
+// assertion holds.
+func AssertEqual[K comparable, T any](t testing.TB, expected, actual graph.Graph[K, T]) bool {
+	t.Helper()
+
+	equal, err := graph.Equal(expected, actual)
+	if err != nil {
+		t.Errorf("failed to compare graphs: %v", err)
+		return false
+	}
+
+	if !equal {
+		expectedEdges, _ := expected.Edges()
+		actualEdges, _ := actual.Edges()
+		t.Errorf("expected graph with edges %v, got %v", expectedEdges, actualEdges)
+		return false
+	}
+
+	return true
+}
+
+// AssertTopologicalOrder reports a test failure if the given order isn't a
+// topological order of g: It has to contain each vertex of g exactly once, and
+// for each edge (A,B), A has to come before B. It returns whether the assertion
+// holds.
+func AssertTopologicalOrder[K comparable, T any](t testing.TB, g graph.Graph[K, T], order []K) bool {
+	t.Helper()
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		t.Errorf("failed to get adjacency map: %v", err)
+		return false
+	}
+
+	if len(order) != len(adjacencyMap) {
+		t.Errorf("expected order of %d vertices, got %d vertices", len(adjacencyMap), len(order))
+		return false
+	}
+
+	positions := make(map[K]int, len(order))
+
+	for i, vertex := range order {
+		if _, ok := adjacencyMap[vertex]; !ok {
+			t.Errorf("order contains unknown vertex %v", vertex)
+			return false
+		}
+		if _, ok := positions[vertex]; ok {
+			t.Errorf("order contains vertex %v more than once", vertex)
+			return false
+		}
+		positions[vertex] = i
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			if positions[source] >= positions[target] {
+				t.Errorf("expected %v to come before %v in order %v", source, target, order)
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// AssertAcyclic reports a test failure if g contains a cycle. For undirected
+// graphs, this means that g has to be a forest. Self-loops count as cycles. It
+// returns whether the assertion holds.
+func AssertAcyclic[K comparable, T any](t testing.TB, g graph.Graph[K, T]) bool {
+	t.Helper()
+
+	if g.Traits().IsDirected {
+		if _, err := graph.TopologicalSort(g); err != nil {
+			t.Errorf("expected an acyclic graph: %v", err)
+			return false
+		}
+		return true
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		t.Errorf("failed to get adjacency map: %v", err)
+		return false
+	}
+
+	size, err := g.Size()
+	if err != nil {
+		t.Errorf("failed to get size: %v", err)
+		return false
+	}
+
+	// An undirected graph is a forest if and only if the number of its edges
+	// equals the number of vertices minus the number of components.
+	components := 0
+	visited := make(map[K]struct{}, len(adjacencyMap))
+
+	for vertex := range adjacencyMap {
+		if _, ok := visited[vertex]; ok {
+			continue
+		}
+
+		components++
+
+		_ = graph.DFS(g, vertex, func(hash K) bool {
+			visited[hash] = struct{}{}
+			return false
+		})
+	}
+
+	if size != len(adjacencyMap)-components {
+		t.Errorf("expected an acyclic graph, got %d edges for %d vertices in %d components", size, len(adjacencyMap), components)
+		return false
+	}
+
+	return true
+}
+
+// RandomGraph returns an Erdős–Rényi random graph with n vertices, where each
+// edge exists with probability p, generated using the given seed. It fails the
+// test if the graph can't be generated. This is useful as a fixture for
+// property-based tests of functions that accept arbitrary graphs.
+func RandomGraph(t testing.TB, n int, p float64, seed int64, options ...func(*graph.Traits)) graph.Graph[int, int] {
+	t.Helper()
+
+	g, err := generators.GNP(n, p, rand.New(rand.NewSource(seed)), options...)
+	if err != nil {
+		t.Fatalf("failed to generate random graph: %v", err)
+	}
+
+	return g
+}
+
+// RandomDAG returns a random directed acyclic graph with n vertices, where each
+// edge (i,j) with i < j exists with probability p, generated using the given
+// seed. It fails the test if the graph can't be generated.
+func RandomDAG(t testing.TB, n int, p float64, seed int64) graph.Graph[int, int] {
+	t.Helper()
+
+	return RandomGraph(t, n, p, seed, graph.Directed(), graph.Acyclic())
+}
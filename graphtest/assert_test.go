@@ -0,0 +1,123 @@
+package graphtest
+
+import (
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+func TestAssertions(t *testing.T) {
+	dag := graph.New(graph.IntHash, graph.Directed())
+	for i := 1; i <= 3; i++ {
+		_ = dag.AddVertex(i)
+	}
+	_ = dag.AddEdge(1, 2)
+	_ = dag.AddEdge(2, 3)
+
+	cyclic := graph.New(graph.IntHash)
+	for i := 1; i <= 3; i++ {
+		_ = cyclic.AddVertex(i)
+	}
+	_ = cyclic.AddEdge(1, 2)
+	_ = cyclic.AddEdge(2, 3)
+	_ = cyclic.AddEdge(3, 1)
+
+	forest := graph.New(graph.IntHash)
+	for i := 1; i <= 4; i++ {
+		_ = forest.AddVertex(i)
+	}
+	_ = forest.AddEdge(1, 2)
+	_ = forest.AddEdge(3, 4)
+
+	tests := map[string]struct {
+		assert   func(t testing.TB) bool
+		expected bool
+	}{
+		"has vertex": {
+			assert:   func(t testing.TB) bool { return AssertHasVertex(t, dag, 1) },
+			expected: true,
+		},
+		"has no vertex": {
+			assert:   func(t testing.TB) bool { return AssertHasVertex(t, dag, 4) },
+			expected: false,
+		},
+		"has edge": {
+			assert:   func(t testing.TB) bool { return AssertHasEdge(t, dag, 1, 2) },
+			expected: true,
+		},
+		"has no edge": {
+			assert:   func(t testing.TB) bool { return AssertHasEdge(t, dag, 2, 1) },
+			expected: false,
+		},
+		"not has edge": {
+			assert:   func(t testing.TB) bool { return AssertNotHasEdge(t, dag, 1, 3) },
+			expected: true,
+		},
+		"equal graphs": {
+			assert:   func(t testing.TB) bool { return AssertEqual(t, cyclic, cyclic) },
+			expected: true,
+		},
+		"different graphs": {
+			assert:   func(t testing.TB) bool { return AssertEqual(t, cyclic, forest) },
+			expected: false,
+		},
+		"valid topological order": {
+			assert:   func(t testing.TB) bool { return AssertTopologicalOrder(t, dag, []int{1, 2, 3}) },
+			expected: true,
+		},
+		"invalid topological order": {
+			assert:   func(t testing.TB) bool { return AssertTopologicalOrder(t, dag, []int{2, 1, 3}) },
+			expected: false,
+		},
+		"incomplete topological order": {
+			assert:   func(t testing.TB) bool { return AssertTopologicalOrder(t, dag, []int{1, 2}) },
+			expected: false,
+		},
+		"duplicate vertex in topological order": {
+			assert:   func(t testing.TB) bool { return AssertTopologicalOrder(t, dag, []int{1, 2, 2}) },
+			expected: false,
+		},
+		"acyclic directed graph": {
+			assert:   func(t testing.TB) bool { return AssertAcyclic(t, dag) },
+			expected: true,
+		},
+		"cyclic undirected graph": {
+			assert:   func(t testing.TB) bool { return AssertAcyclic(t, cyclic) },
+			expected: false,
+		},
+		"forest": {
+			assert:   func(t testing.TB) bool { return AssertAcyclic(t, forest) },
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{TB: t}
+
+			if holds := test.assert(r); holds != test.expected {
+				t.Errorf("expected assertion to return %v, got %v", test.expected, holds)
+			}
+
+			if failed := r.failures > 0; failed == test.expected {
+				t.Errorf("expected failure to be reported: %v, got %v", !test.expected, failed)
+			}
+		})
+	}
+}
+
+func TestRandomDAG(t *testing.T) {
+	g := RandomDAG(t, 50, 0.2, 3)
+
+	AssertAcyclic(t, g)
+
+	order, err := graph.TopologicalSort(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	AssertTopologicalOrder(t, g, order)
+
+	// The same seed yields the same graph.
+	AssertEqual(t, g, RandomDAG(t, 50, 0.2, 3))
+}
@@ -0,0 +1,225 @@
+// Package graphtest provides utilities for testing code that uses graphs, such
+// as custom Store implementations, wrappers around graph.Graph, or functions
+// that produce graphs.
+//
+// The assertion functions like AssertHasEdge or AssertTopologicalOrder report
+// test failures with a descriptive message, and the fixtures like RandomGraph
+// provide reproducible random graphs:
+//
+//	g := graphtest.RandomDAG(t, 100, 0.1, 42)
+//	order, _ := graph.TopologicalSort(g)
+//
+//	graphtest.AssertTopologicalOrder(t, g, order)
+//
+// Race drives randomized concurrent mutations and reads against a graph. It is
+// meant to be run using the race detector:
+//
+//	func TestMyStore_Race(t *testing.T) {
+//		g := graph.NewWithStore(graph.IntHash, newMyStore(), graph.Directed())
+//		graphtest.Race(t, g, graphtest.Seed(42))
+//	}
+//
+//	$ go test -race ./...
+package graphtest
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+type race struct {
+	seed       int64
+	goroutines int
+	operations int
+	vertices   int
+	validate   bool
+}
+
+// Seed sets the seed for the operations performed by Race. Each goroutine uses
+// its own randomness source derived from this seed, so the same seed always
+// yields the same sequence of operations per goroutine. The default seed is 1.
+func Seed(seed int64) func(*race) {
+	return func(r *race) {
+		r.seed = seed
+	}
+}
+
+// Goroutines sets the number of goroutines that concurrently operate on the
+// graph. The default is 8.
+func Goroutines(n int) func(*race) {
+	return func(r *race) {
+		r.goroutines = n
+	}
+}
+
+// Operations sets the number of operations that each goroutine performs. The
+// default is 1000.
+func Operations(n int) func(*race) {
+	return func(r *race) {
+		r.operations = n
+	}
+}
+
+// Vertices sets the number of distinct vertices that the operations work with.
+// A small number leads to more conflicting operations. The default is 32.
+func Vertices(n int) func(*race) {
+	return func(r *race) {
+		r.vertices = n
+	}
+}
+
+// SkipValidation disables the validation of the graph after all operations have
+// been performed. See [Race] for details.
+func SkipValidation() func(*race) {
+	return func(r *race) {
+		r.validate = false
+	}
+}
+
+// Race performs randomized operations on the given graph from multiple
+// goroutines at the same time. The operations include adding and removing
+// vertices and edges, updating edges, and reading vertices, edges, and the
+// adjacency and predecessor maps.
+//
+// The operations performed by each goroutine are determined by the seed, but
+// the interleaving of the goroutines is up to the Go scheduler. Running Race
+// with the race detector enabled reveals data races in the graph or its store.
+//
+// Race reports a test failure if an operation panics or returns an error other
+// than the errors expected for conflicting operations, like
+// graph.ErrVertexAlreadyExists or graph.ErrEdgeNotFound. After all operations
+// have completed, Race checks the structural invariants of the graph using
+// graph.Validate. All failures include the seed so that they can be reproduced.
+//
+// The graph should be empty, and its vertices must be of type int and use
+// graph.IntHash or an equivalent hashing function.
+func Race(t testing.TB, g graph.Graph[int, int], options ...func(*race)) {
+	t.Helper()
+
+	r := race{
+		seed:       1,
+		goroutines: 8,
+		operations: 1000,
+		vertices:   32,
+		validate:   true,
+	}
+
+	for _, option := range options {
+		option(&r)
+	}
+
+	var wg sync.WaitGroup
+
+	// All goroutines wait for the start signal, so that they run at the same
+	// time as much as possible.
+	start := make(chan struct{})
+
+	errs := make([][]error, r.goroutines)
+
+	for i := 0; i < r.goroutines; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			rng := rand.New(rand.NewSource(r.seed + int64(i)))
+
+			<-start
+
+			for op := 0; op < r.operations; op++ {
+				if err := r.perform(g, rng); err != nil {
+					errs[i] = append(errs[i], fmt.Errorf("goroutine %d, operation %d: %w", i, op, err))
+				}
+			}
+		}(i)
+	}
+
+	close(start)
+	wg.Wait()
+
+	for _, goroutineErrs := range errs {
+		for _, err := range goroutineErrs {
+			t.Errorf("seed %d: %v", r.seed, err)
+		}
+	}
+
+	if !r.validate {
+		return
+	}
+
+	if err := graph.Validate(g); err != nil {
+		t.Errorf("seed %d: graph is invalid after concurrent operations: %v", r.seed, err)
+	}
+}
+
+// perform runs a single random operation and returns an error if it fails in an
+// unexpected way.
+func (r *race) perform(g graph.Graph[int, int], rng *rand.Rand) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("panic: %v", recovered)
+		}
+	}()
+
+	a, b := rng.Intn(r.vertices), rng.Intn(r.vertices)
+
+	var name string
+
+	switch op := rng.Intn(10); op {
+	case 0:
+		name, err = "AddVertex", g.AddVertex(a)
+	case 1:
+		name, err = "RemoveVertex", g.RemoveVertex(a)
+	case 2:
+		name, err = "AddEdge", g.AddEdge(a, b, graph.EdgeWeight(rng.Intn(100)))
+	case 3:
+		name, err = "RemoveEdge", g.RemoveEdge(a, b)
+	case 4:
+		name, err = "UpdateEdge", g.UpdateEdge(a, b, graph.EdgeWeight(rng.Intn(100)))
+	case 5:
+		name = "Vertex"
+		_, err = g.Vertex(a)
+	case 6:
+		name = "Edge"
+		_, err = g.Edge(a, b)
+	case 7:
+		name = "AdjacencyMap"
+		_, err = g.AdjacencyMap()
+	case 8:
+		name = "PredecessorMap"
+		_, err = g.PredecessorMap()
+	default:
+		name = "Edges"
+		_, err = g.Edges()
+	}
+
+	if err != nil && !isExpected(err) {
+		return fmt.Errorf("%s(%d, %d): %w", name, a, b, err)
+	}
+
+	return nil
+}
+
+// isExpected reports whether err is an error that may legitimately occur when
+// operations conflict with each other.
+func isExpected(err error) bool {
+	for _, expected := range []error{
+		graph.ErrVertexNotFound,
+		graph.ErrVertexAlreadyExists,
+		graph.ErrEdgeNotFound,
+		graph.ErrEdgeAlreadyExists,
+		graph.ErrEdgeCreatesCycle,
+		graph.ErrVertexHasEdges,
+	} {
+		if errors.Is(err, expected) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,64 @@
+package graphtest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+func TestRace(t *testing.T) {
+	tests := map[string]struct {
+		options []func(*graph.Traits)
+	}{
+		"directed graph": {
+			options: []func(*graph.Traits){graph.Directed()},
+		},
+		"undirected graph": {
+			options: []func(*graph.Traits){},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := graph.New(graph.IntHash, test.options...)
+			Race(t, g, Seed(7), Goroutines(4), Operations(500), Vertices(16))
+		})
+	}
+}
+
+type recorder struct {
+	testing.TB
+	failures int
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(string, ...interface{}) {
+	r.failures++
+}
+
+// faultyGraph returns an unexpected error for AddVertex and panics in Edges.
+type faultyGraph struct {
+	graph.Graph[int, int]
+}
+
+func (f faultyGraph) AddVertex(int, ...func(*graph.VertexProperties)) error {
+	return errors.New("disk is full")
+}
+
+func (f faultyGraph) Edges() ([]graph.Edge[int], error) {
+	panic("not implemented")
+}
+
+func TestRace_ReportsFailures(t *testing.T) {
+	r := &recorder{TB: t}
+
+	g := faultyGraph{Graph: graph.New(graph.IntHash)}
+
+	Race(r, g, Goroutines(2), Operations(100), SkipValidation())
+
+	if r.failures == 0 {
+		t.Errorf("expected failures to be reported")
+	}
+}
@@ -0,0 +1,615 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// History is a graph that records all mutations, so that they can be undone and
+// redone. This is useful for interactive applications like graph editors.
+//
+// A History wraps an existing graph and implements the Graph interface itself,
+// so it can be used wherever a graph is expected. Each call to a mutating
+// method like AddVertex, AddEdges, or UpdateEdge that changes the graph creates
+// a new version, where methods that change several vertices or edges at once
+// create a single version:
+//
+//	g := graph.NewHistory(graph.New(graph.IntHash))
+//
+//	_ = g.AddVertex(1) // version 1
+//	_ = g.AddVertex(2) // version 2
+//	_ = g.AddEdge(1, 2) // version 3
+//
+//	_ = g.Undo() // removes the edge, back to version 2
+//	_ = g.Redo() // adds the edge again
+//
+//	_ = g.Checkout(0) // back to the initial, empty graph
+//
+// Making a change after undoing discards all versions that could have been
+// redone. Changes made directly on the wrapped graph are not recorded and may
+// break the history. Clone returns a clone of the current version without its
+// history. Like the graph implementations of this package, a History is not
+// safe for concurrent mutations.
+type History[K comparable, T any] struct {
+	Graph[K, T]
+	hash     Hash[K, T]
+	versions [][]change[K, T]
+	current  int
+}
+
+type changeKind int
+
+const (
+	vertexAddition changeKind = iota
+	vertexRemoval
+	vertexUpdate
+	edgeAddition
+	edgeRemoval
+	edgeUpdate
+)
+
+// change is a single recorded mutation. For vertex changes, hash, value, and
+// vertexProperties are set, and for vertex updates, previousValue and
+// previousVertexProperties contain the vertex before the update. For edge
+// changes, edge is set, and for edge updates, previous contains the edge before
+// the update.
+type change[K comparable, T any] struct {
+	kind                     changeKind
+	hash                     K
+	value                    T
+	vertexProperties         VertexProperties
+	previousValue            T
+	previousVertexProperties VertexProperties
+	edge                     Edge[K]
+	previous                 Edge[K]
+}
+
+// NewHistory creates a History for the given graph. The current state of the
+// graph is version 0, which doesn't have any changes to undo.
+func NewHistory[K comparable, T any](g Graph[K, T]) *History[K, T] {
+	return &History[K, T]{
+		Graph: g,
+		hash:  hashOf(g),
+	}
+}
+
+func (h *History[K, T]) unwrap() Graph[K, T] {
+	return h.Graph
+}
+
+// Version returns the number of the current version. Each recorded change
+// increments the version, and Undo decrements it.
+func (h *History[K, T]) Version() int {
+	return h.current
+}
+
+// Versions returns the number of the latest version, which can be reached
+// using Redo or Checkout.
+func (h *History[K, T]) Versions() int {
+	return len(h.versions)
+}
+
+// CanUndo reports whether there is a version to go back to.
+func (h *History[K, T]) CanUndo() bool {
+	return h.current > 0
+}
+
+// CanRedo reports whether a change has been undone that can be redone.
+func (h *History[K, T]) CanRedo() bool {
+	return h.current < len(h.versions)
+}
+
+// Undo reverts the changes of the current version, going back to the previous
+// version. If there is nothing to undo, an error is returned.
+func (h *History[K, T]) Undo() error {
+	if !h.CanUndo() {
+		return errors.New("nothing to undo")
+	}
+
+	changes := h.versions[h.current-1]
+
+	for i := len(changes) - 1; i >= 0; i-- {
+		if err := h.revert(changes[i]); err != nil {
+			return fmt.Errorf("failed to undo version %d: %w", h.current, err)
+		}
+	}
+
+	h.current--
+
+	return nil
+}
+
+// Redo applies the changes of the next version again after they have been
+// undone. If there is nothing to redo, an error is returned.
+func (h *History[K, T]) Redo() error {
+	if !h.CanRedo() {
+		return errors.New("nothing to redo")
+	}
+
+	for _, c := range h.versions[h.current] {
+		if err := h.apply(c); err != nil {
+			return fmt.Errorf("failed to redo version %d: %w", h.current+1, err)
+		}
+	}
+
+	h.current++
+
+	return nil
+}
+
+// Checkout undoes or redoes changes until the given version is reached, where
+// version 0 is the state of the graph when the History has been created.
+func (h *History[K, T]) Checkout(version int) error {
+	if version < 0 || version > len(h.versions) {
+		return fmt.Errorf("version %d doesn't exist", version)
+	}
+
+	for h.current > version {
+		if err := h.Undo(); err != nil {
+			return err
+		}
+	}
+
+	for h.current < version {
+		if err := h.Redo(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVertex adds a vertex and records the change. See [graph.Graph.AddVertex].
+func (h *History[K, T]) AddVertex(value T, options ...func(*VertexProperties)) error {
+	return h.addVertices([]T{value}, func() error {
+		return h.Graph.AddVertex(value, options...)
+	})
+}
+
+// AddVertexWithHash adds a vertex with the given hash and records the change.
+// See [graph.Graph.AddVertexWithHash].
+func (h *History[K, T]) AddVertexWithHash(hash K, value T, options ...func(*VertexProperties)) error {
+	if err := h.Graph.AddVertexWithHash(hash, value, options...); err != nil {
+		return err
+	}
+
+	added, properties, err := h.Graph.VertexWithProperties(hash)
+	if err != nil {
+		return fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+	}
+
+	h.record([]change[K, T]{{
+		kind:             vertexAddition,
+		hash:             hash,
+		value:            added,
+		vertexProperties: newVertexPropertiesFrom(properties),
+	}})
+
+	return nil
+}
+
+// AddVertices adds the given vertices and records the changes as a single
+// version. See [graph.Graph.AddVertices].
+func (h *History[K, T]) AddVertices(values []T, options ...func(*VertexProperties)) error {
+	return h.addVertices(values, func() error {
+		return h.Graph.AddVertices(values, options...)
+	})
+}
+
+// AddVerticesFrom adds the vertices of the given graph and records the changes
+// as a single version. See [graph.Graph.AddVerticesFrom].
+func (h *History[K, T]) AddVerticesFrom(g Graph[K, T]) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	values := make([]T, 0, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		value, err := g.Vertex(hash)
+		if err != nil {
+			return fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+		values = append(values, value)
+	}
+
+	return h.addVertices(values, func() error {
+		return h.Graph.AddVerticesFrom(g)
+	})
+}
+
+// UpsertVertex adds or replaces a vertex and records the change. See
+// [graph.Graph.UpsertVertex].
+func (h *History[K, T]) UpsertVertex(value T, options ...func(*VertexProperties)) error {
+	hash := h.hash(value)
+
+	previousValue, previousProperties, err := h.Graph.VertexWithProperties(hash)
+	if err != nil {
+		return h.addVertices([]T{value}, func() error {
+			return h.Graph.UpsertVertex(value, options...)
+		})
+	}
+
+	// The properties have to be copied before the update, because the store
+	// might share the attributes map with the replaced vertex.
+	previousProperties = newVertexPropertiesFrom(previousProperties)
+
+	if err := h.Graph.UpsertVertex(value, options...); err != nil {
+		return err
+	}
+
+	updated, properties, err := h.Graph.VertexWithProperties(hash)
+	if err != nil {
+		return fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+	}
+
+	h.record([]change[K, T]{{
+		kind:                     vertexUpdate,
+		hash:                     hash,
+		value:                    updated,
+		vertexProperties:         newVertexPropertiesFrom(properties),
+		previousValue:            previousValue,
+		previousVertexProperties: previousProperties,
+	}})
+
+	return nil
+}
+
+// RemoveVertex removes a vertex and records the change. See
+// [graph.Graph.RemoveVertex].
+func (h *History[K, T]) RemoveVertex(hash K) error {
+	value, properties, err := h.Graph.VertexWithProperties(hash)
+	if err != nil {
+		return fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+	}
+
+	if err := h.Graph.RemoveVertex(hash); err != nil {
+		return err
+	}
+
+	h.record([]change[K, T]{{
+		kind:             vertexRemoval,
+		hash:             hash,
+		value:            value,
+		vertexProperties: newVertexPropertiesFrom(properties),
+	}})
+
+	return nil
+}
+
+// AddEdge adds an edge and records the change. See [graph.Graph.AddEdge].
+func (h *History[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
+	return h.addEdges([]EdgeKey[K]{{Source: sourceHash, Target: targetHash}}, func() error {
+		return h.Graph.AddEdge(sourceHash, targetHash, options...)
+	})
+}
+
+// AddEdges adds the given edges and records the changes as a single version.
+// See [graph.Graph.AddEdges].
+func (h *History[K, T]) AddEdges(edges []Edge[K]) error {
+	keys := make([]EdgeKey[K], len(edges))
+	for i, edge := range edges {
+		keys[i] = EdgeKey[K]{Source: edge.Source, Target: edge.Target}
+	}
+
+	return h.addEdges(keys, func() error {
+		return h.Graph.AddEdges(edges)
+	})
+}
+
+// AddEdgesFrom adds the edges of the given graph and records the changes as a
+// single version. See [graph.Graph.AddEdgesFrom].
+func (h *History[K, T]) AddEdgesFrom(g Graph[K, T]) error {
+	edges, err := g.Edges()
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	keys := make([]EdgeKey[K], len(edges))
+	for i, edge := range edges {
+		keys[i] = EdgeKey[K]{Source: edge.Source, Target: edge.Target}
+	}
+
+	return h.addEdges(keys, func() error {
+		return h.Graph.AddEdgesFrom(g)
+	})
+}
+
+// UpdateEdge updates the properties of an edge and records the change. See
+// [graph.Graph.UpdateEdge].
+func (h *History[K, T]) UpdateEdge(source, target K, options ...func(properties *EdgeProperties)) error {
+	return h.updateEdges([]EdgeKey[K]{{Source: source, Target: target}}, func() error {
+		return h.Graph.UpdateEdge(source, target, options...)
+	})
+}
+
+// UpdateWeights updates the weights of the given edges and records the changes
+// as a single version. See [graph.Graph.UpdateWeights].
+func (h *History[K, T]) UpdateWeights(weights map[EdgeKey[K]]int) error {
+	keys := make([]EdgeKey[K], 0, len(weights))
+	for key := range weights {
+		keys = append(keys, key)
+	}
+
+	return h.updateEdges(keys, func() error {
+		return h.Graph.UpdateWeights(weights)
+	})
+}
+
+// RemoveEdge removes an edge and records the change. See
+// [graph.Graph.RemoveEdge].
+func (h *History[K, T]) RemoveEdge(source, target K) error {
+	edge, err := h.edge(source, target)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Graph.RemoveEdge(source, target); err != nil {
+		return err
+	}
+
+	h.record([]change[K, T]{{kind: edgeRemoval, edge: edge}})
+
+	return nil
+}
+
+// addVertices runs the given mutation and records the vertices among values
+// that didn't exist before and exist afterwards. The changes are also recorded
+// if the mutation fails, since it might have added some of the vertices.
+func (h *History[K, T]) addVertices(values []T, mutate func() error) error {
+	existing := make(map[K]bool, len(values))
+
+	for _, value := range values {
+		hash := h.hash(value)
+		if _, err := h.Graph.Vertex(hash); err == nil {
+			existing[hash] = true
+		}
+	}
+
+	mutationErr := mutate()
+
+	var changes []change[K, T]
+
+	for _, value := range values {
+		hash := h.hash(value)
+		if existing[hash] {
+			continue
+		}
+
+		added, properties, err := h.Graph.VertexWithProperties(hash)
+		if err != nil {
+			continue
+		}
+
+		existing[hash] = true
+		changes = append(changes, change[K, T]{
+			kind:             vertexAddition,
+			hash:             hash,
+			value:            added,
+			vertexProperties: newVertexPropertiesFrom(properties),
+		})
+	}
+
+	h.record(changes)
+
+	return mutationErr
+}
+
+// addEdges works like addVertices, but for edges. An edge that already existed
+// might have been merged with the added edge using KeepFirstEdge or MergeEdges,
+// which is recorded as an update if its properties have changed. Vertices that
+// have been created using AutoVertices are recorded before the edges.
+func (h *History[K, T]) addEdges(keys []EdgeKey[K], mutate func() error) error {
+	existing := make(map[EdgeKey[K]]Edge[K], len(keys))
+	missing := make(map[K]bool)
+
+	for _, key := range keys {
+		if edge, err := h.edge(key.Source, key.Target); err == nil {
+			existing[key] = edge
+		}
+
+		for _, hash := range []K{key.Source, key.Target} {
+			if _, err := h.Graph.Vertex(hash); err != nil {
+				missing[hash] = true
+			}
+		}
+	}
+
+	mutationErr := mutate()
+
+	var changes []change[K, T]
+
+	for _, key := range keys {
+		for _, hash := range []K{key.Source, key.Target} {
+			if !missing[hash] {
+				continue
+			}
+
+			added, properties, err := h.Graph.VertexWithProperties(hash)
+			if err != nil {
+				continue
+			}
+
+			missing[hash] = false
+			changes = append(changes, change[K, T]{
+				kind:             vertexAddition,
+				hash:             hash,
+				value:            added,
+				vertexProperties: newVertexPropertiesFrom(properties),
+			})
+		}
+	}
+
+	// recorded contains the edges whose changes have been recorded. In an
+	// undirected graph, an edge might have been recorded already in the
+	// opposite direction.
+	recorded := make(map[EdgeKey[K]]bool, len(keys))
+
+	for _, key := range keys {
+		reversed := EdgeKey[K]{Source: key.Target, Target: key.Source}
+		if recorded[key] || (!h.Traits().IsDirected && recorded[reversed]) {
+			continue
+		}
+
+		edge, err := h.edge(key.Source, key.Target)
+		if err != nil {
+			continue
+		}
+
+		recorded[key] = true
+
+		previous, ok := existing[key]
+		if !ok && !h.Traits().IsDirected {
+			previous, ok = existing[reversed]
+		}
+
+		switch {
+		case !ok:
+			changes = append(changes, change[K, T]{kind: edgeAddition, edge: edge})
+		case !edgePropertiesEqual(previous.Properties, edge.Properties):
+			changes = append(changes, change[K, T]{kind: edgeUpdate, edge: edge, previous: previous})
+		}
+	}
+
+	h.record(changes)
+
+	return mutationErr
+}
+
+// updateEdges runs the given mutation and records the previous and the new
+// properties of the edges identified by keys.
+func (h *History[K, T]) updateEdges(keys []EdgeKey[K], mutate func() error) error {
+	previous := make([]Edge[K], len(keys))
+
+	for i, key := range keys {
+		edge, err := h.edge(key.Source, key.Target)
+		if err != nil {
+			return err
+		}
+		previous[i] = edge
+	}
+
+	if err := mutate(); err != nil {
+		return err
+	}
+
+	changes := make([]change[K, T], len(keys))
+
+	for i, key := range keys {
+		edge, err := h.edge(key.Source, key.Target)
+		if err != nil {
+			return err
+		}
+		changes[i] = change[K, T]{kind: edgeUpdate, edge: edge, previous: previous[i]}
+	}
+
+	h.record(changes)
+
+	return nil
+}
+
+// record adds a new version with the given changes, discarding all versions
+// that could have been redone. No version is created without any changes.
+func (h *History[K, T]) record(changes []change[K, T]) {
+	if len(changes) == 0 {
+		return
+	}
+
+	h.versions = append(h.versions[:h.current], changes)
+	h.current++
+}
+
+// apply performs the given change on the wrapped graph.
+func (h *History[K, T]) apply(c change[K, T]) error {
+	switch c.kind {
+	case vertexAddition:
+		return h.Graph.AddVertex(c.value, copyVertexProperties(c.vertexProperties))
+	case vertexRemoval:
+		return h.Graph.RemoveVertex(c.hash)
+	case vertexUpdate:
+		return h.Graph.UpsertVertex(c.value, copyVertexProperties(c.vertexProperties))
+	case edgeAddition:
+		return h.Graph.AddEdge(copyEdge(c.edge))
+	case edgeRemoval:
+		return h.Graph.RemoveEdge(c.edge.Source, c.edge.Target)
+	default:
+		return h.Graph.UpdateEdge(c.edge.Source, c.edge.Target, setEdgeProperties(c.edge.Properties))
+	}
+}
+
+// revert performs the inverse of the given change on the wrapped graph.
+func (h *History[K, T]) revert(c change[K, T]) error {
+	switch c.kind {
+	case vertexAddition:
+		return h.Graph.RemoveVertex(c.hash)
+	case vertexRemoval:
+		return h.Graph.AddVertex(c.value, copyVertexProperties(c.vertexProperties))
+	case vertexUpdate:
+		return h.Graph.UpsertVertex(c.previousValue, copyVertexProperties(c.previousVertexProperties))
+	case edgeAddition:
+		return h.Graph.RemoveEdge(c.edge.Source, c.edge.Target)
+	case edgeRemoval:
+		return h.Graph.AddEdge(copyEdge(c.edge))
+	default:
+		return h.Graph.UpdateEdge(c.edge.Source, c.edge.Target, setEdgeProperties(c.previous.Properties))
+	}
+}
+
+// edge returns the edge between the given vertices with an independent copy of
+// its attributes, so that later updates of the edge don't affect it. The edge
+// is read from the store, so that it contains the stored weight rather than
+// the weight computed by a function registered using WeightFunc, which would
+// otherwise be written back as the stored weight when reverting a change.
+func (h *History[K, T]) edge(source, target K) (Edge[K], error) {
+	var (
+		properties EdgeProperties
+		err        error
+	)
+
+	if store := rawStoreOf(h.Graph); store != nil {
+		var edge Edge[K]
+
+		edge, err = store.Edge(source, target)
+		if errors.Is(err, ErrEdgeNotFound) && !h.Traits().IsDirected {
+			edge, err = store.Edge(target, source)
+		}
+		properties = edge.Properties
+	} else {
+		var edge Edge[T]
+
+		edge, err = h.Graph.Edge(source, target)
+		properties = edge.Properties
+	}
+
+	if err != nil {
+		return Edge[K]{}, fmt.Errorf("could not get edge (%v, %v): %w", source, target, err)
+	}
+
+	return newEdgeFrom(Edge[K]{Source: source, Target: target, Properties: properties}), nil
+}
+
+// setEdgeProperties returns a functional option that replaces all properties of
+// an edge with the given properties.
+func setEdgeProperties(properties EdgeProperties) func(*EdgeProperties) {
+	return func(p *EdgeProperties) {
+		attributes := make(map[string]string, len(properties.Attributes))
+		for k, v := range properties.Attributes {
+			attributes[k] = v
+		}
+
+		*p = properties
+		p.Attributes = attributes
+	}
+}
+
+// newVertexPropertiesFrom returns a copy of the given properties with an
+// independent attributes map.
+func newVertexPropertiesFrom(properties VertexProperties) VertexProperties {
+	attributes := make(map[string]string, len(properties.Attributes))
+	for k, v := range properties.Attributes {
+		attributes[k] = v
+	}
+
+	return VertexProperties{Attributes: attributes, Weight: properties.Weight}
+}
@@ -0,0 +1,451 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHistory_UndoRedo(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		mutate     func(h *History[int, int]) error
+	}{
+		"add vertex": {
+			mutate: func(h *History[int, int]) error {
+				return h.AddVertex(4, VertexWeight(2), VertexAttribute("color", "red"))
+			},
+		},
+		"add vertices": {
+			mutate: func(h *History[int, int]) error {
+				return h.AddVertices([]int{4, 5, 6})
+			},
+		},
+		"add vertices from other graph": {
+			mutate: func(h *History[int, int]) error {
+				other := New(IntHash)
+				_ = other.AddVertex(5)
+				_ = other.AddVertex(6, VertexWeight(3))
+				return h.AddVerticesFrom(other)
+			},
+		},
+		"remove vertex": {
+			mutate: func(h *History[int, int]) error {
+				return h.RemoveVertex(3)
+			},
+		},
+		"add vertex with hash": {
+			mutate: func(h *History[int, int]) error {
+				return h.AddVertexWithHash(4, 4, VertexWeight(2))
+			},
+		},
+		"upsert existing vertex": {
+			mutate: func(h *History[int, int]) error {
+				return h.UpsertVertex(2, VertexWeight(8), VertexAttribute("color", "green"))
+			},
+		},
+		"upsert new vertex": {
+			mutate: func(h *History[int, int]) error {
+				return h.UpsertVertex(4, VertexWeight(8))
+			},
+		},
+		"add edge": {
+			mutate: func(h *History[int, int]) error {
+				return h.AddEdge(2, 3, EdgeWeight(4), EdgeAttribute("color", "blue"))
+			},
+		},
+		"add edges": {
+			isDirected: true,
+			mutate: func(h *History[int, int]) error {
+				return h.AddEdges([]Edge[int]{
+					{Source: 2, Target: 1},
+					{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 7}},
+				})
+			},
+		},
+		"add edges from other graph": {
+			mutate: func(h *History[int, int]) error {
+				other := New(IntHash)
+				_ = other.AddVertex(2)
+				_ = other.AddVertex(3)
+				_ = other.AddEdge(3, 2, EdgeWeight(9))
+				return h.AddEdgesFrom(other)
+			},
+		},
+		"update edge": {
+			mutate: func(h *History[int, int]) error {
+				return h.UpdateEdge(2, 1, EdgeWeight(10), EdgeAttribute("label", "new"))
+			},
+		},
+		"update edge attributes in place": {
+			mutate: func(h *History[int, int]) error {
+				return h.UpdateEdge(1, 2, EdgeAttribute("color", "green"))
+			},
+		},
+		"update weights": {
+			isDirected: true,
+			mutate: func(h *History[int, int]) error {
+				return h.UpdateWeights(map[EdgeKey[int]]int{{Source: 1, Target: 2}: 20})
+			},
+		},
+		"remove edge": {
+			mutate: func(h *History[int, int]) error {
+				return h.RemoveEdge(1, 2)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := NewHistory(newHistoryGraph(test.isDirected))
+
+			before, _ := h.Clone()
+
+			if err := test.mutate(h); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			after, _ := h.Clone()
+
+			if h.Version() != 1 || h.Versions() != 1 {
+				t.Fatalf("expected version 1 of 1, got version %d of %d", h.Version(), h.Versions())
+			}
+
+			if err := h.Undo(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if equal, _ := Equal[int, int](h, before); !equal {
+				t.Errorf("expected graph to equal the original graph after undo")
+			}
+
+			if err := h.Redo(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if equal, _ := Equal[int, int](h, after); !equal {
+				t.Errorf("expected graph to equal the mutated graph after redo")
+			}
+		})
+	}
+}
+
+func TestHistory_Checkout(t *testing.T) {
+	h := NewHistory(New(IntHash, Directed()))
+
+	_ = h.AddVertex(1)
+	_ = h.AddVertex(2)
+	_ = h.AddEdge(1, 2)
+	_ = h.UpdateEdge(1, 2, EdgeWeight(5))
+
+	if h.Version() != 4 {
+		t.Fatalf("expected version 4, got %d", h.Version())
+	}
+
+	if err := h.Checkout(0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if order, _ := h.Order(); order != 0 {
+		t.Errorf("expected empty graph in version 0, got %d vertices", order)
+	}
+
+	if h.CanUndo() || !h.CanRedo() {
+		t.Errorf("expected to be able to redo but not undo in version 0")
+	}
+
+	if err := h.Checkout(3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if edge, err := h.Edge(1, 2); err != nil || edge.Properties.Weight != 0 {
+		t.Errorf("expected edge (1, 2) with weight 0 in version 3, got %v, %v", edge, err)
+	}
+
+	if err := h.Checkout(5); err == nil {
+		t.Error("expected error for non-existent version, got nil")
+	}
+
+	// A new change discards version 4, which could have been redone.
+	_ = h.AddVertex(3)
+
+	if h.Version() != 4 || h.Versions() != 4 || h.CanRedo() {
+		t.Errorf("expected version 4 of 4 without redo, got version %d of %d", h.Version(), h.Versions())
+	}
+
+	if edge, _ := h.Edge(1, 2); edge.Properties.Weight != 0 {
+		t.Errorf("expected discarded weight update, got weight %d", edge.Properties.Weight)
+	}
+}
+
+func TestHistory_failedMutation(t *testing.T) {
+	h := NewHistory(newHistoryGraph(false))
+
+	if err := h.AddVertex(1); err == nil {
+		t.Fatal("expected error for existing vertex, got nil")
+	}
+
+	if err := h.RemoveVertex(1); err == nil {
+		t.Fatal("expected error for vertex with edges, got nil")
+	}
+
+	if err := h.UpdateEdge(2, 3, EdgeWeight(1)); err == nil {
+		t.Fatal("expected error for missing edge, got nil")
+	}
+
+	if h.Version() != 0 || h.CanUndo() {
+		t.Errorf("expected no version to be recorded, got version %d", h.Version())
+	}
+
+	if err := h.Undo(); err == nil {
+		t.Error("expected error when undoing without changes, got nil")
+	}
+
+	if err := h.Redo(); err == nil {
+		t.Error("expected error when redoing without undone changes, got nil")
+	}
+}
+
+func TestHistory_partialMutation(t *testing.T) {
+	h := NewHistory(New(IntHash, Directed(), PreventCycles()))
+
+	_ = h.AddVertices([]int{1, 2, 3})
+
+	// The last edge would create a cycle, but the other edges are added.
+	err := h.AddEdges([]Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}, {Source: 3, Target: 1}})
+	if err == nil {
+		t.Fatal("expected error for edge creating a cycle, got nil")
+	}
+
+	if size, _ := h.Size(); size != 2 || h.Version() != 2 {
+		t.Fatalf("expected 2 edges in version 2, got %d edges in version %d", size, h.Version())
+	}
+
+	_ = h.Undo()
+
+	if size, _ := h.Size(); size != 0 {
+		t.Errorf("expected undo to remove the added edges, got %d edges", size)
+	}
+}
+
+func TestHistory_weightFunc(t *testing.T) {
+	for _, isDirected := range []bool{true, false} {
+		t.Run(fmt.Sprintf("directed: %v", isDirected), func(t *testing.T) {
+			traits := []func(*Traits){WeightFunc(func(edge Edge[int]) int {
+				return edge.Properties.Weight * 10
+			})}
+			if isDirected {
+				traits = append(traits, Directed())
+			}
+
+			h := NewHistory(New(IntHash, traits...))
+
+			_ = h.AddVertices([]int{1, 2})
+			_ = h.AddEdge(1, 2, EdgeWeight(1))
+			_ = h.UpdateEdge(1, 2, EdgeWeight(2))
+
+			// Undoing restores the stored weight, not the computed one.
+			_ = h.Undo()
+
+			if edge, _ := h.Edge(1, 2); edge.Properties.Weight != 10 {
+				t.Errorf("expected weight 10 after undo, got %d", edge.Properties.Weight)
+			}
+
+			_ = h.Redo()
+
+			if edge, _ := h.Edge(1, 2); edge.Properties.Weight != 20 {
+				t.Errorf("expected weight 20 after redo, got %d", edge.Properties.Weight)
+			}
+
+			_ = h.RemoveEdge(1, 2)
+			_ = h.Undo()
+
+			if edge, _ := h.Edge(1, 2); edge.Properties.Weight != 20 {
+				t.Errorf("expected weight 20 after undoing the removal, got %d", edge.Properties.Weight)
+			}
+		})
+	}
+}
+
+func TestHistory_duplicateEdges(t *testing.T) {
+	tests := map[string]struct {
+		traits          []func(*Traits)
+		expectedWeight  int
+		expectedVersion int
+	}{
+		"KeepFirstEdge": {
+			traits:          []func(*Traits){KeepFirstEdge()},
+			expectedWeight:  3,
+			expectedVersion: 2,
+		},
+		"MergeEdges": {
+			traits: []func(*Traits){MergeEdges(func(existing, duplicate Edge[int]) EdgeProperties {
+				existing.Properties.Weight += duplicate.Properties.Weight
+				return existing.Properties
+			})},
+			expectedWeight:  8,
+			expectedVersion: 3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := NewHistory(New(IntHash, test.traits...))
+
+			_ = h.AddVertices([]int{1, 2})
+			_ = h.AddEdge(1, 2, EdgeWeight(3))
+			_ = h.AddEdge(2, 1, EdgeWeight(5))
+
+			if edge, _ := h.Edge(1, 2); edge.Properties.Weight != test.expectedWeight {
+				t.Fatalf("expected weight %d, got %d", test.expectedWeight, edge.Properties.Weight)
+			}
+
+			if h.Version() != test.expectedVersion {
+				t.Fatalf("expected version %d, got %d", test.expectedVersion, h.Version())
+			}
+
+			// Undoing the duplicate edge keeps the edge that has been added
+			// before.
+			_ = h.Checkout(2)
+
+			edge, err := h.Edge(1, 2)
+			if err != nil {
+				t.Fatalf("expected edge to exist in version 2, got %v", err)
+			}
+
+			if edge.Properties.Weight != 3 {
+				t.Errorf("expected weight 3 in version 2, got %d", edge.Properties.Weight)
+			}
+
+			_ = h.Checkout(h.Versions())
+
+			if edge, _ := h.Edge(1, 2); edge.Properties.Weight != test.expectedWeight {
+				t.Errorf("expected weight %d after redo, got %d", test.expectedWeight, edge.Properties.Weight)
+			}
+		})
+	}
+}
+
+func TestHistory_autoVertices(t *testing.T) {
+	tests := map[string]struct {
+		bulk bool
+	}{
+		"AddEdge":  {},
+		"AddEdges": {bulk: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := NewHistory(New(IntHash, Directed(), AutoVertices(func(hash int) int { return hash })))
+
+			_ = h.AddVertex(1)
+
+			if test.bulk {
+				_ = h.AddEdges([]Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}})
+			} else {
+				_ = h.AddEdge(1, 2)
+				_ = h.AddEdge(2, 3)
+			}
+
+			if order, _ := h.Order(); order != 3 {
+				t.Fatalf("expected 3 vertices, got %d", order)
+			}
+
+			if err := h.Checkout(1); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if order, _ := h.Order(); order != 1 {
+				t.Errorf("expected 1 vertex in version 1, got %d", order)
+			}
+
+			if err := h.Checkout(h.Versions()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if order, _ := h.Order(); order != 3 {
+				t.Errorf("expected 3 vertices after redo, got %d", order)
+			}
+
+			if size, _ := h.Size(); size != 2 {
+				t.Errorf("expected 2 edges after redo, got %d", size)
+			}
+		})
+	}
+}
+
+// newHistoryGraph creates a graph with the vertices 1 to 3, where vertex 3 has
+// a weight, and an edge (1, 2) with a weight and an attribute.
+func newHistoryGraph(isDirected bool) Graph[int, int] {
+	g := New(IntHash)
+	if isDirected {
+		g = New(IntHash, Directed())
+	}
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2, VertexAttribute("label", "two"))
+	_ = g.AddVertex(3, VertexWeight(3))
+	_ = g.AddEdge(1, 2, EdgeWeight(5), EdgeAttribute("color", "red"))
+
+	return g
+}
+
+func TestHistory_graphFunctions(t *testing.T) {
+	tests := map[string]struct {
+		function func(g Graph[int, int]) (Graph[int, int], error)
+		isEmpty  bool
+	}{
+		"NewLike": {
+			function: func(g Graph[int, int]) (Graph[int, int], error) {
+				return NewLike(g), nil
+			},
+			isEmpty: true,
+		},
+		"NewHistory": {
+			function: func(g Graph[int, int]) (Graph[int, int], error) {
+				return NewHistory(g), nil
+			},
+		},
+		"Freeze": {
+			function: Freeze[int, int],
+		},
+		"Compact": {
+			function: func(g Graph[int, int]) (Graph[int, int], error) {
+				return Compact(g, IntShardKey)
+			},
+		},
+		"Spanner": {
+			function: func(g Graph[int, int]) (Graph[int, int], error) {
+				return Spanner(g, 2)
+			},
+		},
+		"KCore": {
+			function: func(g Graph[int, int]) (Graph[int, int], error) {
+				return KCore(g, 1)
+			},
+		},
+		"ConnectedSubgraphContaining": {
+			function: func(g Graph[int, int]) (Graph[int, int], error) {
+				return ConnectedSubgraphContaining(g, 1, func(Edge[int]) bool { return true })
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := NewHistory(newHistoryGraph(true))
+
+			result, err := test.function(h)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !result.Traits().IsDirected {
+				t.Errorf("expected result to be directed")
+			}
+
+			if _, err := result.Vertex(1); err != nil && !test.isEmpty {
+				t.Errorf("expected result to contain vertex 1, got %v", err)
+			}
+		})
+	}
+}
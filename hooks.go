@@ -0,0 +1,106 @@
+package graph
+
+// The hook types below are distinct named types so that hooks with the same
+// function signature, such as OnEdgeAdded and OnEdgeRemoved, can be told apart.
+type (
+	vertexAddedHook[K comparable, T any] func(hash K, value T)
+	vertexRemovedHook[K comparable]      func(hash K)
+	edgeAddedHook[K comparable]          func(edge Edge[K])
+	edgeRemovedHook[K comparable]        func(edge Edge[K])
+	edgesUpdatedHook[K comparable]       func(edges []Edge[K])
+)
+
+// OnVertexAdded registers a callback that is invoked after a vertex has been
+// added to the graph, for example using AddVertex or AddVertices. This allows
+// caches, indexes, or user interfaces to react to changes of the graph:
+//
+//	g := graph.New(graph.IntHash, graph.OnVertexAdded(func(hash int, value int) {
+//		fmt.Println("added vertex", hash)
+//	}))
+//
+// The types of the callback's parameters have to match the types of the graph,
+// otherwise the callback is never invoked. Use [ValidateTraits] to detect such
+// a mismatch. Hooks are invoked synchronously and are not copied by Clone or
+// NewLike.
+func OnVertexAdded[K comparable, T any](hook func(hash K, value T)) func(*Traits) {
+	return func(t *Traits) {
+		t.hooks = t.hooks.with(vertexAddedHook[K, T](hook))
+	}
+}
+
+// OnVertexRemoved registers a callback that is invoked after a vertex has been
+// removed from the graph. See [OnVertexAdded] for details.
+func OnVertexRemoved[K comparable](hook func(hash K)) func(*Traits) {
+	return func(t *Traits) {
+		t.hooks = t.hooks.with(vertexRemovedHook[K](hook))
+	}
+}
+
+// OnEdgeAdded registers a callback that is invoked after an edge has been added
+// to the graph. The callback receives the added edge including its properties.
+// In an undirected graph, the hook is invoked once per edge. See [OnVertexAdded]
+// for details.
+func OnEdgeAdded[K comparable](hook func(edge Edge[K])) func(*Traits) {
+	return func(t *Traits) {
+		t.hooks = t.hooks.with(edgeAddedHook[K](hook))
+	}
+}
+
+// OnEdgeRemoved registers a callback that is invoked after an edge has been
+// removed from the graph. The callback receives the edge as it was before its
+// removal. See [OnVertexAdded] for details.
+func OnEdgeRemoved[K comparable](hook func(edge Edge[K])) func(*Traits) {
+	return func(t *Traits) {
+		t.hooks = t.hooks.with(edgeRemovedHook[K](hook))
+	}
+}
+
+// OnEdgesUpdated registers a callback that is invoked after the properties of
+// one or more edges have been updated. UpdateEdge invokes the callback with the
+// single updated edge, while UpdateWeights invokes it only once for all updated
+// edges. See [OnVertexAdded] for details.
+func OnEdgesUpdated[K comparable](hook func(edges []Edge[K])) func(*Traits) {
+	return func(t *Traits) {
+		t.hooks = t.hooks.with(edgesUpdatedHook[K](hook))
+	}
+}
+
+func vertexAdded[K comparable, T any](t *Traits, hash K, value T) {
+	for _, hook := range t.hooks.all() {
+		if h, ok := hook.(vertexAddedHook[K, T]); ok {
+			h(hash, value)
+		}
+	}
+}
+
+func vertexRemoved[K comparable](t *Traits, hash K) {
+	for _, hook := range t.hooks.all() {
+		if h, ok := hook.(vertexRemovedHook[K]); ok {
+			h(hash)
+		}
+	}
+}
+
+func edgeAdded[K comparable](t *Traits, edge Edge[K]) {
+	for _, hook := range t.hooks.all() {
+		if h, ok := hook.(edgeAddedHook[K]); ok {
+			h(edge)
+		}
+	}
+}
+
+func edgeRemoved[K comparable](t *Traits, edge Edge[K]) {
+	for _, hook := range t.hooks.all() {
+		if h, ok := hook.(edgeRemovedHook[K]); ok {
+			h(edge)
+		}
+	}
+}
+
+func edgesUpdated[K comparable](t *Traits, edges []Edge[K]) {
+	for _, hook := range t.hooks.all() {
+		if h, ok := hook.(edgesUpdatedHook[K]); ok {
+			h(edges)
+		}
+	}
+}
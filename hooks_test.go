@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestHooks(t *testing.T) {
+	tests := map[string]struct {
+		options  []func(*Traits)
+		mutate   func(g Graph[int, int])
+		expected []string
+	}{
+		"directed graph": {
+			options: []func(*Traits){Directed()},
+			mutate: func(g Graph[int, int]) {
+				_ = g.AddVertex(1)
+				_ = g.AddVertices([]int{2, 3})
+				_ = g.AddEdge(1, 2)
+				_ = g.AddEdges([]Edge[int]{{Source: 2, Target: 3}})
+				_ = g.UpdateEdge(1, 2, EdgeWeight(4))
+				_ = g.UpdateWeights(map[EdgeKey[int]]int{{Source: 2, Target: 3}: 5})
+				_ = g.RemoveEdge(1, 2)
+				_ = g.RemoveVertex(1)
+			},
+			expected: []string{
+				"vertex added 1",
+				"vertex added 2",
+				"vertex added 3",
+				"edge added (1, 2) 0",
+				"edge added (2, 3) 0",
+				"edges updated [(1, 2) 4]",
+				"edges updated [(2, 3) 5]",
+				"edge removed (1, 2) 4",
+				"vertex removed 1",
+			},
+		},
+		"undirected graph": {
+			mutate: func(g Graph[int, int]) {
+				_ = g.AddVertex(1)
+				_ = g.AddVertices([]int{2, 3})
+				_ = g.AddEdge(1, 2)
+				_ = g.AddEdges([]Edge[int]{{Source: 2, Target: 3}})
+				_ = g.UpdateEdge(1, 2, EdgeWeight(4))
+				_ = g.UpdateWeights(map[EdgeKey[int]]int{{Source: 2, Target: 3}: 5})
+				_ = g.RemoveEdge(2, 1)
+				_ = g.RemoveVertex(1)
+			},
+			expected: []string{
+				"vertex added 1",
+				"vertex added 2",
+				"vertex added 3",
+				"edge added (1, 2) 0",
+				"edge added (2, 3) 0",
+				"edges updated [(1, 2) 4]",
+				"edges updated [(2, 3) 5]",
+				"edge removed (2, 1) 4",
+				"vertex removed 1",
+			},
+		},
+		"failed operations": {
+			options: []func(*Traits){Directed()},
+			mutate: func(g Graph[int, int]) {
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(1)
+				_ = g.AddEdge(1, 2)
+				_ = g.RemoveEdge(1, 2)
+				_ = g.RemoveVertex(2)
+			},
+			expected: []string{
+				"vertex added 1",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var events []string
+
+			formatEdge := func(e Edge[int]) string {
+				return "(" + strconv.Itoa(e.Source) + ", " + strconv.Itoa(e.Target) + ") " + strconv.Itoa(e.Properties.Weight)
+			}
+
+			hooks := []func(*Traits){
+				OnVertexAdded(func(hash int, value int) {
+					events = append(events, "vertex added "+strconv.Itoa(hash))
+				}),
+				OnVertexRemoved(func(hash int) {
+					events = append(events, "vertex removed "+strconv.Itoa(hash))
+				}),
+				OnEdgeAdded(func(edge Edge[int]) {
+					events = append(events, "edge added "+formatEdge(edge))
+				}),
+				OnEdgeRemoved(func(edge Edge[int]) {
+					events = append(events, "edge removed "+formatEdge(edge))
+				}),
+				OnEdgesUpdated(func(edges []Edge[int]) {
+					event := "edges updated ["
+					for i, edge := range edges {
+						if i > 0 {
+							event += ", "
+						}
+						event += formatEdge(edge)
+					}
+					events = append(events, event+"]")
+				}),
+			}
+
+			g := New(IntHash, append(test.options, hooks...)...)
+
+			test.mutate(g)
+
+			if !reflect.DeepEqual(events, test.expected) {
+				t.Errorf("expected events %v, got %v", test.expected, events)
+			}
+		})
+	}
+}
+
+func TestHooks_UpdateWeightsFiresOnce(t *testing.T) {
+	calls := 0
+
+	g := New(IntHash, Directed(), OnEdgesUpdated(func(edges []Edge[int]) {
+		calls++
+		if len(edges) != 3 {
+			t.Errorf("expected 3 updated edges, got %d", len(edges))
+		}
+	}))
+
+	_ = g.AddVertices([]int{1, 2, 3, 4})
+	_ = g.AddEdges([]Edge[int]{
+		{Source: 1, Target: 2},
+		{Source: 2, Target: 3},
+		{Source: 3, Target: 4},
+	})
+
+	_ = g.UpdateWeights(map[EdgeKey[int]]int{
+		{Source: 1, Target: 2}: 1,
+		{Source: 2, Target: 3}: 2,
+		{Source: 3, Target: 4}: 3,
+	})
+
+	if calls != 1 {
+		t.Errorf("expected hook to be invoked once, got %d", calls)
+	}
+}
+
+func TestHooks_NotCopied(t *testing.T) {
+	calls := 0
+
+	g := New(IntHash, OnVertexAdded(func(int, int) {
+		calls++
+	}))
+
+	_ = g.AddVertex(1)
+
+	clone, _ := g.Clone()
+	_ = clone.AddVertex(2)
+
+	h := NewLike(g)
+	_ = h.AddVertex(3)
+
+	if calls != 1 {
+		t.Errorf("expected hook to be invoked once, got %d", calls)
+	}
+}
@@ -0,0 +1,400 @@
+package graph
+
+import "fmt"
+
+// Hypergraph is a graph whose edges, called hyperedges, connect an arbitrary
+// set of vertices instead of exactly two. This models relations between more
+// than two entities, such as the authors of a paper or the items bought in a
+// transaction:
+//
+//	h := graph.NewHypergraph(graph.StringHash)
+//
+//	_ = h.AddVertex("alice")
+//	_ = h.AddVertex("bob")
+//	_ = h.AddVertex("carol")
+//
+//	_ = h.AddHyperedge("paper-1", []string{"alice", "bob", "carol"})
+//
+// Hyperedges are identified by a hash of the same type as the vertices, but the
+// hashes of hyperedges and vertices are independent of each other. Hyperedges
+// are undirected. Algorithms for ordinary graphs can be run on the incidence
+// graph of a hypergraph, which is returned by [IncidenceGraph].
+//
+// A Hypergraph keeps its vertices and hyperedges in memory and is not safe for
+// concurrent use.
+type Hypergraph[K comparable, T any] struct {
+	hash             Hash[K, T]
+	vertices         map[K]T
+	vertexProperties map[K]VertexProperties
+	hyperedges       map[K]Hyperedge[K]
+	// incidences contains the hashes of the hyperedges each vertex belongs to.
+	incidences map[K]map[K]struct{}
+}
+
+// Hyperedge is an edge of a [Hypergraph] that connects a set of vertices. The
+// properties of a hyperedge are set using the same functional options as for
+// ordinary edges, such as [EdgeWeight].
+type Hyperedge[K comparable] struct {
+	Hash       K
+	Vertices   []K
+	Properties EdgeProperties
+}
+
+// NewHypergraph creates an empty hypergraph that uses the given hashing function
+// for its vertices.
+func NewHypergraph[K comparable, T any](hash Hash[K, T]) *Hypergraph[K, T] {
+	return &Hypergraph[K, T]{
+		hash:             hash,
+		vertices:         make(map[K]T),
+		vertexProperties: make(map[K]VertexProperties),
+		hyperedges:       make(map[K]Hyperedge[K]),
+		incidences:       make(map[K]map[K]struct{}),
+	}
+}
+
+// AddVertex adds a vertex with the given value to the hypergraph. If a vertex
+// with the same hash already exists, ErrVertexAlreadyExists is returned. See
+// [graph.Graph.AddVertex].
+func (h *Hypergraph[K, T]) AddVertex(value T, options ...func(*VertexProperties)) error {
+	hash := h.hash(value)
+
+	if _, ok := h.vertices[hash]; ok {
+		return ErrVertexAlreadyExists
+	}
+
+	properties := VertexProperties{
+		Weight:     0,
+		Attributes: make(map[string]string),
+	}
+
+	for _, option := range options {
+		option(&properties)
+	}
+
+	h.vertices[hash] = value
+	h.vertexProperties[hash] = properties
+	h.incidences[hash] = make(map[K]struct{})
+
+	return nil
+}
+
+// Vertex returns the vertex with the given hash or ErrVertexNotFound if it
+// doesn't exist.
+func (h *Hypergraph[K, T]) Vertex(hash K) (T, error) {
+	vertex, _, err := h.VertexWithProperties(hash)
+	return vertex, err
+}
+
+// VertexWithProperties returns the vertex with the given hash along with its
+// properties or ErrVertexNotFound if it doesn't exist.
+func (h *Hypergraph[K, T]) VertexWithProperties(hash K) (T, VertexProperties, error) {
+	vertex, ok := h.vertices[hash]
+	if !ok {
+		return vertex, VertexProperties{}, ErrVertexNotFound
+	}
+
+	return vertex, h.vertexProperties[hash], nil
+}
+
+// RemoveVertex removes the vertex with the given hash. If the vertex belongs to
+// a hyperedge, ErrVertexHasEdges is returned, and the hyperedge has to be
+// removed first.
+func (h *Hypergraph[K, T]) RemoveVertex(hash K) error {
+	if _, ok := h.vertices[hash]; !ok {
+		return ErrVertexNotFound
+	}
+
+	if len(h.incidences[hash]) > 0 {
+		return ErrVertexHasEdges
+	}
+
+	delete(h.vertices, hash)
+	delete(h.vertexProperties, hash)
+	delete(h.incidences, hash)
+
+	return nil
+}
+
+// AddHyperedge adds a hyperedge with the given hash that connects the given
+// vertices. Duplicate vertices are only added once, and the hyperedge has to
+// contain at least one vertex. If a hyperedge with the same hash already exists,
+// ErrEdgeAlreadyExists is returned. If one of the vertices doesn't exist,
+// ErrVertexNotFound is returned.
+func (h *Hypergraph[K, T]) AddHyperedge(hash K, vertices []K, options ...func(*EdgeProperties)) error {
+	if _, ok := h.hyperedges[hash]; ok {
+		return ErrEdgeAlreadyExists
+	}
+
+	if len(vertices) == 0 {
+		return fmt.Errorf("hyperedge %v must contain at least one vertex", hash)
+	}
+
+	members := make([]K, 0, len(vertices))
+	visited := make(map[K]struct{}, len(vertices))
+
+	for _, vertex := range vertices {
+		if _, ok := h.vertices[vertex]; !ok {
+			return fmt.Errorf("vertex %v: %w", vertex, ErrVertexNotFound)
+		}
+
+		if _, ok := visited[vertex]; ok {
+			continue
+		}
+
+		visited[vertex] = struct{}{}
+		members = append(members, vertex)
+	}
+
+	hyperedge := Hyperedge[K]{
+		Hash:     hash,
+		Vertices: members,
+		Properties: EdgeProperties{
+			Attributes: make(map[string]string),
+		},
+	}
+
+	for _, option := range options {
+		option(&hyperedge.Properties)
+	}
+
+	h.hyperedges[hash] = hyperedge
+
+	for _, vertex := range members {
+		h.incidences[vertex][hash] = struct{}{}
+	}
+
+	return nil
+}
+
+// Hyperedge returns the hyperedge with the given hash or ErrEdgeNotFound if it
+// doesn't exist. Its vertices are in the order they have been added in.
+func (h *Hypergraph[K, T]) Hyperedge(hash K) (Hyperedge[K], error) {
+	hyperedge, ok := h.hyperedges[hash]
+	if !ok {
+		return Hyperedge[K]{}, ErrEdgeNotFound
+	}
+
+	return copyHyperedge(hyperedge), nil
+}
+
+// RemoveHyperedge removes the hyperedge with the given hash. Its vertices remain
+// in the hypergraph.
+func (h *Hypergraph[K, T]) RemoveHyperedge(hash K) error {
+	hyperedge, ok := h.hyperedges[hash]
+	if !ok {
+		return ErrEdgeNotFound
+	}
+
+	for _, vertex := range hyperedge.Vertices {
+		delete(h.incidences[vertex], hash)
+	}
+
+	delete(h.hyperedges, hash)
+
+	return nil
+}
+
+// Hyperedges returns all hyperedges of the hypergraph in an arbitrary order.
+func (h *Hypergraph[K, T]) Hyperedges() []Hyperedge[K] {
+	hyperedges := make([]Hyperedge[K], 0, len(h.hyperedges))
+
+	for _, hyperedge := range h.hyperedges {
+		hyperedges = append(hyperedges, copyHyperedge(hyperedge))
+	}
+
+	return hyperedges
+}
+
+// Incident returns the hashes of the hyperedges the given vertex belongs to in
+// an arbitrary order. For a co-authorship hypergraph, these are the papers of
+// an author. If the vertex doesn't exist, ErrVertexNotFound is returned.
+func (h *Hypergraph[K, T]) Incident(hash K) ([]K, error) {
+	incidences, ok := h.incidences[hash]
+	if !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	hyperedges := make([]K, 0, len(incidences))
+	for hyperedge := range incidences {
+		hyperedges = append(hyperedges, hyperedge)
+	}
+
+	return hyperedges, nil
+}
+
+// Order returns the number of vertices in the hypergraph.
+func (h *Hypergraph[K, T]) Order() int {
+	return len(h.vertices)
+}
+
+// Size returns the number of hyperedges in the hypergraph.
+func (h *Hypergraph[K, T]) Size() int {
+	return len(h.hyperedges)
+}
+
+func copyHyperedge[K comparable](hyperedge Hyperedge[K]) Hyperedge[K] {
+	vertices := make([]K, len(hyperedge.Vertices))
+	copy(vertices, hyperedge.Vertices)
+
+	attributes := make(map[string]string, len(hyperedge.Properties.Attributes))
+	for key, value := range hyperedge.Properties.Attributes {
+		attributes[key] = value
+	}
+
+	hyperedge.Vertices = vertices
+	hyperedge.Properties.Attributes = attributes
+
+	return hyperedge
+}
+
+// IncidenceKey is the hash of a vertex of an incidence graph, which stands for
+// either a vertex or a hyperedge of a [Hypergraph].
+type IncidenceKey[K comparable] struct {
+	Hash        K
+	IsHyperedge bool
+}
+
+// IncidenceVertex is a vertex of an incidence graph. For a vertex of the
+// hypergraph, Value is its value. For a hyperedge, Value is the zero value.
+type IncidenceVertex[K comparable, T any] struct {
+	Key   IncidenceKey[K]
+	Value T
+}
+
+// IncidenceHash is the hashing function of incidence graphs, which returns the
+// key of an [IncidenceVertex]. It can be used to create an incidence graph that
+// is converted into a hypergraph using [FromIncidenceGraph].
+func IncidenceHash[K comparable, T any](vertex IncidenceVertex[K, T]) IncidenceKey[K] {
+	return vertex.Key
+}
+
+// IncidenceGraph returns the incidence graph of the given hypergraph, which is
+// an undirected bipartite graph with a vertex for each vertex and each hyperedge
+// of the hypergraph. Each hyperedge is connected to the vertices it contains:
+//
+//	incidence, _ := graph.IncidenceGraph(h)
+//
+//	// Find the shortest chain of co-authors between alice and dave.
+//	path, _ := graph.ShortestPath(incidence,
+//		graph.IncidenceKey[string]{Hash: "alice"},
+//		graph.IncidenceKey[string]{Hash: "dave"},
+//	)
+//
+// The vertices of the incidence graph keep their properties. The vertex of a
+// hyperedge gets the attributes and weight of the hyperedge as its properties,
+// while its other properties like Data are not preserved. The edges of the
+// incidence graph don't have any properties.
+func IncidenceGraph[K comparable, T any](h *Hypergraph[K, T]) (Graph[IncidenceKey[K], IncidenceVertex[K, T]], error) {
+	g := New(IncidenceHash[K, T])
+
+	for hash, value := range h.vertices {
+		vertex := IncidenceVertex[K, T]{
+			Key:   IncidenceKey[K]{Hash: hash},
+			Value: value,
+		}
+
+		if err := g.AddVertex(vertex, copyVertexProperties(h.vertexProperties[hash])); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+	}
+
+	for hash, hyperedge := range h.hyperedges {
+		key := IncidenceKey[K]{Hash: hash, IsHyperedge: true}
+
+		properties := VertexProperties{
+			Attributes: hyperedge.Properties.Attributes,
+			Weight:     hyperedge.Properties.Weight,
+		}
+
+		if err := g.AddVertex(IncidenceVertex[K, T]{Key: key}, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add hyperedge %v: %w", hash, err)
+		}
+
+		for _, vertex := range hyperedge.Vertices {
+			if err := g.AddEdge(key, IncidenceKey[K]{Hash: vertex}); err != nil {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", hash, vertex, err)
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// FromIncidenceGraph creates a hypergraph from the given incidence graph, which
+// is the inverse of [IncidenceGraph]. Each hyperedge vertex of the incidence
+// graph becomes a hyperedge that contains its adjacent vertices, and each other
+// vertex becomes a vertex of the hypergraph that uses the given hashing
+// function. This is useful for building a hypergraph from bipartite data, like
+// a graph of authors and their papers.
+//
+// The direction of the edges is ignored. Edges between two vertices or between
+// two hyperedges are not permitted, and each hyperedge has to contain at least
+// one vertex. The hash of each vertex must match the hash of its value.
+func FromIncidenceGraph[K comparable, T any](g Graph[IncidenceKey[K], IncidenceVertex[K, T]], hash Hash[K, T]) (*Hypergraph[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	h := NewHypergraph(hash)
+
+	for key := range adjacencyMap {
+		if key.IsHyperedge {
+			continue
+		}
+
+		vertex, properties, err := g.VertexWithProperties(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", key.Hash, err)
+		}
+
+		if h.hash(vertex.Value) != key.Hash {
+			return nil, fmt.Errorf("vertex %v doesn't match the hash of its value", key.Hash)
+		}
+
+		if err := h.AddVertex(vertex.Value, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", key.Hash, err)
+		}
+	}
+
+	// members contains the vertices of each hyperedge, regardless of the
+	// direction of their edges.
+	members := make(map[K][]K)
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			if source.IsHyperedge == target.IsHyperedge {
+				return nil, fmt.Errorf("edge (%v, %v) doesn't connect a vertex and a hyperedge", source.Hash, target.Hash)
+			}
+
+			if source.IsHyperedge {
+				members[source.Hash] = append(members[source.Hash], target.Hash)
+			} else {
+				members[target.Hash] = append(members[target.Hash], source.Hash)
+			}
+		}
+	}
+
+	for key := range adjacencyMap {
+		if !key.IsHyperedge {
+			continue
+		}
+
+		_, properties, err := g.VertexWithProperties(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hyperedge %v: %w", key.Hash, err)
+		}
+
+		err = h.AddHyperedge(key.Hash, members[key.Hash], func(p *EdgeProperties) {
+			for k, v := range properties.Attributes {
+				p.Attributes[k] = v
+			}
+			p.Weight = properties.Weight
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add hyperedge %v: %w", key.Hash, err)
+		}
+	}
+
+	return h, nil
+}
@@ -0,0 +1,220 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHypergraph_AddHyperedge(t *testing.T) {
+	tests := map[string]struct {
+		vertices         []string
+		hyperedges       map[string][]string
+		hash             string
+		members          []string
+		expectedVertices []string
+		expectedErr      error
+		shouldFail       bool
+	}{
+		"co-authorship": {
+			vertices:         []string{"alice", "bob", "carol"},
+			hash:             "paper-1",
+			members:          []string{"alice", "bob", "carol"},
+			expectedVertices: []string{"alice", "bob", "carol"},
+		},
+		"duplicate vertices": {
+			vertices:         []string{"alice", "bob"},
+			hash:             "paper-1",
+			members:          []string{"alice", "bob", "alice"},
+			expectedVertices: []string{"alice", "bob"},
+		},
+		"hash of a vertex": {
+			vertices:         []string{"alice", "bob"},
+			hash:             "alice",
+			members:          []string{"alice", "bob"},
+			expectedVertices: []string{"alice", "bob"},
+		},
+		"existing hyperedge": {
+			vertices:    []string{"alice", "bob"},
+			hyperedges:  map[string][]string{"paper-1": {"alice"}},
+			hash:        "paper-1",
+			members:     []string{"bob"},
+			expectedErr: ErrEdgeAlreadyExists,
+		},
+		"missing vertex": {
+			vertices:    []string{"alice"},
+			hash:        "paper-1",
+			members:     []string{"alice", "bob"},
+			expectedErr: ErrVertexNotFound,
+		},
+		"no vertices": {
+			vertices:   []string{"alice"},
+			hash:       "paper-1",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := NewHypergraph(StringHash)
+
+			for _, vertex := range test.vertices {
+				_ = h.AddVertex(vertex)
+			}
+
+			for hash, members := range test.hyperedges {
+				_ = h.AddHyperedge(hash, members)
+			}
+
+			err := h.AddHyperedge(test.hash, test.members, EdgeWeight(3))
+
+			if test.expectedErr != nil || test.shouldFail {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			hyperedge, err := h.Hyperedge(test.hash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slicesAreEqual(hyperedge.Vertices, test.expectedVertices) {
+				t.Errorf("expected vertices %v, got %v", test.expectedVertices, hyperedge.Vertices)
+			}
+
+			if hyperedge.Properties.Weight != 3 {
+				t.Errorf("expected weight 3, got %d", hyperedge.Properties.Weight)
+			}
+
+			for _, vertex := range test.expectedVertices {
+				incident, _ := h.Incident(vertex)
+				if !slicesAreEqual(incident, []string{test.hash}) {
+					t.Errorf("expected vertex %v to belong to %v, got %v", vertex, test.hash, incident)
+				}
+			}
+		})
+	}
+}
+
+func TestHypergraph_RemoveVertex(t *testing.T) {
+	h := NewHypergraph(StringHash)
+
+	_ = h.AddVertex("alice")
+	_ = h.AddVertex("bob")
+	_ = h.AddHyperedge("paper-1", []string{"alice", "bob"})
+
+	if err := h.RemoveVertex("alice"); !errors.Is(err, ErrVertexHasEdges) {
+		t.Fatalf("expected error %v, got %v", ErrVertexHasEdges, err)
+	}
+
+	if err := h.RemoveHyperedge("paper-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := h.RemoveVertex("alice"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := h.Vertex("alice"); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+
+	if incident, _ := h.Incident("bob"); len(incident) != 0 {
+		t.Errorf("expected no hyperedges for bob, got %v", incident)
+	}
+
+	if h.Order() != 1 || h.Size() != 0 {
+		t.Errorf("expected order 1 and size 0, got %d and %d", h.Order(), h.Size())
+	}
+}
+
+func TestIncidenceGraph(t *testing.T) {
+	h := NewHypergraph(StringHash)
+
+	_ = h.AddVertex("alice", VertexWeight(1))
+	_ = h.AddVertex("bob")
+	_ = h.AddVertex("carol")
+	_ = h.AddVertex("dave")
+
+	_ = h.AddHyperedge("paper-1", []string{"alice", "bob", "carol"}, EdgeAttribute("year", "2023"))
+	_ = h.AddHyperedge("paper-2", []string{"carol", "dave"}, EdgeWeight(2))
+
+	incidence, err := IncidenceGraph(h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if order, _ := incidence.Order(); order != 6 {
+		t.Errorf("expected order 6, got %d", order)
+	}
+
+	if size, _ := incidence.Size(); size != 5 {
+		t.Errorf("expected size 5, got %d", size)
+	}
+
+	path, err := ShortestPath(incidence, IncidenceKey[string]{Hash: "alice"}, IncidenceKey[string]{Hash: "dave"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(path) != 5 {
+		t.Errorf("expected path of 5 vertices, got %v", path)
+	}
+
+	_, properties, _ := incidence.VertexWithProperties(IncidenceKey[string]{Hash: "paper-1", IsHyperedge: true})
+	if properties.Attributes["year"] != "2023" {
+		t.Errorf("expected attribute year=2023, got %v", properties.Attributes)
+	}
+
+	// Converting the incidence graph back yields the original hypergraph.
+	converted, err := FromIncidenceGraph(incidence, StringHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if converted.Order() != h.Order() || converted.Size() != h.Size() {
+		t.Fatalf("expected order %d and size %d, got %d and %d", h.Order(), h.Size(), converted.Order(), converted.Size())
+	}
+
+	for _, hyperedge := range h.Hyperedges() {
+		convertedHyperedge, err := converted.Hyperedge(hyperedge.Hash)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !slicesAreEqual(convertedHyperedge.Vertices, hyperedge.Vertices) {
+			t.Errorf("expected vertices %v for %v, got %v", hyperedge.Vertices, hyperedge.Hash, convertedHyperedge.Vertices)
+		}
+
+		if convertedHyperedge.Properties.Weight != hyperedge.Properties.Weight {
+			t.Errorf("expected weight %d for %v, got %d", hyperedge.Properties.Weight, hyperedge.Hash, convertedHyperedge.Properties.Weight)
+		}
+	}
+
+	if _, properties, _ := converted.VertexWithProperties("alice"); properties.Weight != 1 {
+		t.Errorf("expected weight 1 for alice, got %d", properties.Weight)
+	}
+}
+
+func TestFromIncidenceGraph_invalidEdge(t *testing.T) {
+	g := New(IncidenceHash[string, string])
+
+	alice := IncidenceVertex[string, string]{Key: IncidenceKey[string]{Hash: "alice"}, Value: "alice"}
+	bob := IncidenceVertex[string, string]{Key: IncidenceKey[string]{Hash: "bob"}, Value: "bob"}
+
+	_ = g.AddVertex(alice)
+	_ = g.AddVertex(bob)
+	_ = g.AddEdge(alice.Key, bob.Key)
+
+	if _, err := FromIncidenceGraph(g, StringHash); err == nil {
+		t.Error("expected error for an edge between two vertices")
+	}
+}
@@ -0,0 +1,85 @@
+package graph
+
+import "fmt"
+
+// ApproximateMaximumIndependentSet computes a large independent set of the
+// given graph. An independent set is a set of vertices of which no two are
+// adjacent, for example a set of tasks without conflicts that can be scheduled
+// at the same time. The direction of the edges is ignored, and vertices with a
+// self-loop are never part of the set because they're adjacent to themselves.
+//
+// Finding a maximum independent set is NP-hard, so a greedy approximation is
+// used instead: It repeatedly adds the vertex with the smallest degree to the
+// set and removes it along with its neighbors from the graph. The resulting set
+// is maximal, i.e. no vertex can be added without losing independence, and it
+// contains at least Σ 1/(d(v)+1) vertices, where d(v) is the degree of vertex
+// v. For graphs with a maximum degree of Δ, it is at most (Δ+2)/3 times smaller
+// than a maximum independent set. This takes O((|V|+|E|)*log(|V|)) time.
+//
+// If there are several vertices with the smallest degree, an arbitrary one is
+// chosen, so the result may vary between calls.
+func ApproximateMaximumIndependentSet[K comparable, T any](g Graph[K, T]) ([]K, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	hashes, neighbors := undirectedNeighbors(adjacencyMap)
+
+	degrees := make([]int, len(hashes))
+	removed := make([]bool, len(hashes))
+
+	for vertex, adjacencies := range neighbors {
+		degrees[vertex] = len(adjacencies)
+	}
+
+	remove := func(vertex int) {
+		removed[vertex] = true
+
+		for _, adjacency := range neighbors[vertex] {
+			degrees[adjacency]--
+		}
+	}
+
+	for vertex, hash := range hashes {
+		if _, ok := adjacencyMap[hash][hash]; ok && !removed[vertex] {
+			remove(vertex)
+		}
+	}
+
+	queue := newPriorityQueue[int]()
+
+	for vertex := range hashes {
+		if !removed[vertex] {
+			queue.Push(vertex, float64(degrees[vertex]))
+		}
+	}
+
+	set := make([]K, 0)
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Pop()
+		if removed[vertex] {
+			continue
+		}
+
+		set = append(set, hashes[vertex])
+		remove(vertex)
+
+		for _, adjacency := range neighbors[vertex] {
+			if removed[adjacency] {
+				continue
+			}
+
+			remove(adjacency)
+
+			for _, next := range neighbors[adjacency] {
+				if !removed[next] {
+					queue.UpdatePriority(next, float64(degrees[next]))
+				}
+			}
+		}
+	}
+
+	return set, nil
+}
@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestApproximateMaximumIndependentSet(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		vertices    []int
+		edges       []Edge[int]
+		expectedSet []int
+	}{
+		"empty graph": {
+			expectedSet: []int{},
+		},
+		"star": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 1, Target: 5},
+				{Source: 1, Target: 6},
+			},
+			expectedSet: []int{2, 3, 4, 5, 6},
+		},
+		"path": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+			},
+			expectedSet: []int{1, 3, 5},
+		},
+		"directed graph with self-loop": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			expectedSet: []int{2, 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target)
+			}
+
+			set, err := ApproximateMaximumIndependentSet(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !slicesAreEqual(set, test.expectedSet) {
+				t.Errorf("expected independent set %v, got %v", test.expectedSet, set)
+			}
+		})
+	}
+}
+
+func TestApproximateMaximumIndependentSet_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	g := New(IntHash)
+
+	for vertex := 0; vertex < 100; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	for i := 0; i < 300; i++ {
+		_ = g.AddEdge(random.Intn(100), random.Intn(100))
+	}
+
+	set, err := ApproximateMaximumIndependentSet(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	inSet := make(map[int]bool)
+	for _, vertex := range set {
+		inSet[vertex] = true
+	}
+
+	for _, vertex := range set {
+		for adjacency := range adjacencyMap[vertex] {
+			if inSet[adjacency] {
+				t.Fatalf("expected independent set, but %d and %d are adjacent", vertex, adjacency)
+			}
+		}
+	}
+
+	for vertex, adjacencies := range adjacencyMap {
+		if inSet[vertex] {
+			continue
+		}
+
+		if _, ok := adjacencies[vertex]; ok {
+			continue
+		}
+
+		hasNeighborInSet := false
+		for adjacency := range adjacencies {
+			if inSet[adjacency] {
+				hasNeighborInSet = true
+			}
+		}
+
+		if !hasNeighborInSet {
+			t.Fatalf("expected maximal independent set, but %d could be added", vertex)
+		}
+	}
+}
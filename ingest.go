@@ -0,0 +1,291 @@
+package graph
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// defaultBatchSize is the number of edges that Ingest and IngestReader add at
+// once if no batch size has been set using BatchSize.
+const defaultBatchSize = 1000
+
+// IngestStats describes the progress of Ingest or IngestReader.
+type IngestStats struct {
+	// Received is the number of edges received so far.
+	Received int
+	// Added is the number of edges that have been added to the graph.
+	Added int
+	// Duplicates is the number of received edges that have been skipped
+	// because they already exist in the graph or have been received before.
+	Duplicates int
+	// Batches is the number of batches that have been added to the graph.
+	Batches int
+	// Elapsed is the time since the ingestion started.
+	Elapsed time.Duration
+	// Backlog is the number of edges waiting in the channel passed to Ingest.
+	// A growing backlog means that the producer is faster than the ingestion,
+	// and that it will be slowed down once the channel buffer is full. It is
+	// always 0 for IngestReader.
+	Backlog int
+}
+
+// Throughput returns the number of edges added to the graph per second.
+func (s IngestStats) Throughput() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+
+	return float64(s.Added) / s.Elapsed.Seconds()
+}
+
+type ingestion struct {
+	batchSize     int
+	flushInterval time.Duration
+	report        func(stats IngestStats)
+}
+
+// BatchSize sets the number of edges that Ingest and IngestReader collect before
+// adding them to the graph at once. Larger batches are faster to add, but the
+// edges take longer to show up in the graph. The default batch size is 1000.
+func BatchSize(n int) func(*ingestion) {
+	return func(i *ingestion) {
+		i.batchSize = n
+	}
+}
+
+// FlushInterval lets Ingest add the collected edges to the graph at least once
+// per interval, even if the batch isn't full yet. This keeps the graph up to
+// date if the edges arrive slowly. By default, a batch is only added once it's
+// full or the channel has been closed.
+func FlushInterval(d time.Duration) func(*ingestion) {
+	return func(i *ingestion) {
+		i.flushInterval = d
+	}
+}
+
+// WithIngestStats registers a function that is called with the current stats
+// after each batch that has been added to the graph, which is useful for
+// monitoring the throughput and the backlog of a long-running ingestion.
+func WithIngestStats(report func(stats IngestStats)) func(*ingestion) {
+	return func(i *ingestion) {
+		i.report = report
+	}
+}
+
+// Ingest adds the edges received from the given channel to the graph until the
+// channel is closed or the context is cancelled. This is useful for building a
+// graph from a continuous stream of edges, for instance edges derived from log
+// entries:
+//
+//	edges := make(chan graph.Edge[string], 1024)
+//	go parseLogs(logs, edges)
+//
+//	stats, err := graph.Ingest(ctx, g, edges, graph.WithIngestStats(func(stats graph.IngestStats) {
+//		log.Printf("%.0f edges/s, backlog: %d", stats.Throughput(), stats.Backlog)
+//	}))
+//
+// The edges are collected in batches, each of which is added using
+// [Graph.AddEdges]. The size of the batches can be set using [BatchSize], and
+// [FlushInterval] makes sure that slowly arriving edges are added regularly.
+// Edges that already exist in the graph or that have been received before are
+// skipped and counted as duplicates, so the stream may contain the same edge
+// multiple times. In an undirected graph, (A,B) and (B,A) are the same edge.
+//
+// Source and target vertices must exist in the graph, unless the graph has been
+// created using [AutoVertices]. If a batch can't be added, Ingest stops and
+// returns the error. If the context is cancelled, the edges received so far are
+// added and the error of the context is returned. The returned stats are those
+// of the entire ingestion, also in case of an error.
+func Ingest[K comparable, T any](ctx context.Context, g Graph[K, T], edges <-chan Edge[K], options ...func(*ingestion)) (IngestStats, error) {
+	in := newIngester(g, options)
+
+	var flush <-chan time.Time
+
+	if in.flushInterval > 0 {
+		ticker := time.NewTicker(in.flushInterval)
+		defer ticker.Stop()
+		flush = ticker.C
+	}
+
+	for {
+		select {
+		case edge, ok := <-edges:
+			if !ok {
+				err := in.flush(0)
+				return in.stats(), err
+			}
+
+			if err := in.add(edge, len(edges)); err != nil {
+				return in.stats(), err
+			}
+		case <-flush:
+			if err := in.flush(len(edges)); err != nil {
+				return in.stats(), err
+			}
+		case <-ctx.Done():
+			if err := in.flush(len(edges)); err != nil {
+				return in.stats(), err
+			}
+			return in.stats(), ctx.Err()
+		}
+	}
+}
+
+// IngestReader reads edges line by line from the given reader and adds them to
+// the graph just like Ingest does. Each non-empty line is converted into an
+// edge using the given parse function:
+//
+//	// Each line contains the source and target of an edge.
+//	stats, err := graph.IngestReader(ctx, g, file, func(line string) (graph.Edge[string], error) {
+//		fields := strings.Fields(line)
+//		if len(fields) != 2 {
+//			return graph.Edge[string]{}, fmt.Errorf("expected 2 fields, got %d", len(fields))
+//		}
+//		return graph.Edge[string]{Source: fields[0], Target: fields[1]}, nil
+//	})
+//
+// If parse returns an error, IngestReader stops and returns the error along with
+// the line number. Lines may be at most 64 KiB long.
+func IngestReader[K comparable, T any](ctx context.Context, g Graph[K, T], r io.Reader, parse func(line string) (Edge[K], error), options ...func(*ingestion)) (IngestStats, error) {
+	in := newIngester(g, options)
+	scanner := bufio.NewScanner(r)
+	c := cancellation{ctx: ctx}
+
+	for line := 1; scanner.Scan(); line++ {
+		if err := c.check(); err != nil {
+			if flushErr := in.flush(0); flushErr != nil {
+				return in.stats(), flushErr
+			}
+			return in.stats(), err
+		}
+
+		text := scanner.Text()
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		edge, err := parse(text)
+		if err != nil {
+			return in.stats(), fmt.Errorf("failed to parse line %d: %w", line, err)
+		}
+
+		if err := in.add(edge, 0); err != nil {
+			return in.stats(), err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return in.stats(), fmt.Errorf("failed to read edges: %w", err)
+	}
+
+	err := in.flush(0)
+	return in.stats(), err
+}
+
+// ingester collects the edges of Ingest and IngestReader in batches and adds
+// them to the graph.
+type ingester[K comparable, T any] struct {
+	ingestion
+	graph      Graph[K, T]
+	isDirected bool
+	start      time.Time
+	batch      []Edge[K]
+	// pending contains the keys of the edges in the current batch. In an
+	// undirected graph, the keys are stored in both directions.
+	pending map[EdgeKey[K]]struct{}
+	current IngestStats
+}
+
+func newIngester[K comparable, T any](g Graph[K, T], options []func(*ingestion)) *ingester[K, T] {
+	in := &ingester[K, T]{
+		ingestion: ingestion{
+			batchSize: defaultBatchSize,
+		},
+		graph:      g,
+		isDirected: g.Traits().IsDirected,
+		start:      time.Now(),
+		pending:    make(map[EdgeKey[K]]struct{}),
+	}
+
+	for _, option := range options {
+		option(&in.ingestion)
+	}
+
+	if in.batchSize < 1 {
+		in.batchSize = 1
+	}
+
+	in.batch = make([]Edge[K], 0, in.batchSize)
+
+	return in
+}
+
+// add adds the given edge to the current batch unless it is a duplicate, and
+// adds the batch to the graph once it is full. backlog is the number of edges
+// that are waiting to be received.
+func (in *ingester[K, T]) add(edge Edge[K], backlog int) error {
+	in.current.Received++
+
+	key := EdgeKey[K]{Source: edge.Source, Target: edge.Target}
+
+	if _, ok := in.pending[key]; ok {
+		in.current.Duplicates++
+		return nil
+	}
+
+	if _, err := in.graph.Edge(edge.Source, edge.Target); err == nil {
+		in.current.Duplicates++
+		return nil
+	}
+
+	in.pending[key] = struct{}{}
+	if !in.isDirected {
+		in.pending[EdgeKey[K]{Source: edge.Target, Target: edge.Source}] = struct{}{}
+	}
+
+	in.batch = append(in.batch, edge)
+
+	if len(in.batch) < in.batchSize {
+		return nil
+	}
+
+	return in.flush(backlog)
+}
+
+// flush adds the current batch to the graph and reports the stats.
+func (in *ingester[K, T]) flush(backlog int) error {
+	if len(in.batch) == 0 {
+		return nil
+	}
+
+	if err := in.graph.AddEdges(in.batch); err != nil {
+		return fmt.Errorf("failed to add batch %d: %w", in.current.Batches+1, err)
+	}
+
+	in.current.Added += len(in.batch)
+	in.current.Batches++
+	in.current.Backlog = backlog
+
+	in.batch = in.batch[:0]
+
+	for key := range in.pending {
+		delete(in.pending, key)
+	}
+
+	if in.report != nil {
+		in.report(in.stats())
+	}
+
+	return nil
+}
+
+// stats returns the current stats of the ingestion.
+func (in *ingester[K, T]) stats() IngestStats {
+	stats := in.current
+	stats.Elapsed = time.Since(in.start)
+	return stats
+}
@@ -0,0 +1,211 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIngest(t *testing.T) {
+	tests := map[string]struct {
+		traits             []func(*Traits)
+		batchSize          int
+		edges              [][2]int
+		expectedAdded      int
+		expectedDuplicates int
+		expectedBatches    int
+	}{
+		"directed graph": {
+			traits:          []func(*Traits){Directed()},
+			batchSize:       2,
+			edges:           [][2]int{{1, 2}, {2, 3}, {3, 1}, {2, 1}},
+			expectedAdded:   4,
+			expectedBatches: 2,
+		},
+		"undirected graph": {
+			batchSize:          10,
+			edges:              [][2]int{{1, 2}, {2, 1}, {2, 3}},
+			expectedAdded:      2,
+			expectedDuplicates: 1,
+			expectedBatches:    1,
+		},
+		"duplicates across batches": {
+			traits:             []func(*Traits){Directed()},
+			batchSize:          1,
+			edges:              [][2]int{{1, 2}, {1, 2}, {2, 3}, {1, 2}},
+			expectedAdded:      2,
+			expectedDuplicates: 2,
+			expectedBatches:    2,
+		},
+		"no edges": {
+			batchSize: 10,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range []int{1, 2, 3} {
+				_ = g.AddVertex(vertex)
+			}
+
+			edges := make(chan Edge[int], len(test.edges))
+
+			for _, edge := range test.edges {
+				edges <- Edge[int]{Source: edge[0], Target: edge[1]}
+			}
+
+			close(edges)
+
+			reports := 0
+
+			stats, err := Ingest(context.Background(), g, edges, BatchSize(test.batchSize), WithIngestStats(func(IngestStats) {
+				reports++
+			}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if stats.Received != len(test.edges) {
+				t.Errorf("expected %d received edges, got %d", len(test.edges), stats.Received)
+			}
+
+			if stats.Added != test.expectedAdded {
+				t.Errorf("expected %d added edges, got %d", test.expectedAdded, stats.Added)
+			}
+
+			if stats.Duplicates != test.expectedDuplicates {
+				t.Errorf("expected %d duplicates, got %d", test.expectedDuplicates, stats.Duplicates)
+			}
+
+			if stats.Batches != test.expectedBatches || reports != test.expectedBatches {
+				t.Errorf("expected %d batches and reports, got %d and %d", test.expectedBatches, stats.Batches, reports)
+			}
+
+			if size, _ := g.Size(); size != test.expectedAdded {
+				t.Errorf("expected size %d, got %d", test.expectedAdded, size)
+			}
+		})
+	}
+}
+
+func TestIngest_flushInterval(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+
+	edges := make(chan Edge[int])
+	flushed := make(chan IngestStats, 1)
+
+	go func() {
+		edges <- Edge[int]{Source: 1, Target: 2}
+		// The edge has to be added without waiting for more edges.
+		<-flushed
+		close(edges)
+	}()
+
+	stats, err := Ingest(context.Background(), g, edges, FlushInterval(time.Millisecond), WithIngestStats(func(stats IngestStats) {
+		flushed <- stats
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stats.Added != 1 || stats.Batches != 1 {
+		t.Errorf("expected 1 edge in 1 batch, got %d in %d", stats.Added, stats.Batches)
+	}
+}
+
+func TestIngest_cancelled(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+
+	edges := make(chan Edge[int], 1)
+	edges <- Edge[int]{Source: 1, Target: 2}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		// Wait until the edge has been received.
+		for len(edges) > 0 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+	}()
+
+	stats, err := Ingest(ctx, g, edges)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error %v, got %v", context.Canceled, err)
+	}
+
+	if stats.Added != 1 {
+		t.Errorf("expected received edge to be added, got %d added edges", stats.Added)
+	}
+}
+
+func TestIngest_missingVertex(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1)
+
+	edges := make(chan Edge[int], 1)
+	edges <- Edge[int]{Source: 1, Target: 2}
+	close(edges)
+
+	if _, err := Ingest(context.Background(), g, edges); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+}
+
+func TestIngestReader(t *testing.T) {
+	tests := map[string]struct {
+		input         string
+		expectedAdded int
+		shouldFail    bool
+	}{
+		"edge list": {
+			input:         "A B\nB C\n\nA B\nC A\n",
+			expectedAdded: 3,
+		},
+		"invalid line": {
+			input:         "A B\nB\n",
+			expectedAdded: 0,
+			shouldFail:    true,
+		},
+	}
+
+	parse := func(line string) (Edge[string], error) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return Edge[string]{}, fmt.Errorf("expected 2 fields, got %d", len(fields))
+		}
+		return Edge[string]{Source: fields[0], Target: fields[1]}, nil
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, Directed(), AutoVertices(func(hash string) string { return hash }))
+
+			stats, err := IngestReader(context.Background(), g, strings.NewReader(test.input), parse)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if stats.Added != test.expectedAdded {
+				t.Errorf("expected %d added edges, got %d", test.expectedAdded, stats.Added)
+			}
+
+			if order, _ := g.Order(); !test.shouldFail && order != 3 {
+				t.Errorf("expected order 3, got %d", order)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// IsIsomorphic determines whether the graphs g and h are isomorphic, that is,
+// whether there is a one-to-one mapping between their vertices that preserves
+// all edges. If the graphs are isomorphic, IsIsomorphic returns true along with
+// a mapping from the vertex hashes of g to the vertex hashes of h.
+//
+// Only the structure of the graphs is compared: vertex values, weights, and
+// other properties don't affect the result. Self-loops are taken into account.
+// A directed graph is never isomorphic to an undirected graph.
+//
+// IsIsomorphic first compares graph invariants like the number of vertices and
+// the degree sequences, which allows to reject most non-isomorphic graphs fast.
+// If all invariants match, it searches for a mapping using the VF2 algorithm,
+// pruning candidate pairs whose degrees or connections to already mapped
+// vertices don't match. In the worst case, the search takes exponential time.
+func IsIsomorphic[K comparable, T any](g, h Graph[K, T]) (bool, map[K]K, error) {
+	if g.Traits().IsDirected != h.Traits().IsDirected {
+		return false, nil, nil
+	}
+
+	state, err := newIsomorphismState(g, h)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if !state.invariantsMatch() {
+		return false, nil, nil
+	}
+
+	state.computeOrder()
+
+	if !state.match(0) {
+		return false, nil, nil
+	}
+
+	return true, state.core, nil
+}
+
+type isomorphismState[K comparable] struct {
+	isDirected bool
+	gOut, gIn  map[K]map[K]Edge[K]
+	hOut, hIn  map[K]map[K]Edge[K]
+
+	// order is the order in which the vertices of g are mapped. For each vertex
+	// in order, parents contains a previously mapped neighbor, if any, which is
+	// used to restrict the candidates in h to the neighbors of its counterpart.
+	order   []K
+	parents map[K]isomorphismParent[K]
+
+	// core maps the vertices of g to the vertices of h, and reverse maps the
+	// vertices of h back to the vertices of g.
+	core    map[K]K
+	reverse map[K]K
+}
+
+type isomorphismParent[K comparable] struct {
+	vertex K
+	// outgoing indicates whether the vertex is an adjacency of its parent, as
+	// opposed to a predecessor of its parent.
+	outgoing bool
+}
+
+func newIsomorphismState[K comparable, T any](g, h Graph[K, T]) (*isomorphismState[K], error) {
+	gOut, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	gIn, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	hOut, err := h.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	hIn, err := h.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	return &isomorphismState[K]{
+		isDirected: g.Traits().IsDirected,
+		gOut:       gOut,
+		gIn:        gIn,
+		hOut:       hOut,
+		hIn:        hIn,
+		parents:    make(map[K]isomorphismParent[K], len(gOut)),
+		core:       make(map[K]K, len(gOut)),
+		reverse:    make(map[K]K, len(gOut)),
+	}, nil
+}
+
+// invariantsMatch compares the number of vertices and the sorted sequences of
+// vertex signatures, consisting of in-degree, out-degree, and self-loop, of
+// both graphs.
+func (s *isomorphismState[K]) invariantsMatch() bool {
+	if len(s.gOut) != len(s.hOut) {
+		return false
+	}
+
+	gSignatures := signatures(s.gOut, s.gIn)
+	hSignatures := signatures(s.hOut, s.hIn)
+
+	for i := range gSignatures {
+		if gSignatures[i] != hSignatures[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+type vertexSignature struct {
+	inDegree  int
+	outDegree int
+	selfLoop  bool
+}
+
+func signatures[K comparable](out, in map[K]map[K]Edge[K]) []vertexSignature {
+	result := make([]vertexSignature, 0, len(out))
+
+	for vertex, adjacencies := range out {
+		_, selfLoop := adjacencies[vertex]
+
+		result = append(result, vertexSignature{
+			inDegree:  len(in[vertex]),
+			outDegree: len(adjacencies),
+			selfLoop:  selfLoop,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.inDegree != b.inDegree {
+			return a.inDegree < b.inDegree
+		}
+		if a.outDegree != b.outDegree {
+			return a.outDegree < b.outDegree
+		}
+		return !a.selfLoop && b.selfLoop
+	})
+
+	return result
+}
+
+// computeOrder determines the order in which the vertices of g are mapped. It
+// performs a BFS for each connected component, starting at the vertex with the
+// highest degree, so that each vertex except the first one of a component has
+// an already mapped neighbor.
+func (s *isomorphismState[K]) computeOrder() {
+	vertices := make([]K, 0, len(s.gOut))
+	for vertex := range s.gOut {
+		vertices = append(vertices, vertex)
+	}
+
+	degree := func(vertex K) int {
+		return len(s.gOut[vertex]) + len(s.gIn[vertex])
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return degree(vertices[i]) > degree(vertices[j])
+	})
+
+	s.order = make([]K, 0, len(vertices))
+	visited := make(map[K]struct{}, len(vertices))
+
+	for _, start := range vertices {
+		if _, ok := visited[start]; ok {
+			continue
+		}
+
+		visited[start] = struct{}{}
+		queue := []K{start}
+
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			s.order = append(s.order, current)
+
+			for adjacency := range s.gOut[current] {
+				if _, ok := visited[adjacency]; !ok {
+					visited[adjacency] = struct{}{}
+					s.parents[adjacency] = isomorphismParent[K]{vertex: current, outgoing: true}
+					queue = append(queue, adjacency)
+				}
+			}
+
+			for predecessor := range s.gIn[current] {
+				if _, ok := visited[predecessor]; !ok {
+					visited[predecessor] = struct{}{}
+					s.parents[predecessor] = isomorphismParent[K]{vertex: current, outgoing: false}
+					queue = append(queue, predecessor)
+				}
+			}
+		}
+	}
+}
+
+// match tries to extend the current partial mapping by mapping the vertex at
+// the given depth of the order. It returns true if a complete mapping could be
+// found.
+func (s *isomorphismState[K]) match(depth int) bool {
+	if depth == len(s.order) {
+		return true
+	}
+
+	u := s.order[depth]
+
+	if parent, ok := s.parents[u]; ok {
+		// u has to be mapped to a neighbor of the vertex its parent is mapped to.
+		counterpart := s.core[parent.vertex]
+		neighbors := s.hIn[counterpart]
+		if parent.outgoing {
+			neighbors = s.hOut[counterpart]
+		}
+
+		for v := range neighbors {
+			if s.tryPair(u, v, depth) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// u is the first vertex of a connected component and may be mapped to any
+	// vertex of h.
+	for v := range s.hOut {
+		if s.tryPair(u, v, depth) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *isomorphismState[K]) tryPair(u, v K, depth int) bool {
+	if _, ok := s.reverse[v]; ok {
+		return false
+	}
+
+	if !s.isFeasible(u, v) {
+		return false
+	}
+
+	s.core[u] = v
+	s.reverse[v] = u
+
+	if s.match(depth + 1) {
+		return true
+	}
+
+	delete(s.core, u)
+	delete(s.reverse, v)
+
+	return false
+}
+
+// isFeasible checks whether u from g can be mapped to v from h given the
+// current partial mapping.
+func (s *isomorphismState[K]) isFeasible(u, v K) bool {
+	if len(s.gOut[u]) != len(s.hOut[v]) || len(s.gIn[u]) != len(s.hIn[v]) {
+		return false
+	}
+
+	_, gSelfLoop := s.gOut[u][u]
+	_, hSelfLoop := s.hOut[v][v]
+
+	if gSelfLoop != hSelfLoop {
+		return false
+	}
+
+	if !s.neighborsAreConsistent(u, v, s.gOut, s.hOut) {
+		return false
+	}
+
+	if s.isDirected && !s.neighborsAreConsistent(u, v, s.gIn, s.hIn) {
+		return false
+	}
+
+	return true
+}
+
+// neighborsAreConsistent checks whether each mapped neighbor of u is mapped to
+// a neighbor of v, and whether v has no additional mapped neighbors.
+func (s *isomorphismState[K]) neighborsAreConsistent(u, v K, gNeighbors, hNeighbors map[K]map[K]Edge[K]) bool {
+	gMapped := 0
+
+	for w := range gNeighbors[u] {
+		if w == u {
+			continue
+		}
+
+		counterpart, ok := s.core[w]
+		if !ok {
+			continue
+		}
+
+		if _, ok := hNeighbors[v][counterpart]; !ok {
+			return false
+		}
+
+		gMapped++
+	}
+
+	hMapped := 0
+
+	for x := range hNeighbors[v] {
+		if x == v {
+			continue
+		}
+
+		if _, ok := s.reverse[x]; ok {
+			hMapped++
+		}
+	}
+
+	return gMapped == hMapped
+}
@@ -0,0 +1,246 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestIsIsomorphic(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		gVertices  []int
+		gEdges     []Edge[int]
+		hVertices  []int
+		hEdges     []Edge[int]
+		expected   bool
+	}{
+		"relabeled path": {
+			gVertices: []int{1, 2, 3, 4},
+			gEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			hVertices: []int{10, 20, 30, 40},
+			hEdges: []Edge[int]{
+				{Source: 30, Target: 10},
+				{Source: 10, Target: 40},
+				{Source: 40, Target: 20},
+			},
+			expected: true,
+		},
+		"cycle and two triangles": {
+			gVertices: []int{1, 2, 3, 4, 5, 6},
+			gEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 1},
+			},
+			hVertices: []int{1, 2, 3, 4, 5, 6},
+			hEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 6},
+				{Source: 6, Target: 4},
+			},
+			expected: false,
+		},
+		"different degree sequences": {
+			gVertices: []int{1, 2, 3, 4},
+			gEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			hVertices: []int{1, 2, 3, 4},
+			hEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expected: false,
+		},
+		"different orders": {
+			gVertices: []int{1, 2},
+			hVertices: []int{1, 2, 3},
+			expected:  false,
+		},
+		"directed graphs": {
+			isDirected: true,
+			gVertices:  []int{1, 2, 3},
+			gEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+			hVertices: []int{1, 2, 3},
+			hEdges: []Edge[int]{
+				{Source: 3, Target: 1},
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 2},
+			},
+			expected: true,
+		},
+		"directed cycle and transitive triangle": {
+			isDirected: true,
+			gVertices:  []int{1, 2, 3},
+			gEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			hVertices: []int{1, 2, 3},
+			hEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+			expected: false,
+		},
+		"self-loops": {
+			gVertices: []int{1, 2},
+			gEdges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+			},
+			hVertices: []int{1, 2},
+			hEdges: []Edge[int]{
+				{Source: 2, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expected: true,
+		},
+		"empty graphs": {
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newIsomorphismGraph(test.isDirected, test.gVertices, test.gEdges)
+			h := newIsomorphismGraph(test.isDirected, test.hVertices, test.hEdges)
+
+			isomorphic, mapping, err := IsIsomorphic(g, h)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if isomorphic != test.expected {
+				t.Fatalf("expected isomorphic to be %v, got %v", test.expected, isomorphic)
+			}
+
+			if isomorphic {
+				assertIsomorphism(t, g, h, mapping)
+			}
+		})
+	}
+}
+
+func TestIsIsomorphic_Petersen(t *testing.T) {
+	g := New(IntHash)
+	h := New(IntHash)
+
+	for i := 0; i < 10; i++ {
+		_ = g.AddVertex(i)
+		_ = h.AddVertex(i)
+	}
+
+	// The Petersen graph as an outer pentagon connected to an inner pentagram.
+	for i := 0; i < 5; i++ {
+		_ = g.AddEdge(i, (i+1)%5)
+		_ = g.AddEdge(i, i+5)
+		_ = g.AddEdge(i+5, (i+2)%5+5)
+	}
+
+	// The Petersen graph as the Kneser graph KG(5,2): The vertices are all
+	// 2-subsets of {0, ..., 4}, and two vertices are adjacent if their subsets
+	// are disjoint.
+	subsets := make([][2]int, 0, 10)
+	for a := 0; a < 5; a++ {
+		for b := a + 1; b < 5; b++ {
+			subsets = append(subsets, [2]int{a, b})
+		}
+	}
+
+	for i := range subsets {
+		for j := i + 1; j < len(subsets); j++ {
+			a, b := subsets[i], subsets[j]
+			if a[0] != b[0] && a[0] != b[1] && a[1] != b[0] && a[1] != b[1] {
+				_ = h.AddEdge(i, j)
+			}
+		}
+	}
+
+	isomorphic, mapping, err := IsIsomorphic(g, h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !isomorphic {
+		t.Fatalf("expected both representations of the Petersen graph to be isomorphic")
+	}
+
+	assertIsomorphism(t, g, h, mapping)
+}
+
+func TestIsIsomorphic_DifferentDirectedness(t *testing.T) {
+	g := New(IntHash, Directed())
+	h := New(IntHash)
+
+	isomorphic, _, err := IsIsomorphic(g, h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if isomorphic {
+		t.Errorf("expected directed and undirected graph not to be isomorphic")
+	}
+}
+
+func newIsomorphismGraph(isDirected bool, vertices []int, edges []Edge[int]) Graph[int, int] {
+	var g Graph[int, int]
+
+	if isDirected {
+		g = New(IntHash, Directed())
+	} else {
+		g = New(IntHash)
+	}
+
+	for _, vertex := range vertices {
+		_ = g.AddVertex(vertex)
+	}
+
+	for _, edge := range edges {
+		_ = g.AddEdge(copyEdge(edge))
+	}
+
+	return g
+}
+
+func assertIsomorphism(t *testing.T, g, h Graph[int, int], mapping map[int]int) {
+	order, _ := g.Order()
+	if len(mapping) != order {
+		t.Fatalf("expected mapping of %d vertices, got %v", order, mapping)
+	}
+
+	mapped := make(map[int]struct{})
+	for _, v := range mapping {
+		mapped[v] = struct{}{}
+	}
+
+	if len(mapped) != order {
+		t.Fatalf("expected a one-to-one mapping, got %v", mapping)
+	}
+
+	edges, _ := g.Edges()
+
+	for _, edge := range edges {
+		if _, err := h.Edge(mapping[edge.Source], mapping[edge.Target]); err != nil {
+			t.Errorf("edge (%d, %d) is not preserved by mapping %v", edge.Source, edge.Target, mapping)
+		}
+	}
+}
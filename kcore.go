@@ -0,0 +1,117 @@
+package graph
+
+import "fmt"
+
+// CoreNumbers computes the core number of each vertex of the given graph. The
+// k-core of a graph is the largest subgraph in which every vertex has a degree
+// of at least k, and the core number of a vertex is the largest k for which the
+// vertex is contained in the k-core.
+//
+// The degrees are determined regardless of the edge direction, and self-loops
+// are ignored. In a directed graph, the edges (A,B) and (B,A) count as a single
+// edge. CoreNumbers uses the algorithm of Batagelj and Zaversnik, which repeatedly
+// removes a vertex with the smallest degree and takes O(|V|+|E|) time.
+func CoreNumbers[K comparable, T any](g Graph[K, T]) (map[K]int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	hashes, neighbors := undirectedNeighbors(adjacencyMap)
+	n := len(hashes)
+
+	degrees := make([]int, n)
+	maxDegree := 0
+
+	for vertex, adjacencies := range neighbors {
+		degrees[vertex] = len(adjacencies)
+		if degrees[vertex] > maxDegree {
+			maxDegree = degrees[vertex]
+		}
+	}
+
+	// The vertices are kept in an array sorted by their current degree, where
+	// bins[d] is the position of the first vertex with degree d. Decrementing
+	// the degree of a vertex moves it to the start of its bin, which then
+	// becomes part of the preceding bin.
+	bins := make([]int, maxDegree+1)
+	for _, degree := range degrees {
+		bins[degree]++
+	}
+
+	start := 0
+	for degree, count := range bins {
+		bins[degree] = start
+		start += count
+	}
+
+	vertices := make([]int, n)
+	positions := make([]int, n)
+
+	for vertex, degree := range degrees {
+		positions[vertex] = bins[degree]
+		vertices[positions[vertex]] = vertex
+		bins[degree]++
+	}
+
+	for degree := maxDegree; degree > 0; degree-- {
+		bins[degree] = bins[degree-1]
+	}
+	if n > 0 {
+		bins[0] = 0
+	}
+
+	for _, vertex := range vertices {
+		for _, adjacency := range neighbors[vertex] {
+			if degrees[adjacency] <= degrees[vertex] {
+				continue
+			}
+
+			degree := degrees[adjacency]
+			position := positions[adjacency]
+			first := bins[degree]
+
+			if other := vertices[first]; other != adjacency {
+				vertices[position], vertices[first] = other, adjacency
+				positions[adjacency], positions[other] = first, position
+			}
+
+			bins[degree]++
+			degrees[adjacency]--
+		}
+	}
+
+	coreNumbers := make(map[K]int, n)
+
+	for vertex, hash := range hashes {
+		coreNumbers[hash] = degrees[vertex]
+	}
+
+	return coreNumbers, nil
+}
+
+// KCore returns the k-core of the given graph, which is the largest subgraph in
+// which every vertex has a degree of at least k. It consists of all vertices
+// whose core number as computed by [CoreNumbers] is at least k, along with all
+// edges between them. Computing the k-core peels away the sparsely connected
+// periphery of a graph and leaves its densely connected core.
+//
+// The returned graph has the same traits as g and contains the vertices and
+// edges with their properties. If no vertex has a core number of at least k,
+// the returned graph is empty.
+func KCore[K comparable, T any](g Graph[K, T], k int) (Graph[K, T], error) {
+	coreNumbers, err := CoreNumbers(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute core numbers: %w", err)
+	}
+
+	vertices := make(map[K]struct{})
+
+	for vertex, coreNumber := range coreNumbers {
+		if coreNumber >= k {
+			vertices[vertex] = struct{}{}
+		}
+	}
+
+	return inducedSubgraph(g, vertices)
+}
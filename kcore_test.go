@@ -0,0 +1,174 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCoreNumbers(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		vertices   []int
+		edges      []Edge[int]
+		expected   map[int]int
+	}{
+		"empty graph": {
+			expected: map[int]int{},
+		},
+		"clique with tail": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 5},
+				{Source: 5, Target: 5},
+			},
+			expected: map[int]int{1: 3, 2: 3, 3: 3, 4: 3, 5: 1, 6: 0},
+		},
+		"directed cycle with mutual edge": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+			},
+			expected: map[int]int{1: 2, 2: 2, 3: 2, 4: 1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target)
+			}
+
+			coreNumbers, err := CoreNumbers(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(coreNumbers) != len(test.expected) {
+				t.Fatalf("expected core numbers %v, got %v", test.expected, coreNumbers)
+			}
+
+			for vertex, expected := range test.expected {
+				if coreNumbers[vertex] != expected {
+					t.Errorf("expected core number %d for vertex %d, got %d", expected, vertex, coreNumbers[vertex])
+				}
+			}
+		})
+	}
+}
+
+func TestCoreNumbers_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	g := New(IntHash)
+
+	for vertex := 0; vertex < 60; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	for i := 0; i < 250; i++ {
+		_ = g.AddEdge(random.Intn(60), random.Intn(60))
+	}
+
+	coreNumbers, err := CoreNumbers(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	// Compute the k-cores naively by repeatedly removing all vertices with a
+	// degree smaller than k.
+	for k := 0; k <= 10; k++ {
+		remaining := make(map[int]bool)
+		for vertex := range adjacencyMap {
+			remaining[vertex] = true
+		}
+
+		for changed := true; changed; {
+			changed = false
+
+			for vertex := range remaining {
+				degree := 0
+				for adjacency := range adjacencyMap[vertex] {
+					if adjacency != vertex && remaining[adjacency] {
+						degree++
+					}
+				}
+
+				if degree < k {
+					delete(remaining, vertex)
+					changed = true
+				}
+			}
+		}
+
+		for vertex := range adjacencyMap {
+			if remaining[vertex] != (coreNumbers[vertex] >= k) {
+				t.Fatalf("vertex %d with core number %d: expected to be in %d-core: %v", vertex, coreNumbers[vertex], k, remaining[vertex])
+			}
+		}
+	}
+}
+
+func TestKCore(t *testing.T) {
+	g := New(IntHash, Weighted())
+
+	for _, vertex := range []int{1, 2, 3, 4, 5} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2, EdgeWeight(1))
+	_ = g.AddEdge(2, 3, EdgeWeight(2))
+	_ = g.AddEdge(3, 1, EdgeWeight(3))
+	_ = g.AddEdge(3, 4, EdgeWeight(4))
+	_ = g.AddEdge(4, 5, EdgeWeight(5))
+
+	core, err := KCore(g, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if order, _ := core.Order(); order != 3 {
+		t.Errorf("expected 3 vertices, got %d", order)
+	}
+
+	if size, _ := core.Size(); size != 3 {
+		t.Errorf("expected 3 edges, got %d", size)
+	}
+
+	if edge, err := core.Edge(1, 3); err != nil || edge.Properties.Weight != 3 {
+		t.Errorf("expected edge (1, 3) with weight 3, got %v, %v", edge, err)
+	}
+
+	if !core.Traits().IsWeighted {
+		t.Errorf("expected weighted graph")
+	}
+
+	empty, err := KCore(g, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if order, _ := empty.Order(); order != 0 {
+		t.Errorf("expected empty graph, got %d vertices", order)
+	}
+}
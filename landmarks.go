@@ -0,0 +1,185 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Landmarks contains the precomputed distances between a few selected landmark
+// vertices and all other vertices. They provide a heuristic for A* searches
+// that speeds up shortest path queries without changing the graph, which is
+// known as the ALT algorithm (A*, landmarks, triangle inequality):
+//
+//	landmarks, _ := graph.NewLandmarks(g, 8)
+//
+//	path, _ := graph.ShortestPath(g, "A", "B", graph.WithHeuristic(landmarks.Heuristic("B")))
+//
+// The landmarks reflect the state of the graph at the time they have been
+// computed, and they have to be computed again after the edges of the graph
+// have changed. Otherwise, the heuristic may overestimate distances.
+type Landmarks[K comparable] struct {
+	landmarks []K
+	// from contains the distances from each landmark to all vertices, and to
+	// contains the distances from all vertices to each landmark. For
+	// undirected graphs, both are the same.
+	from []map[K]float64
+	to   []map[K]float64
+}
+
+// NewLandmarks selects the given number of landmarks in the graph and computes
+// their distances to all other vertices. Like in [ShortestPath], each edge of
+// an unweighted graph has a weight of 1. Negative edge weights are rejected
+// with an error.
+//
+// The landmarks are selected using the farthest heuristic: Each landmark is the
+// vertex with the largest distance to all landmarks selected before, which
+// spreads the landmarks around the periphery of the graph. Vertices that can't
+// be reached from any landmark so far are preferred, so that each connected
+// part of the graph receives a landmark. The first landmark is the vertex that
+// is farthest away from an arbitrary vertex.
+//
+// NewLandmarks runs two searches per landmark and stores two distances per
+// landmark and vertex. A few landmarks, typically between 4 and 16, are
+// sufficient for a good heuristic.
+func NewLandmarks[K comparable, T any](g Graph[K, T], count int) (*Landmarks[K], error) {
+	if count < 1 {
+		return nil, errors.New("number of landmarks must be at least 1")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	predecessorMap := adjacencyMap
+
+	if g.Traits().IsDirected {
+		if predecessorMap, err = g.PredecessorMap(); err != nil {
+			return nil, fmt.Errorf("could not get predecessor map: %w", err)
+		}
+	}
+
+	l := &Landmarks[K]{
+		landmarks: make([]K, 0, count),
+		from:      make([]map[K]float64, 0, count),
+		to:        make([]map[K]float64, 0, count),
+	}
+
+	if len(adjacencyMap) == 0 {
+		return l, nil
+	}
+
+	var start K
+	for start = range adjacencyMap {
+		break
+	}
+
+	paths, err := shortestPathTree(g, adjacencyMap, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute shortest paths from vertex %v: %w", start, err)
+	}
+
+	// closest contains the distance between each vertex and its closest
+	// landmark, considering both directions. For selecting the first landmark,
+	// the arbitrary start vertex acts as a landmark.
+	closest := make(map[K]float64, len(adjacencyMap))
+
+	for vertex := range adjacencyMap {
+		closest[vertex] = math.Inf(1)
+	}
+
+	update := func(distances map[K]float64) {
+		for vertex, distance := range distances {
+			if distance < closest[vertex] {
+				closest[vertex] = distance
+			}
+		}
+	}
+
+	update(paths.distances)
+
+	for len(l.landmarks) < count && len(l.landmarks) < len(adjacencyMap) {
+		var landmark K
+
+		largest := math.Inf(-1)
+
+		for vertex, distance := range closest {
+			if distance > largest {
+				landmark, largest = vertex, distance
+			}
+		}
+
+		from, err := shortestPathTree(g, adjacencyMap, landmark)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute shortest paths from vertex %v: %w", landmark, err)
+		}
+
+		// Running the search on the predecessor map follows the edges in
+		// reverse, which yields the distances to the landmark.
+		to := from
+
+		if g.Traits().IsDirected {
+			if to, err = shortestPathTree(g, predecessorMap, landmark); err != nil {
+				return nil, fmt.Errorf("failed to compute shortest paths to vertex %v: %w", landmark, err)
+			}
+		}
+
+		if len(l.landmarks) == 0 {
+			for vertex := range closest {
+				closest[vertex] = math.Inf(1)
+			}
+		}
+
+		l.landmarks = append(l.landmarks, landmark)
+		l.from = append(l.from, from.distances)
+		l.to = append(l.to, to.distances)
+
+		update(from.distances)
+		update(to.distances)
+
+		// Other vertices might have a distance of 0 to the landmark as well,
+		// but the landmark itself must never be selected twice.
+		closest[landmark] = math.Inf(-1)
+	}
+
+	return l, nil
+}
+
+// Landmarks returns the hashes of the selected landmarks in the order they have
+// been selected.
+func (l *Landmarks[K]) Landmarks() []K {
+	landmarks := make([]K, len(l.landmarks))
+	copy(landmarks, l.landmarks)
+
+	return landmarks
+}
+
+// Heuristic returns a heuristic that estimates the distance from a vertex to
+// the given target, which can be passed to a path query using [WithHeuristic].
+//
+// By the triangle inequality, the distance from a vertex v to the target t is
+// at least d(L,t)-d(L,v) and d(v,L)-d(t,L) for each landmark L. The heuristic
+// returns the largest of these lower bounds, so it never overestimates the
+// distance. For a vertex that isn't contained in the landmarks, it returns 0.
+func (l *Landmarks[K]) Heuristic(target K) func(K) float64 {
+	return func(vertex K) float64 {
+		estimate := 0.0
+
+		for i := range l.landmarks {
+			if toTarget, ok := l.from[i][target]; ok {
+				if toVertex, ok := l.from[i][vertex]; ok && toTarget-toVertex > estimate {
+					estimate = toTarget - toVertex
+				}
+			}
+
+			if fromTarget, ok := l.to[i][target]; ok {
+				if fromVertex, ok := l.to[i][vertex]; ok && fromVertex-fromTarget > estimate {
+					estimate = fromVertex - fromTarget
+				}
+			}
+		}
+
+		return estimate
+	}
+}
@@ -0,0 +1,156 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestNewLandmarks(t *testing.T) {
+	tests := map[string]struct {
+		isDirected    bool
+		count         int
+		expectedCount int
+		shouldFail    bool
+	}{
+		"directed graph": {
+			isDirected:    true,
+			count:         2,
+			expectedCount: 2,
+		},
+		"undirected graph": {
+			isDirected:    false,
+			count:         3,
+			expectedCount: 3,
+		},
+		"more landmarks than vertices": {
+			isDirected:    false,
+			count:         10,
+			expectedCount: 5,
+		},
+		"no landmarks": {
+			count:      0,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		for graphName, g := range newNeighborsGraphs(t, test.isDirected) {
+			t.Run(name+" with "+graphName, func(t *testing.T) {
+				landmarks, err := NewLandmarks(g, test.count)
+
+				if test.shouldFail != (err != nil) {
+					t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+				}
+
+				if test.shouldFail {
+					return
+				}
+
+				selected := landmarks.Landmarks()
+
+				if len(selected) != test.expectedCount {
+					t.Fatalf("expected %d landmarks, got %v", test.expectedCount, selected)
+				}
+
+				isSelected := make(map[int]bool)
+				for _, landmark := range selected {
+					if isSelected[landmark] {
+						t.Errorf("expected landmark %d to be selected once, got %v", landmark, selected)
+					}
+					isSelected[landmark] = true
+				}
+
+				// Vertex 5 is isolated in the undirected neighbors graph, so it
+				// has to receive a landmark of its own once a second landmark
+				// is selected.
+				if !test.isDirected && test.expectedCount > 1 && !isSelected[5] {
+					t.Errorf("expected isolated vertex 5 to be a landmark, got %v", selected)
+				}
+			})
+		}
+	}
+}
+
+func TestLandmarks_Heuristic(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for _, traits := range [][]func(*Traits){
+		{Directed(), Weighted()},
+		{Weighted()},
+		{Directed()},
+	} {
+		g := New(IntHash, traits...)
+		n := 50
+
+		for vertex := 0; vertex < n; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for i := 0; i < 150; i++ {
+			_ = g.AddEdge(random.Intn(n), random.Intn(n), EdgeWeight(random.Intn(10)))
+		}
+
+		landmarks, err := NewLandmarks(g, 4)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for source := 0; source < n; source++ {
+			paths, err := ShortestPathTree(g, source)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for target := 0; target < n; target++ {
+				expected, expectedErr := paths.Distance(target)
+
+				if expectedErr == nil {
+					if estimate := landmarks.Heuristic(target)(source); estimate > float64(expected) {
+						t.Fatalf("expected estimate from %d to %d to be at most %d, got %v", source, target, expected, estimate)
+					}
+				}
+
+				path, err := ShortestPath(g, source, target, WithHeuristic(landmarks.Heuristic(target)))
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("expected error %v from %d to %d, got %v", expectedErr, source, target, err)
+				}
+
+				if err != nil {
+					continue
+				}
+
+				weight := 0
+
+				for i := 1; i < len(path); i++ {
+					edge, err := g.Edge(path[i-1], path[i])
+					if err != nil {
+						t.Fatalf("expected edge (%d, %d) in path %v: %v", path[i-1], path[i], path, err)
+					}
+
+					if g.Traits().IsWeighted {
+						weight += edge.Properties.Weight
+					} else {
+						weight++
+					}
+				}
+
+				if weight != expected {
+					t.Fatalf("expected path of weight %d from %d to %d, got %v with weight %d", expected, source, target, path, weight)
+				}
+			}
+		}
+	}
+}
+
+func TestNewLandmarks_negativeWeight(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2, EdgeWeight(-1))
+
+	if _, err := NewLandmarks(g, 1); err == nil {
+		t.Error("expected error for negative edge weight, got nil")
+	}
+}
@@ -0,0 +1,351 @@
+// Package layout provides functions for computing the positions of vertices in
+// a two-dimensional plane, which can be used for rendering graphs without any
+// external tools like Graphviz.
+//
+// ForceDirected works for all graphs and is a good default choice:
+//
+//	positions, _ := layout.ForceDirected(g, layout.Size(800, 600))
+//
+//	for vertex, position := range positions {
+//		fmt.Printf("%v is at (%.1f, %.1f)\n", vertex, position.X, position.Y)
+//	}
+//
+// Hierarchical works for directed acyclic graphs and arranges the vertices in
+// layers, so that all edges point downwards.
+package layout
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/dominikbraun/graph"
+)
+
+// Point is a position in the plane. The origin is the upper left corner, the Y
+// axis points downwards.
+type Point struct {
+	X float64
+	Y float64
+}
+
+type layout struct {
+	width      float64
+	height     float64
+	iterations int
+	seed       int64
+	horizontal float64
+	vertical   float64
+}
+
+func newLayout(options ...func(*layout)) layout {
+	l := layout{
+		width:      1000,
+		height:     1000,
+		iterations: 300,
+		seed:       1,
+		horizontal: 100,
+		vertical:   100,
+	}
+
+	for _, option := range options {
+		option(&l)
+	}
+
+	return l
+}
+
+// Size sets the size of the frame that ForceDirected places the vertices in.
+// The default size is 1000x1000.
+func Size(width, height float64) func(*layout) {
+	return func(l *layout) {
+		l.width = width
+		l.height = height
+	}
+}
+
+// Iterations sets the number of iterations that ForceDirected runs. More
+// iterations lead to a more balanced layout. The default is 300.
+func Iterations(n int) func(*layout) {
+	return func(l *layout) {
+		l.iterations = n
+	}
+}
+
+// Seed sets the seed for the random initial positions used by ForceDirected.
+// The same seed yields the same layout for the same graph. The default is 1.
+func Seed(seed int64) func(*layout) {
+	return func(l *layout) {
+		l.seed = seed
+	}
+}
+
+// Spacing sets the horizontal distance between vertices in the same layer and
+// the vertical distance between layers used by Hierarchical. The default is
+// 100 for both.
+func Spacing(horizontal, vertical float64) func(*layout) {
+	return func(l *layout) {
+		l.horizontal = horizontal
+		l.vertical = vertical
+	}
+}
+
+// ForceDirected computes a layout using the Fruchterman–Reingold algorithm. It
+// simulates a physical system where all vertices repel each other and edges
+// act like springs that pull adjacent vertices together. Starting at random
+// positions, the vertices are moved according to these forces, with a maximum
+// displacement that decreases in each iteration.
+//
+// The returned positions are within the frame set using [Size]. Each iteration
+// takes O(|V|^2+|E|) time, so ForceDirected is suitable for graphs with up to a
+// few thousand vertices.
+func ForceDirected[K comparable, T any](g graph.Graph[K, T], options ...func(*layout)) (map[K]Point, error) {
+	l := newLayout(options...)
+
+	vertices, adjacencyMap, err := sortedVertices(g)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(vertices)
+	positions := make(map[K]Point, n)
+
+	if n == 0 {
+		return positions, nil
+	}
+
+	index := make(map[K]int, n)
+	for i, vertex := range vertices {
+		index[vertex] = i
+	}
+
+	// The edges are collected in a deterministic order, because the order in
+	// which the forces are summed up affects the result. Self-loops don't exert
+	// any force, and undirected edges are only included once.
+	edges := make([][2]int, 0)
+
+	for i, source := range vertices {
+		targets := make([]int, 0, len(adjacencyMap[source]))
+
+		for target := range adjacencyMap[source] {
+			j := index[target]
+			if i == j || (!g.Traits().IsDirected && j < i) {
+				continue
+			}
+			targets = append(targets, j)
+		}
+
+		sort.Ints(targets)
+
+		for _, j := range targets {
+			edges = append(edges, [2]int{i, j})
+		}
+	}
+
+	rng := rand.New(rand.NewSource(l.seed))
+
+	x := make([]float64, n)
+	y := make([]float64, n)
+
+	for i := range vertices {
+		x[i] = rng.Float64() * l.width
+		y[i] = rng.Float64() * l.height
+	}
+
+	// k is the optimal distance between two vertices, assuming that they are
+	// distributed evenly across the frame.
+	k := math.Sqrt(l.width * l.height / float64(n))
+	temperature := l.width / 10
+
+	dx := make([]float64, n)
+	dy := make([]float64, n)
+
+	for iteration := 0; iteration < l.iterations; iteration++ {
+		for i := range dx {
+			dx[i], dy[i] = 0, 0
+		}
+
+		// Repulsive forces between all pairs of vertices.
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				deltaX, deltaY, distance := delta(x[i], y[i], x[j], y[j], rng)
+				force := k * k / distance
+
+				dx[i] += deltaX / distance * force
+				dy[i] += deltaY / distance * force
+				dx[j] -= deltaX / distance * force
+				dy[j] -= deltaY / distance * force
+			}
+		}
+
+		// Attractive forces between adjacent vertices.
+		for _, edge := range edges {
+			i, j := edge[0], edge[1]
+
+			deltaX, deltaY, distance := delta(x[i], y[i], x[j], y[j], rng)
+			force := distance * distance / k
+
+			dx[i] -= deltaX / distance * force
+			dy[i] -= deltaY / distance * force
+			dx[j] += deltaX / distance * force
+			dy[j] += deltaY / distance * force
+		}
+
+		// Move the vertices, limiting the displacement to the temperature and
+		// keeping them inside the frame.
+		for i := 0; i < n; i++ {
+			length := math.Hypot(dx[i], dy[i])
+			if length > 0 {
+				step := math.Min(length, temperature)
+				x[i] += dx[i] / length * step
+				y[i] += dy[i] / length * step
+			}
+
+			x[i] = math.Min(l.width, math.Max(0, x[i]))
+			y[i] = math.Min(l.height, math.Max(0, y[i]))
+		}
+
+		temperature -= temperature / float64(l.iterations-iteration)
+	}
+
+	for i, vertex := range vertices {
+		positions[vertex] = Point{X: x[i], Y: y[i]}
+	}
+
+	return positions, nil
+}
+
+// delta returns the vector from (x2, y2) to (x1, y1) and its length. If both
+// points coincide, a tiny random vector is returned so that the vertices can be
+// pushed apart.
+func delta(x1, y1, x2, y2 float64, rng *rand.Rand) (float64, float64, float64) {
+	deltaX, deltaY := x1-x2, y1-y2
+	distance := math.Hypot(deltaX, deltaY)
+
+	if distance < 1e-9 {
+		deltaX, deltaY = rng.Float64()*1e-3+1e-6, rng.Float64()*1e-3+1e-6
+		distance = math.Hypot(deltaX, deltaY)
+	}
+
+	return deltaX, deltaY, distance
+}
+
+// Hierarchical computes a layered layout for a directed acyclic graph. Each
+// vertex is assigned to a layer that is one below its lowest predecessor, so
+// that all edges point downwards. Within the layers, the vertices are ordered
+// by the average position of their predecessors to reduce edge crossings.
+//
+// The layers are positioned at multiples of the vertical spacing set using
+// [Spacing], and the vertices of each layer are horizontally centered. If g is
+// undirected or contains cycles, an error is returned.
+func Hierarchical[K comparable, T any](g graph.Graph[K, T], options ...func(*layout)) (map[K]Point, error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("hierarchical layout cannot be computed on undirected graph")
+	}
+
+	l := newLayout(options...)
+
+	order, err := graph.TopologicalSort(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sort vertices topologically: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	layerOf := make(map[K]int, len(order))
+	layers := make([][]K, 0)
+
+	for _, vertex := range order {
+		layer := 0
+		for predecessor := range predecessorMap[vertex] {
+			if layerOf[predecessor]+1 > layer {
+				layer = layerOf[predecessor] + 1
+			}
+		}
+
+		layerOf[vertex] = layer
+
+		for len(layers) <= layer {
+			layers = append(layers, nil)
+		}
+		layers[layer] = append(layers[layer], vertex)
+	}
+
+	// The position of each vertex within its layer.
+	rank := make(map[K]float64, len(order))
+
+	for _, layer := range layers {
+		sort.SliceStable(layer, func(i, j int) bool {
+			return fmt.Sprint(layer[i]) < fmt.Sprint(layer[j])
+		})
+	}
+
+	for i, layer := range layers {
+		if i > 0 {
+			barycenters := make(map[K]float64, len(layer))
+
+			for _, vertex := range layer {
+				sum := 0.0
+				for predecessor := range predecessorMap[vertex] {
+					sum += rank[predecessor]
+				}
+				barycenters[vertex] = sum / float64(len(predecessorMap[vertex]))
+			}
+
+			sort.SliceStable(layer, func(a, b int) bool {
+				return barycenters[layer[a]] < barycenters[layer[b]]
+			})
+		}
+
+		for position, vertex := range layer {
+			rank[vertex] = float64(position)
+		}
+	}
+
+	widest := 0
+	for _, layer := range layers {
+		if len(layer) > widest {
+			widest = len(layer)
+		}
+	}
+
+	positions := make(map[K]Point, len(order))
+
+	for i, layer := range layers {
+		offset := float64(widest-len(layer)) / 2 * l.horizontal
+
+		for position, vertex := range layer {
+			positions[vertex] = Point{
+				X: offset + float64(position)*l.horizontal,
+				Y: float64(i) * l.vertical,
+			}
+		}
+	}
+
+	return positions, nil
+}
+
+// sortedVertices returns the vertices of g in a deterministic order, which is
+// required for reproducible layouts, along with the adjacency map of g.
+func sortedVertices[K comparable, T any](g graph.Graph[K, T]) ([]K, map[K]map[K]graph.Edge[K], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return fmt.Sprint(vertices[i]) < fmt.Sprint(vertices[j])
+	})
+
+	return vertices, adjacencyMap, nil
+}
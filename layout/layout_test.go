@@ -0,0 +1,189 @@
+package layout
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+func TestForceDirected(t *testing.T) {
+	tests := map[string]struct {
+		vertices []int
+		edges    [][2]int
+	}{
+		"path": {
+			vertices: []int{1, 2, 3, 4},
+			edges:    [][2]int{{1, 2}, {2, 3}, {3, 4}},
+		},
+		"two components": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges:    [][2]int{{1, 2}, {3, 4}, {4, 5}},
+		},
+		"self-loop": {
+			vertices: []int{1, 2},
+			edges:    [][2]int{{1, 1}, {1, 2}},
+		},
+		"single vertex": {
+			vertices: []int{1},
+		},
+		"empty graph": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := graph.New(graph.IntHash)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge[0], edge[1])
+			}
+
+			positions, err := ForceDirected(g, Size(400, 300))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(positions) != len(test.vertices) {
+				t.Fatalf("expected %d positions, got %d", len(test.vertices), len(positions))
+			}
+
+			for vertex, position := range positions {
+				if math.IsNaN(position.X) || math.IsNaN(position.Y) {
+					t.Errorf("expected valid position for %d, got %v", vertex, position)
+				}
+
+				if position.X < 0 || position.X > 400 || position.Y < 0 || position.Y > 300 {
+					t.Errorf("expected position for %d to be inside the frame, got %v", vertex, position)
+				}
+			}
+
+			again, _ := ForceDirected(g, Size(400, 300))
+
+			for vertex, position := range positions {
+				if again[vertex] != position {
+					t.Errorf("expected reproducible position %v for %d, got %v", position, vertex, again[vertex])
+				}
+			}
+		})
+	}
+}
+
+func TestForceDirected_Distances(t *testing.T) {
+	g := graph.New(graph.IntHash)
+
+	for i := 1; i <= 6; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	// Two triangles connected by a single edge.
+	for _, edge := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {4, 5}, {5, 6}, {6, 4}, {3, 4}} {
+		_ = g.AddEdge(edge[0], edge[1])
+	}
+
+	positions, err := ForceDirected(g, Seed(7))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	distance := func(a, b int) float64 {
+		return math.Hypot(positions[a].X-positions[b].X, positions[a].Y-positions[b].Y)
+	}
+
+	for _, pair := range [][2]int{{1, 2}, {2, 3}, {5, 6}} {
+		if distance(pair[0], pair[1]) >= distance(1, 6) {
+			t.Errorf("expected adjacent vertices %v to be closer than 1 and 6", pair)
+		}
+	}
+}
+
+func TestHierarchical(t *testing.T) {
+	tests := map[string]struct {
+		vertices    []int
+		edges       [][2]int
+		expectedY   map[int]float64
+		expectedErr bool
+	}{
+		"diamond": {
+			vertices: []int{1, 2, 3, 4},
+			edges:    [][2]int{{1, 2}, {1, 3}, {2, 4}, {3, 4}},
+			expectedY: map[int]float64{
+				1: 0,
+				2: 50,
+				3: 50,
+				4: 100,
+			},
+		},
+		"longest path determines layer": {
+			vertices: []int{1, 2, 3, 4},
+			edges:    [][2]int{{1, 2}, {2, 3}, {1, 3}, {4, 3}},
+			expectedY: map[int]float64{
+				1: 0,
+				2: 50,
+				3: 100,
+				4: 0,
+			},
+		},
+		"cycle": {
+			vertices:    []int{1, 2, 3},
+			edges:       [][2]int{{1, 2}, {2, 3}, {3, 1}},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := graph.New(graph.IntHash, graph.Directed())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge[0], edge[1])
+			}
+
+			positions, err := Hierarchical(g, Spacing(80, 50))
+
+			if test.expectedErr != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedErr {
+				return
+			}
+
+			for vertex, expected := range test.expectedY {
+				if positions[vertex].Y != expected {
+					t.Errorf("expected Y %v for %d, got %v", expected, vertex, positions[vertex].Y)
+				}
+			}
+
+			for _, edge := range test.edges {
+				if positions[edge[0]].Y >= positions[edge[1]].Y {
+					t.Errorf("expected edge %v to point downwards", edge)
+				}
+			}
+
+			occupied := make(map[Point]int)
+
+			for vertex, position := range positions {
+				if other, ok := occupied[position]; ok {
+					t.Errorf("expected distinct positions, got %v for %d and %d", position, vertex, other)
+				}
+				occupied[position] = vertex
+			}
+		})
+	}
+}
+
+func TestHierarchical_Undirected(t *testing.T) {
+	g := graph.New(graph.IntHash)
+
+	if _, err := Hierarchical(g); err == nil {
+		t.Errorf("expected error for undirected graph, got nil")
+	}
+}
@@ -0,0 +1,290 @@
+// Package matrix provides functions for exporting graphs as sparse matrices,
+// for example for handing them off to machine learning pipelines in Python.
+//
+// The matrices are written in the coordinate (COO) format, that is, as a list
+// of (row, column, value) triplets. Only non-zero entries are written, so the
+// size of the output is proportional to the number of edges.
+//
+// WriteNPZ writes a file that can be loaded using scipy.sparse.load_npz:
+//
+//	file, _ := os.Create("adjacency.npz")
+//	vertices, _ := matrix.WriteNPZ(g, file)
+//
+//	// In Python:
+//	// adjacency = scipy.sparse.load_npz("adjacency.npz")
+//
+// The returned vertices slice maps the row and column indices back to vertex
+// hashes: row i corresponds to vertices[i].
+//
+// For numeric methods in Go, such as spectral clustering, Dense and Sparse
+// return the matrices in memory instead. The data returned by Dense can be used
+// with gonum directly:
+//
+//	data, vertices, _ := matrix.Dense(g, matrix.Laplacian())
+//	laplacian := mat.NewDense(len(vertices), len(vertices), data)
+package matrix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/dominikbraun/graph"
+)
+
+type export struct {
+	laplacian bool
+}
+
+// Laplacian exports the Laplacian matrix L = D - A instead of the adjacency
+// matrix A, where D is the diagonal matrix of the weighted vertex degrees. For
+// directed graphs, the out-degrees are used.
+func Laplacian() func(*export) {
+	return func(e *export) {
+		e.laplacian = true
+	}
+}
+
+// entry is a non-zero matrix entry in a row.
+type entry struct {
+	column int
+	value  float64
+}
+
+// sparseMatrix is a matrix stored as a list of non-zero entries per row, with
+// the entries of each row sorted by column.
+type sparseMatrix[K comparable] struct {
+	vertices []K
+	rows     [][]entry
+	nonZero  int
+}
+
+// WriteCOO writes the adjacency matrix of g to w as plain text, with one
+// triplet per line. The line "0 2 5" means that the entry in row 0 and column
+// 2 has the value 5. The entries are written row by row.
+//
+// The values are the edge weights for weighted graphs and 1 otherwise. Like
+// the adjacency map, the matrix of an undirected graph is symmetric. To export
+// the Laplacian matrix instead, use the [Laplacian] option.
+//
+// WriteCOO returns the vertex hashes in the order of their row indices. The
+// vertices are ordered by their hashes if those are integers or strings, and
+// by their string representation otherwise.
+func WriteCOO[K comparable, T any](g graph.Graph[K, T], w io.Writer, options ...func(*export)) ([]K, error) {
+	m, err := newSparseMatrix(g, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	buffered := bufio.NewWriter(w)
+	line := make([]byte, 0, 64)
+
+	for row, entries := range m.rows {
+		for _, e := range entries {
+			line = strconv.AppendInt(line[:0], int64(row), 10)
+			line = append(line, ' ')
+			line = strconv.AppendInt(line, int64(e.column), 10)
+			line = append(line, ' ')
+			line = strconv.AppendFloat(line, e.value, 'g', -1, 64)
+			line = append(line, '\n')
+
+			if _, err := buffered.Write(line); err != nil {
+				return nil, fmt.Errorf("failed to write entry: %w", err)
+			}
+		}
+	}
+
+	if err := buffered.Flush(); err != nil {
+		return nil, fmt.Errorf("failed to write entries: %w", err)
+	}
+
+	return m.vertices, nil
+}
+
+// COO is a sparse matrix in the coordinate format, as returned by Sparse. The
+// entry in row Rows[i] and column Columns[i] has the value Values[i], and all
+// other entries are zero. The entries are sorted by row and then by column.
+type COO struct {
+	// Size is the number of rows and columns of the square matrix.
+	Size    int
+	Rows    []int
+	Columns []int
+	Values  []float64
+}
+
+// Sparse returns the adjacency matrix of g as a sparse matrix in the coordinate
+// format, which is the same matrix that WriteCOO writes. It can be converted to
+// the sparse matrix types of third-party libraries without a dense
+// intermediate. To get the Laplacian matrix instead, use the [Laplacian]
+// option.
+//
+// Sparse also returns the vertex hashes in the order of their row indices,
+// which are ordered like in WriteCOO, so the row of a vertex is the same for
+// each export of the same graph.
+func Sparse[K comparable, T any](g graph.Graph[K, T], options ...func(*export)) (*COO, []K, error) {
+	m, err := newSparseMatrix(g, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coo := &COO{
+		Size:    len(m.vertices),
+		Rows:    make([]int, 0, m.nonZero),
+		Columns: make([]int, 0, m.nonZero),
+		Values:  make([]float64, 0, m.nonZero),
+	}
+
+	for row, entries := range m.rows {
+		for _, e := range entries {
+			coo.Rows = append(coo.Rows, row)
+			coo.Columns = append(coo.Columns, e.column)
+			coo.Values = append(coo.Values, e.value)
+		}
+	}
+
+	return coo, m.vertices, nil
+}
+
+// Dense returns the adjacency matrix of g as a dense matrix, stored row by row
+// in a single slice: The entry in row i and column j is at index i*n+j, where n
+// is the number of vertices. This is the layout used by gonum's mat.NewDense.
+// To get the Laplacian matrix instead, use the [Laplacian] option.
+//
+// Like Sparse, Dense also returns the vertex hashes in the order of their row
+// indices. Since the matrix takes O(|V|^2) memory, Sparse should be preferred
+// for large graphs.
+func Dense[K comparable, T any](g graph.Graph[K, T], options ...func(*export)) ([]float64, []K, error) {
+	m, err := newSparseMatrix(g, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := len(m.vertices)
+	data := make([]float64, n*n)
+
+	for row, entries := range m.rows {
+		for _, e := range entries {
+			data[row*n+e.column] = e.value
+		}
+	}
+
+	return data, m.vertices, nil
+}
+
+func newSparseMatrix[K comparable, T any](g graph.Graph[K, T], options ...func(*export)) (*sparseMatrix[K], error) {
+	var e export
+
+	for _, option := range options {
+		option(&e)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := make([]K, 0, len(adjacencyMap))
+	for vertex := range adjacencyMap {
+		vertices = append(vertices, vertex)
+	}
+
+	sortVertices(vertices)
+
+	index := make(map[K]int, len(vertices))
+	for i, vertex := range vertices {
+		index[vertex] = i
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	m := &sparseMatrix[K]{
+		vertices: vertices,
+		rows:     make([][]entry, len(vertices)),
+	}
+
+	for row, vertex := range vertices {
+		entries := make([]entry, 0, len(adjacencyMap[vertex])+1)
+		degree := 0.0
+
+		for adjacency, edge := range adjacencyMap[vertex] {
+			value := 1.0
+			if isWeighted {
+				value = float64(edge.Properties.Weight)
+			}
+
+			degree += value
+
+			if e.laplacian {
+				value = -value
+			}
+
+			entries = append(entries, entry{column: index[adjacency], value: value})
+		}
+
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].column < entries[j].column
+		})
+
+		if e.laplacian {
+			entries = addToDiagonal(entries, row, degree)
+		}
+
+		m.rows[row] = entries
+		m.nonZero += len(entries)
+	}
+
+	return m, nil
+}
+
+// addToDiagonal adds value to the diagonal entry of a sorted row, inserting the
+// entry if it doesn't exist and removing it if it becomes zero.
+func addToDiagonal(entries []entry, row int, value float64) []entry {
+	i := sort.Search(len(entries), func(i int) bool {
+		return entries[i].column >= row
+	})
+
+	if i < len(entries) && entries[i].column == row {
+		entries[i].value += value
+		if entries[i].value == 0 {
+			entries = append(entries[:i], entries[i+1:]...)
+		}
+		return entries
+	}
+
+	if value == 0 {
+		return entries
+	}
+
+	entries = append(entries, entry{})
+	copy(entries[i+1:], entries[i:])
+	entries[i] = entry{column: row, value: value}
+
+	return entries
+}
+
+// sortVertices sorts vertex hashes by their natural order if they are integers
+// or strings, and by their string representation otherwise.
+func sortVertices[K comparable](vertices []K) {
+	sort.Slice(vertices, func(i, j int) bool {
+		switch a := any(vertices[i]).(type) {
+		case int:
+			return a < any(vertices[j]).(int)
+		case int64:
+			return a < any(vertices[j]).(int64)
+		case int32:
+			return a < any(vertices[j]).(int32)
+		case uint:
+			return a < any(vertices[j]).(uint)
+		case uint64:
+			return a < any(vertices[j]).(uint64)
+		case uint32:
+			return a < any(vertices[j]).(uint32)
+		case string:
+			return a < any(vertices[j]).(string)
+		default:
+			return fmt.Sprint(vertices[i]) < fmt.Sprint(vertices[j])
+		}
+	})
+}
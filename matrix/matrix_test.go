@@ -0,0 +1,198 @@
+package matrix
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+type edge struct {
+	source, target, weight int
+}
+
+func newGraph(vertices []int, edges []edge, options ...func(*graph.Traits)) graph.Graph[int, int] {
+	g := graph.New(graph.IntHash, options...)
+
+	for _, vertex := range vertices {
+		_ = g.AddVertex(vertex)
+	}
+
+	for _, e := range edges {
+		_ = g.AddEdge(e.source, e.target, graph.EdgeWeight(e.weight))
+	}
+
+	return g
+}
+
+func TestWriteCOO(t *testing.T) {
+	tests := map[string]struct {
+		g                graph.Graph[int, int]
+		options          []func(*export)
+		expected         string
+		expectedVertices []int
+	}{
+		"directed unweighted": {
+			g:                newGraph([]int{3, 1, 2}, []edge{{1, 2, 5}, {1, 3, 5}, {3, 1, 5}}, graph.Directed()),
+			expected:         "0 1 1\n0 2 1\n2 0 1\n",
+			expectedVertices: []int{1, 2, 3},
+		},
+		"undirected weighted": {
+			g:                newGraph([]int{1, 2, 10}, []edge{{1, 10, 4}, {2, 10, 2}}, graph.Weighted()),
+			expected:         "0 2 4\n1 2 2\n2 0 4\n2 1 2\n",
+			expectedVertices: []int{1, 2, 10},
+		},
+		"undirected laplacian": {
+			g:                newGraph([]int{1, 2, 3}, []edge{{1, 2, 1}, {2, 3, 1}}),
+			options:          []func(*export){Laplacian()},
+			expected:         "0 0 1\n0 1 -1\n1 0 -1\n1 1 2\n1 2 -1\n2 1 -1\n2 2 1\n",
+			expectedVertices: []int{1, 2, 3},
+		},
+		"directed laplacian with self-loop": {
+			g:                newGraph([]int{1, 2}, []edge{{1, 1, 1}, {1, 2, 1}, {2, 2, 3}}, graph.Directed(), graph.Weighted()),
+			options:          []func(*export){Laplacian()},
+			expected:         "0 0 1\n0 1 -1\n",
+			expectedVertices: []int{1, 2},
+		},
+		"empty graph": {
+			g:                newGraph(nil, nil),
+			expected:         "",
+			expectedVertices: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			vertices, err := WriteCOO(test.g, &buf, test.options...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if buf.String() != test.expected {
+				t.Errorf("expected output %q, got %q", test.expected, buf.String())
+			}
+
+			if len(vertices) != len(test.expectedVertices) {
+				t.Fatalf("expected vertices %v, got %v", test.expectedVertices, vertices)
+			}
+
+			for i := range vertices {
+				if vertices[i] != test.expectedVertices[i] {
+					t.Errorf("expected vertices %v, got %v", test.expectedVertices, vertices)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestWriteCOO_WriteError(t *testing.T) {
+	g := newGraph([]int{1, 2}, []edge{{1, 2, 1}})
+
+	if _, err := WriteCOO(g, failingWriter{}); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestSparse(t *testing.T) {
+	g := newGraph([]int{3, 1, 2}, []edge{{1, 2, 4}, {2, 3, 1}}, graph.Weighted())
+
+	coo, vertices, err := Sparse(g, Laplacian())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &COO{
+		Size:    3,
+		Rows:    []int{0, 0, 1, 1, 1, 2, 2},
+		Columns: []int{0, 1, 0, 1, 2, 1, 2},
+		Values:  []float64{4, -4, -4, 5, -1, -1, 1},
+	}
+
+	if !reflect.DeepEqual(coo, expected) {
+		t.Errorf("expected matrix %v, got %v", expected, coo)
+	}
+
+	if !reflect.DeepEqual(vertices, []int{1, 2, 3}) {
+		t.Errorf("expected vertices %v, got %v", []int{1, 2, 3}, vertices)
+	}
+}
+
+func TestDense(t *testing.T) {
+	tests := map[string]struct {
+		g                graph.Graph[int, int]
+		options          []func(*export)
+		expected         []float64
+		expectedVertices []int
+	}{
+		"directed adjacency matrix": {
+			g: newGraph([]int{3, 1, 2}, []edge{{1, 2, 5}, {1, 3, 5}, {3, 1, 5}}, graph.Directed()),
+			expected: []float64{
+				0, 1, 1,
+				0, 0, 0,
+				1, 0, 0,
+			},
+			expectedVertices: []int{1, 2, 3},
+		},
+		"undirected laplacian": {
+			g:       newGraph([]int{1, 2, 3}, []edge{{1, 2, 1}, {2, 3, 1}}),
+			options: []func(*export){Laplacian()},
+			expected: []float64{
+				1, -1, 0,
+				-1, 2, -1,
+				0, -1, 1,
+			},
+			expectedVertices: []int{1, 2, 3},
+		},
+		"empty graph": {
+			g:                newGraph(nil, nil),
+			expected:         []float64{},
+			expectedVertices: []int{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, vertices, err := Dense(test.g, test.options...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(data, test.expected) {
+				t.Errorf("expected matrix %v, got %v", test.expected, data)
+			}
+
+			if !reflect.DeepEqual(vertices, test.expectedVertices) {
+				t.Errorf("expected vertices %v, got %v", test.expectedVertices, vertices)
+			}
+		})
+	}
+}
+
+func TestSortVertices(t *testing.T) {
+	strings := []string{"b", "c", "a"}
+	sortVertices(strings)
+
+	if strings[0] != "a" || strings[1] != "b" || strings[2] != "c" {
+		t.Errorf("expected sorted strings, got %v", strings)
+	}
+
+	type point struct{ x, y int }
+
+	points := []point{{2, 0}, {1, 5}, {1, 2}}
+	sortVertices(points)
+
+	if points[0] != (point{1, 2}) || points[1] != (point{1, 5}) || points[2] != (point{2, 0}) {
+		t.Errorf("expected points sorted by string representation, got %v", points)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
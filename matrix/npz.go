@@ -0,0 +1,159 @@
+package matrix
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/dominikbraun/graph"
+)
+
+// WriteNPZ writes the adjacency matrix of g to w in the .npz format used by
+// scipy.sparse.save_npz, so that it can be loaded as a scipy.sparse.coo_matrix
+// using scipy.sparse.load_npz. The row and column indices are stored as 64-bit
+// integers and the values as 64-bit floats.
+//
+// The values are the same as for [WriteCOO], and the [Laplacian] option can be
+// used in the same way. WriteNPZ returns the vertex hashes in the order of their
+// row indices.
+func WriteNPZ[K comparable, T any](g graph.Graph[K, T], w io.Writer, options ...func(*export)) ([]K, error) {
+	m, err := newSparseMatrix(g, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := zip.NewWriter(w)
+
+	arrays := []struct {
+		name  string
+		descr string
+		shape []int
+		write func(w io.Writer) error
+	}{
+		{
+			name:  "row",
+			descr: "<i8",
+			shape: []int{m.nonZero},
+			write: func(w io.Writer) error {
+				return m.writeEntries(w, func(row int, _ entry) uint64 {
+					return uint64(row)
+				})
+			},
+		},
+		{
+			name:  "col",
+			descr: "<i8",
+			shape: []int{m.nonZero},
+			write: func(w io.Writer) error {
+				return m.writeEntries(w, func(_ int, e entry) uint64 {
+					return uint64(e.column)
+				})
+			},
+		},
+		{
+			name:  "data",
+			descr: "<f8",
+			shape: []int{m.nonZero},
+			write: func(w io.Writer) error {
+				return m.writeEntries(w, func(_ int, e entry) uint64 {
+					return math.Float64bits(e.value)
+				})
+			},
+		},
+		{
+			name:  "shape",
+			descr: "<i8",
+			shape: []int{2},
+			write: func(w io.Writer) error {
+				order := uint64(len(m.vertices))
+				return binary.Write(w, binary.LittleEndian, []uint64{order, order})
+			},
+		},
+		{
+			name:  "format",
+			descr: "|S3",
+			shape: []int{},
+			write: func(w io.Writer) error {
+				_, err := io.WriteString(w, "coo")
+				return err
+			},
+		},
+	}
+
+	for _, array := range arrays {
+		file, err := archive.CreateHeader(&zip.FileHeader{
+			Name:   array.name + ".npy",
+			Method: zip.Deflate,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s.npy: %w", array.name, err)
+		}
+
+		if _, err := io.WriteString(file, npyHeader(array.descr, array.shape)); err != nil {
+			return nil, fmt.Errorf("failed to write %s.npy: %w", array.name, err)
+		}
+
+		if err := array.write(file); err != nil {
+			return nil, fmt.Errorf("failed to write %s.npy: %w", array.name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	return m.vertices, nil
+}
+
+// writeEntries writes one little-endian 64-bit value per entry, obtained using
+// the given function, in row order.
+func (m *sparseMatrix[K]) writeEntries(w io.Writer, value func(row int, e entry) uint64) error {
+	buffered := bufio.NewWriter(w)
+	var buf [8]byte
+
+	for row, entries := range m.rows {
+		for _, e := range entries {
+			binary.LittleEndian.PutUint64(buf[:], value(row, e))
+			if _, err := buffered.Write(buf[:]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return buffered.Flush()
+}
+
+// npyHeader returns the header of an .npy file in version 1.0 of the format,
+// padded so that the data starts at a multiple of 64 bytes.
+func npyHeader(descr string, shape []int) string {
+	dimensions := make([]string, len(shape))
+	for i, dimension := range shape {
+		dimensions[i] = fmt.Sprint(dimension)
+	}
+
+	tuple := strings.Join(dimensions, ", ")
+	if len(shape) == 1 {
+		tuple += ","
+	}
+
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, tuple)
+
+	// The magic string, the version, and the header length take 10 bytes, and
+	// the header is terminated by a newline.
+	padding := 64 - (10+len(header)+1)%64
+	if padding == 64 {
+		padding = 0
+	}
+
+	header += strings.Repeat(" ", padding) + "\n"
+
+	var prefix [10]byte
+	copy(prefix[:], "\x93NUMPY\x01\x00")
+	binary.LittleEndian.PutUint16(prefix[8:], uint16(len(header)))
+
+	return string(prefix[:]) + header
+}
@@ -0,0 +1,169 @@
+package matrix
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+func TestWriteNPZ(t *testing.T) {
+	g := newGraph([]int{1, 2, 3}, []edge{{1, 2, 4}, {2, 3, 2}, {3, 1, 7}}, graph.Directed(), graph.Weighted())
+
+	var buf bytes.Buffer
+
+	vertices, err := WriteNPZ(g, &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(vertices) != 3 {
+		t.Fatalf("expected 3 vertices, got %v", vertices)
+	}
+
+	arrays := readNPZ(t, buf.Bytes())
+
+	expectedHeaders := map[string]string{
+		"row":    "{'descr': '<i8', 'fortran_order': False, 'shape': (3,), }",
+		"col":    "{'descr': '<i8', 'fortran_order': False, 'shape': (3,), }",
+		"data":   "{'descr': '<f8', 'fortran_order': False, 'shape': (3,), }",
+		"shape":  "{'descr': '<i8', 'fortran_order': False, 'shape': (2,), }",
+		"format": "{'descr': '|S3', 'fortran_order': False, 'shape': (), }",
+	}
+
+	for name, expected := range expectedHeaders {
+		array, ok := arrays[name]
+		if !ok {
+			t.Fatalf("expected array %s in archive", name)
+		}
+
+		if array.header != expected {
+			t.Errorf("expected header %q for %s, got %q", expected, name, array.header)
+		}
+	}
+
+	expectedInts := map[string][]uint64{
+		"row":   {0, 1, 2},
+		"col":   {1, 2, 0},
+		"shape": {3, 3},
+	}
+
+	for name, expected := range expectedInts {
+		values := arrays[name].uint64s()
+		if len(values) != len(expected) {
+			t.Fatalf("expected %s %v, got %v", name, expected, values)
+		}
+
+		for i := range expected {
+			if values[i] != expected[i] {
+				t.Errorf("expected %s %v, got %v", name, expected, values)
+				break
+			}
+		}
+	}
+
+	expectedData := []float64{4, 2, 7}
+
+	for i, bits := range arrays["data"].uint64s() {
+		if math.Float64frombits(bits) != expectedData[i] {
+			t.Errorf("expected data %v, got value %v at %d", expectedData, math.Float64frombits(bits), i)
+		}
+	}
+
+	if string(arrays["format"].data) != "coo" {
+		t.Errorf("expected format coo, got %q", arrays["format"].data)
+	}
+}
+
+func TestNPYHeader(t *testing.T) {
+	tests := map[string]struct {
+		descr string
+		shape []int
+	}{
+		"vector": {
+			descr: "<i8",
+			shape: []int{12345},
+		},
+		"scalar": {
+			descr: "|S3",
+			shape: []int{},
+		},
+		"matrix": {
+			descr: "<f8",
+			shape: []int{3, 4},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := npyHeader(test.descr, test.shape)
+
+			if !strings.HasPrefix(header, "\x93NUMPY\x01\x00") {
+				t.Errorf("expected magic string, got %q", header[:8])
+			}
+
+			if len(header)%64 != 0 {
+				t.Errorf("expected header length to be a multiple of 64, got %d", len(header))
+			}
+
+			if length := int(binary.LittleEndian.Uint16([]byte(header[8:10]))); length != len(header)-10 {
+				t.Errorf("expected header length %d, got %d", len(header)-10, length)
+			}
+
+			if !strings.HasSuffix(header, "\n") {
+				t.Errorf("expected header to end with a newline")
+			}
+		})
+	}
+}
+
+type npyArray struct {
+	header string
+	data   []byte
+}
+
+func (a npyArray) uint64s() []uint64 {
+	values := make([]uint64, len(a.data)/8)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint64(a.data[i*8:])
+	}
+	return values
+}
+
+func readNPZ(t *testing.T, archive []byte) map[string]npyArray {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+
+	arrays := make(map[string]npyArray)
+
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("failed to open %s: %v", file.Name, err)
+		}
+
+		content, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file.Name, err)
+		}
+
+		length := int(binary.LittleEndian.Uint16(content[8:10]))
+
+		arrays[strings.TrimSuffix(file.Name, ".npy")] = npyArray{
+			header: strings.TrimRight(string(content[10:10+length]), " \n"),
+			data:   content[10+length:],
+		}
+	}
+
+	return arrays
+}
@@ -0,0 +1,294 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Reciprocity computes the fraction of edges in a directed graph that are
+// mutual. An edge (A,B) is mutual if the graph also contains the edge (B,A),
+// so a graph consisting of the edges (A,B), (B,A), and (B,C) has a reciprocity
+// of 2/3. Self-loops are counted as edges, but not as mutual edges. For a graph
+// without edges, Reciprocity returns 0.
+//
+// Reciprocity can only be computed on directed graphs.
+func Reciprocity[K comparable, T any](g Graph[K, T]) (float64, error) {
+	if !g.Traits().IsDirected {
+		return 0, errors.New("reciprocity cannot be computed on undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, mutualEdges := 0, 0
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			edges++
+
+			if source == target {
+				continue
+			}
+
+			if _, ok := adjacencyMap[target][source]; ok {
+				mutualEdges++
+			}
+		}
+	}
+
+	if edges == 0 {
+		return 0, nil
+	}
+
+	return float64(mutualEdges) / float64(edges), nil
+}
+
+// MutualEdges returns all mutual edges of a directed graph, that is, all edges
+// (A,B) for which the graph also contains the edge (B,A). Each pair of mutual
+// edges is only returned once, either as (A,B) or as (B,A). Self-loops are not
+// considered mutual edges.
+//
+// MutualEdges can only be computed on directed graphs.
+func MutualEdges[K comparable, T any](g Graph[K, T]) ([]Edge[K], error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("mutual edges cannot be computed on undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	mutualEdges := make([]Edge[K], 0)
+	added := make(map[tuple[K]]struct{})
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if source == target {
+				continue
+			}
+
+			if _, ok := adjacencyMap[target][source]; !ok {
+				continue
+			}
+
+			if _, ok := added[tuple[K]{source: target, target: source}]; ok {
+				continue
+			}
+
+			mutualEdges = append(mutualEdges, edge)
+			added[tuple[K]{source: source, target: target}] = struct{}{}
+		}
+	}
+
+	return mutualEdges, nil
+}
+
+// DegreeCorrelation computes the Pearson correlation coefficient between the
+// in-degrees and the out-degrees of the vertices in a directed graph. A value
+// close to 1 means that vertices with many ingoing edges also tend to have many
+// outgoing edges, a value close to -1 means the opposite.
+//
+// DegreeCorrelation can only be computed on directed graphs. If all vertices
+// have the same in-degree or the same out-degree, the correlation is undefined
+// and an error is returned.
+func DegreeCorrelation[K comparable, T any](g Graph[K, T]) (float64, error) {
+	if !g.Traits().IsDirected {
+		return 0, errors.New("degree correlation cannot be computed on undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	inDegrees := make([]float64, 0, len(adjacencyMap))
+	outDegrees := make([]float64, 0, len(adjacencyMap))
+
+	for vertex, adjacencies := range adjacencyMap {
+		inDegrees = append(inDegrees, float64(len(predecessorMap[vertex])))
+		outDegrees = append(outDegrees, float64(len(adjacencies)))
+	}
+
+	correlation, ok := pearson(inDegrees, outDegrees)
+	if !ok {
+		return 0, errors.New("degree correlation is undefined if all vertices have the same in- or out-degree")
+	}
+
+	return correlation, nil
+}
+
+// DegreeAssortativity computes the degree assortativity coefficient, which is
+// the Pearson correlation coefficient between the degrees of the vertices at
+// both ends of each edge. A positive value means that vertices tend to be
+// connected to vertices with a similar degree, as in many social networks. A
+// negative value means that high-degree vertices tend to be connected to
+// low-degree vertices, as in many technological and biological networks.
+//
+// In an undirected graph, each edge is taken into account in both directions,
+// and the degree of a vertex is defined as in [DegreeDistribution]. In a
+// directed graph, the out-degree of the source is correlated with the in-degree
+// of the target of each edge.
+//
+// If the graph has no edges or if the degrees at either end of the edges are
+// all the same, as in a regular graph, the coefficient is undefined and an
+// error is returned.
+func DegreeAssortativity[K comparable, T any](g Graph[K, T]) (float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	var sourceDegrees, targetDegrees map[K]int
+
+	if g.Traits().IsDirected {
+		predecessorMap, err := g.PredecessorMap()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get predecessor map: %w", err)
+		}
+
+		sourceDegrees = make(map[K]int, len(adjacencyMap))
+		targetDegrees = make(map[K]int, len(adjacencyMap))
+
+		for vertex, adjacencies := range adjacencyMap {
+			sourceDegrees[vertex] = len(adjacencies)
+			targetDegrees[vertex] = len(predecessorMap[vertex])
+		}
+	} else {
+		if sourceDegrees, err = vertexDegrees(g); err != nil {
+			return 0, err
+		}
+		targetDegrees = sourceDegrees
+	}
+
+	x := make([]float64, 0)
+	y := make([]float64, 0)
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			x = append(x, float64(sourceDegrees[source]))
+			y = append(y, float64(targetDegrees[target]))
+		}
+	}
+
+	assortativity, ok := pearson(x, y)
+	if !ok {
+		return 0, errors.New("degree assortativity is undefined if the degrees at either end of the edges are all the same")
+	}
+
+	return assortativity, nil
+}
+
+// AttributeAssortativity computes the assortativity coefficient of the labels
+// returned by the given function for each vertex, such as the language of the
+// users in a social network. A value of 1 means that edges only connect vertices
+// with the same label, 0 means that the labels at both ends of the edges are
+// independent, and negative values mean that edges rather connect vertices
+// with different labels than they would by chance:
+//
+//	r, _ := graph.AttributeAssortativity(g, func(user User) string {
+//		return user.Language
+//	})
+//
+// AttributeAssortativity uses Newman's definition for categorical attributes,
+// r = (sum(e_ii) - sum(a_i*b_i)) / (1 - sum(a_i*b_i)), where e_ij is the
+// fraction of edges from a vertex with label i to a vertex with label j, and
+// a_i and b_i are the fractions of edges starting and ending at a vertex with
+// label i. In an undirected graph, each edge is taken into account in both
+// directions.
+//
+// If the graph has no edges or if all edges connect vertices with the same
+// single label, the coefficient is undefined and an error is returned.
+func AttributeAssortativity[K comparable, T any, L comparable](g Graph[K, T], label func(T) L) (float64, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	labels := make(map[K]L, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		vertex, err := g.Vertex(hash)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+		labels[hash] = label(vertex)
+	}
+
+	edges := 0
+	same := 0
+	sources := make(map[L]int)
+	targets := make(map[L]int)
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			edges++
+			sources[labels[source]]++
+			targets[labels[target]]++
+
+			if labels[source] == labels[target] {
+				same++
+			}
+		}
+	}
+
+	if edges == 0 {
+		return 0, errors.New("attribute assortativity is undefined for a graph without edges")
+	}
+
+	m := float64(edges)
+	expected := 0.0
+
+	for l, count := range sources {
+		expected += float64(count) / m * float64(targets[l]) / m
+	}
+
+	if expected == 1 {
+		return 0, errors.New("attribute assortativity is undefined if all edges connect vertices with the same label")
+	}
+
+	return (float64(same)/m - expected) / (1 - expected), nil
+}
+
+// pearson computes the Pearson correlation coefficient of x and y, which must
+// have the same length. It returns false if the coefficient is undefined
+// because x or y have a variance of zero.
+func pearson(x, y []float64) (float64, bool) {
+	n := float64(len(x))
+	if n == 0 {
+		return 0, false
+	}
+
+	var meanX, meanY float64
+
+	for i := range x {
+		meanX += x[i]
+		meanY += y[i]
+	}
+
+	meanX /= n
+	meanY /= n
+
+	var covariance, varianceX, varianceY float64
+
+	for i := range x {
+		dx, dy := x[i]-meanX, y[i]-meanY
+		covariance += dx * dy
+		varianceX += dx * dx
+		varianceY += dy * dy
+	}
+
+	if varianceX == 0 || varianceY == 0 {
+		return 0, false
+	}
+
+	return covariance / math.Sqrt(varianceX*varianceY), true
+}
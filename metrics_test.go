@@ -0,0 +1,310 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestReciprocity(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		edges      []Edge[int]
+		expected   float64
+		shouldFail bool
+	}{
+		"mutual and one-way edges": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+			},
+			expected: 2.0 / 3.0,
+		},
+		"self-loop": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expected: 2.0 / 3.0,
+		},
+		"no edges": {
+			isDirected: true,
+			expected:   0,
+		},
+		"undirected graph": {
+			isDirected: false,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newMetricsGraph(test.isDirected, test.edges)
+
+			reciprocity, err := Reciprocity(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if math.Abs(reciprocity-test.expected) > 1e-9 {
+				t.Errorf("expected reciprocity %v, got %v", test.expected, reciprocity)
+			}
+		})
+	}
+}
+
+func TestMutualEdges(t *testing.T) {
+	g := newMetricsGraph(true, []Edge[int]{
+		{Source: 1, Target: 2},
+		{Source: 2, Target: 1},
+		{Source: 2, Target: 3},
+		{Source: 3, Target: 4},
+		{Source: 4, Target: 3},
+		{Source: 4, Target: 4},
+	})
+
+	mutualEdges, err := MutualEdges(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(mutualEdges) != 2 {
+		t.Fatalf("expected 2 mutual edges, got %v", mutualEdges)
+	}
+
+	pairs := make(map[[2]int]struct{})
+	for _, edge := range mutualEdges {
+		a, b := edge.Source, edge.Target
+		if a > b {
+			a, b = b, a
+		}
+		pairs[[2]int{a, b}] = struct{}{}
+	}
+
+	for _, expected := range [][2]int{{1, 2}, {3, 4}} {
+		if _, ok := pairs[expected]; !ok {
+			t.Errorf("expected mutual edge between %d and %d, got %v", expected[0], expected[1], mutualEdges)
+		}
+	}
+
+	if _, err := MutualEdges(newMetricsGraph(false, nil)); err == nil {
+		t.Errorf("expected error for undirected graph, got nil")
+	}
+}
+
+func TestDegreeCorrelation(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		edges      []Edge[int]
+		expected   float64
+		shouldFail bool
+	}{
+		"positive correlation": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 1, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 1, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			expected: 1,
+		},
+		"negative correlation": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expected: -1,
+		},
+		"cycle": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			shouldFail: true,
+		},
+		"undirected graph": {
+			isDirected: false,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newMetricsGraph(test.isDirected, test.edges)
+
+			correlation, err := DegreeCorrelation(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if math.Abs(correlation-test.expected) > 1e-9 {
+				t.Errorf("expected correlation %v, got %v", test.expected, correlation)
+			}
+		})
+	}
+}
+
+func TestDegreeAssortativity(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		edges      []Edge[int]
+		expected   float64
+		shouldFail bool
+	}{
+		"star": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expected: -1,
+		},
+		"path": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+			},
+			expected: -0.5,
+		},
+		"directed graph": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+			},
+			expected: -0.5,
+		},
+		"regular graph": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			shouldFail: true,
+		},
+		"no edges": {
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newMetricsGraph(test.isDirected, test.edges)
+
+			assortativity, err := DegreeAssortativity(g)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if math.Abs(assortativity-test.expected) > 1e-9 {
+				t.Errorf("expected assortativity %v, got %v", test.expected, assortativity)
+			}
+		})
+	}
+}
+
+func TestAttributeAssortativity(t *testing.T) {
+	parity := func(vertex int) int {
+		return vertex % 2
+	}
+
+	tests := map[string]struct {
+		isDirected bool
+		edges      []Edge[int]
+		label      func(int) int
+		expected   float64
+		shouldFail bool
+	}{
+		"only equal labels": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+			},
+			label:    parity,
+			expected: 1,
+		},
+		"only different labels": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 4},
+			},
+			label:    parity,
+			expected: -1,
+		},
+		"directed graph": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 2},
+			},
+			label:    parity,
+			expected: 0,
+		},
+		"single label": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			label: func(int) int {
+				return 0
+			},
+			shouldFail: true,
+		},
+		"no edges": {
+			label:      parity,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newMetricsGraph(test.isDirected, test.edges)
+
+			assortativity, err := AttributeAssortativity(g, test.label)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if math.Abs(assortativity-test.expected) > 1e-9 {
+				t.Errorf("expected assortativity %v, got %v", test.expected, assortativity)
+			}
+		})
+	}
+}
+
+func newMetricsGraph(isDirected bool, edges []Edge[int]) Graph[int, int] {
+	var g Graph[int, int]
+
+	if isDirected {
+		g = New(IntHash, Directed())
+	} else {
+		g = New(IntHash)
+	}
+
+	for _, vertex := range []int{1, 2, 3, 4} {
+		_ = g.AddVertex(vertex)
+	}
+
+	for _, edge := range edges {
+		_ = g.AddEdge(copyEdge(edge))
+	}
+
+	return g
+}
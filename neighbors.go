@@ -0,0 +1,135 @@
+package graph
+
+import "fmt"
+
+// edgeIndex is implemented by stores that maintain an index of the ingoing and
+// outgoing edges of each vertex, like the default in-memory store. This allows
+// to retrieve the edges of a single vertex without scanning all edges.
+type edgeIndex[K comparable] interface {
+	InEdges(hash K) ([]Edge[K], error)
+	OutEdges(hash K) ([]Edge[K], error)
+}
+
+// Predecessors returns the hashes of all vertices that have an edge to the
+// given vertex. For an undirected graph, these are the same as the successors.
+//
+// For graphs using the default in-memory store, Predecessors takes O(deg) time
+// because the store maintains an index of the ingoing edges of each vertex. For
+// other stores, the full predecessor map needs to be computed, which takes
+// O(|V|+|E|) time.
+func Predecessors[K comparable, T any](g Graph[K, T], hash K) ([]K, error) {
+	edges, err := inEdges(g, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	predecessors := make([]K, len(edges))
+	for i, edge := range edges {
+		predecessors[i] = edge.Source
+	}
+
+	return predecessors, nil
+}
+
+// Successors returns the hashes of all vertices that the given vertex has an
+// edge to. For an undirected graph, these are the same as the predecessors.
+// Like [Predecessors], it takes O(deg) time for the default in-memory store.
+func Successors[K comparable, T any](g Graph[K, T], hash K) ([]K, error) {
+	edges, err := outEdges(g, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	successors := make([]K, len(edges))
+	for i, edge := range edges {
+		successors[i] = edge.Target
+	}
+
+	return successors, nil
+}
+
+// InDegree returns the number of ingoing edges of the given vertex. For an
+// undirected graph, this is the number of edges incident to the vertex, where
+// a self-loop counts once. Like [Predecessors], it takes O(deg) time for the
+// default in-memory store.
+func InDegree[K comparable, T any](g Graph[K, T], hash K) (int, error) {
+	edges, err := inEdges(g, hash)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(edges), nil
+}
+
+// OutDegree returns the number of outgoing edges of the given vertex. For an
+// undirected graph, this is the same as the in-degree.
+func OutDegree[K comparable, T any](g Graph[K, T], hash K) (int, error) {
+	edges, err := outEdges(g, hash)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(edges), nil
+}
+
+// inEdges returns the ingoing edges of the given vertex, using the edge index
+// of the store if available.
+func inEdges[K comparable, T any](g Graph[K, T], hash K) ([]Edge[K], error) {
+	if index, ok := storeOf(g).(edgeIndex[K]); ok {
+		edges, err := index.InEdges(hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+		return edges, nil
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	predecessors, ok := predecessorMap[hash]
+	if !ok {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", hash, ErrVertexNotFound)
+	}
+
+	// In an undirected graph, the predecessor map is the same as the adjacency
+	// map, so the edges have to be reversed to point to the vertex.
+	edges := make([]Edge[K], 0, len(predecessors))
+	for source, edge := range predecessors {
+		edge.Source, edge.Target = source, hash
+		edges = append(edges, edge)
+	}
+
+	return edges, nil
+}
+
+// outEdges returns the outgoing edges of the given vertex, using the edge index
+// of the store if available.
+func outEdges[K comparable, T any](g Graph[K, T], hash K) ([]Edge[K], error) {
+	if index, ok := storeOf(g).(edgeIndex[K]); ok {
+		edges, err := index.OutEdges(hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+		return edges, nil
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	adjacencies, ok := adjacencyMap[hash]
+	if !ok {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", hash, ErrVertexNotFound)
+	}
+
+	edges := make([]Edge[K], 0, len(adjacencies))
+	for target, edge := range adjacencies {
+		edge.Source, edge.Target = hash, target
+		edges = append(edges, edge)
+	}
+
+	return edges, nil
+}
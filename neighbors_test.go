@@ -0,0 +1,177 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+// wrappedGraph hides the graph implementation, so that functions can't use the
+// fast paths provided by the store.
+type wrappedGraph[K comparable, T any] struct {
+	Graph[K, T]
+}
+
+func newNeighborsGraphs(t *testing.T, isDirected bool) map[string]Graph[int, int] {
+	var g Graph[int, int]
+
+	if isDirected {
+		g = New(IntHash, Directed(), Weighted())
+	} else {
+		g = New(IntHash, Weighted())
+	}
+
+	for _, vertex := range []int{1, 2, 3, 4, 5} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2, EdgeWeight(3))
+	_ = g.AddEdge(1, 3, EdgeWeight(1))
+	_ = g.AddEdge(2, 3, EdgeWeight(4))
+	_ = g.AddEdge(3, 3, EdgeWeight(2))
+	_ = g.AddEdge(4, 3, EdgeWeight(5))
+
+	compact, err := Compact(g, func(k int) uint64 { return uint64(k) })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return map[string]Graph[int, int]{
+		"memory store":  g,
+		"compact store": compact,
+		"other graph":   wrappedGraph[int, int]{g},
+	}
+}
+
+func TestPredecessors(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		vertex      int
+		expected    []int
+		expectedErr error
+	}{
+		"directed graph": {
+			isDirected: true,
+			vertex:     3,
+			expected:   []int{1, 2, 3, 4},
+		},
+		"directed graph without predecessors": {
+			isDirected: true,
+			vertex:     1,
+			expected:   []int{},
+		},
+		"undirected graph": {
+			vertex:   1,
+			expected: []int{2, 3},
+		},
+		"isolated vertex": {
+			isDirected: true,
+			vertex:     5,
+			expected:   []int{},
+		},
+		"unknown vertex": {
+			isDirected:  true,
+			vertex:      6,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		for graphName, g := range newNeighborsGraphs(t, test.isDirected) {
+			t.Run(name+", "+graphName, func(t *testing.T) {
+				predecessors, err := Predecessors(g, test.vertex)
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				sort.Ints(predecessors)
+
+				if !slicesAreEqual(predecessors, test.expected) {
+					t.Errorf("expected predecessors %v, got %v", test.expected, predecessors)
+				}
+
+				inDegree, err := InDegree(g, test.vertex)
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				if inDegree != len(test.expected) {
+					t.Errorf("expected in-degree %d, got %d", len(test.expected), inDegree)
+				}
+			})
+		}
+	}
+}
+
+func TestSuccessors(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		vertex      int
+		expected    []int
+		expectedErr error
+	}{
+		"directed graph": {
+			isDirected: true,
+			vertex:     1,
+			expected:   []int{2, 3},
+		},
+		"directed graph with self-loop": {
+			isDirected: true,
+			vertex:     3,
+			expected:   []int{3},
+		},
+		"undirected graph": {
+			vertex:   3,
+			expected: []int{1, 2, 3, 4},
+		},
+		"unknown vertex": {
+			vertex:      6,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		for graphName, g := range newNeighborsGraphs(t, test.isDirected) {
+			t.Run(name+", "+graphName, func(t *testing.T) {
+				successors, err := Successors(g, test.vertex)
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				sort.Ints(successors)
+
+				if !slicesAreEqual(successors, test.expected) {
+					t.Errorf("expected successors %v, got %v", test.expected, successors)
+				}
+
+				outDegree, err := OutDegree(g, test.vertex)
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				if outDegree != len(test.expected) {
+					t.Errorf("expected out-degree %d, got %d", len(test.expected), outDegree)
+				}
+			})
+		}
+	}
+}
+
+func TestInEdges_Properties(t *testing.T) {
+	for graphName, g := range newNeighborsGraphs(t, true) {
+		t.Run(graphName, func(t *testing.T) {
+			edges, err := inEdges(g, 2)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(edges) != 1 {
+				t.Fatalf("expected 1 edge, got %v", edges)
+			}
+
+			if edges[0].Source != 1 || edges[0].Target != 2 || edges[0].Properties.Weight != 3 {
+				t.Errorf("expected edge (1, 2) with weight 3, got %v", edges[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,437 @@
+package graph
+
+import (
+	"sort"
+	"sync"
+)
+
+// orderedStore is a Store implementation that enumerates its vertices and
+// edges in a well-defined order instead of the random order of Go maps. The
+// vertices and edges are stored in maps for fast lookups like in the default
+// in-memory store, and in addition, the hashes of all vertices as well as the
+// adjacencies of each vertex are kept in ordered slices.
+type orderedStore[K comparable, T any] struct {
+	lock sync.RWMutex
+
+	// less determines the order of the vertex hashes. If it is nil, vertices
+	// and edges are ordered by the time they have been added.
+	less func(K, K) bool
+
+	vertices         map[K]T
+	vertexProperties map[K]VertexProperties
+	outEdges         map[K]map[K]Edge[K]
+	inEdges          map[K]map[K]Edge[K]
+
+	order     []K
+	outOrders map[K][]K
+	inOrders  map[K][]K
+}
+
+// NewOrderedStore creates a new in-memory store that enumerates its vertices
+// and edges in a deterministic order. This makes the output of functions that
+// enumerate the store, like [Graph.Edges], [VisitVertices], [VisitAdjacencies],
+// [Successors], or [Predecessors], reproducible:
+//
+//	// Enumerate vertices and edges in the order they have been added.
+//	g := graph.NewWithStore(graph.StringHash, graph.NewOrderedStore[string, string](nil))
+//
+//	// Enumerate vertices and edges in lexicographic order of the hashes.
+//	g := graph.NewWithStore(graph.StringHash, graph.NewOrderedStore[string, string](func(a, b string) bool {
+//		return a < b
+//	}))
+//
+// If less is nil, the vertices are enumerated in the order they have been
+// added, and the edges of each vertex are enumerated in the order they have
+// been added. Otherwise, vertices are ordered by their hashes using less, and
+// the edges of each vertex are ordered by the hashes of their adjacent
+// vertices. [Graph.Edges] returns the outgoing edges of each vertex in the
+// order of the vertices.
+//
+// Maps such as the one returned by [Graph.AdjacencyMap] remain unordered.
+// [DFS], [BFS], [ShortestPath], and [TopologicalSort] iterate over the vertices
+// and adjacencies in the order of the store instead, so that their results are
+// reproducible, too. Other algorithms that work on these maps may still break
+// ties between equivalent results randomly. The ordered store takes more
+// memory than the default in-memory store, and removing a vertex or an edge
+// takes time linear in the number of vertices or edges of the vertex,
+// respectively.
+func NewOrderedStore[K comparable, T any](less func(K, K) bool) Store[K, T] {
+	return &orderedStore[K, T]{
+		less:             less,
+		vertices:         make(map[K]T),
+		vertexProperties: make(map[K]VertexProperties),
+		outEdges:         make(map[K]map[K]Edge[K]),
+		inEdges:          make(map[K]map[K]Edge[K]),
+		order:            make([]K, 0),
+		outOrders:        make(map[K][]K),
+		inOrders:         make(map[K][]K),
+	}
+}
+
+func (s *orderedStore[K, T]) AddVertex(k K, t T, p VertexProperties) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.vertices[k]; ok {
+		return ErrVertexAlreadyExists
+	}
+
+	s.vertices[k] = t
+	s.vertexProperties[k] = p
+	s.order = s.insert(s.order, k)
+
+	return nil
+}
+
+// UpdateVertex replaces the value and properties of an existing vertex while
+// keeping its edges and its position in the order of the vertices.
+func (s *orderedStore[K, T]) UpdateVertex(k K, t T, p VertexProperties) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.vertices[k]; !ok {
+		return ErrVertexNotFound
+	}
+
+	s.vertices[k] = t
+	s.vertexProperties[k] = p
+
+	return nil
+}
+
+func (s *orderedStore[K, T]) ListVertices() ([]K, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	hashes := make([]K, len(s.order))
+	copy(hashes, s.order)
+
+	return hashes, nil
+}
+
+func (s *orderedStore[K, T]) VertexCount() (int, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return len(s.vertices), nil
+}
+
+func (s *orderedStore[K, T]) Vertex(k K) (T, VertexProperties, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	v, ok := s.vertices[k]
+	if !ok {
+		return v, VertexProperties{}, ErrVertexNotFound
+	}
+
+	return v, s.vertexProperties[k], nil
+}
+
+func (s *orderedStore[K, T]) RemoveVertex(k K) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.vertices[k]; !ok {
+		return ErrVertexNotFound
+	}
+
+	if len(s.inEdges[k]) > 0 || len(s.outEdges[k]) > 0 {
+		return ErrVertexHasEdges
+	}
+
+	delete(s.vertices, k)
+	delete(s.vertexProperties, k)
+	delete(s.outEdges, k)
+	delete(s.inEdges, k)
+	delete(s.outOrders, k)
+	delete(s.inOrders, k)
+	s.order = s.remove(s.order, k)
+
+	return nil
+}
+
+func (s *orderedStore[K, T]) AddEdge(sourceHash, targetHash K, edge Edge[K]) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.outEdges[sourceHash]; !ok {
+		s.outEdges[sourceHash] = make(map[K]Edge[K])
+	}
+
+	if _, ok := s.inEdges[targetHash]; !ok {
+		s.inEdges[targetHash] = make(map[K]Edge[K])
+	}
+
+	if _, ok := s.outEdges[sourceHash][targetHash]; !ok {
+		s.outOrders[sourceHash] = s.insert(s.outOrders[sourceHash], targetHash)
+		s.inOrders[targetHash] = s.insert(s.inOrders[targetHash], sourceHash)
+	}
+
+	s.outEdges[sourceHash][targetHash] = edge
+	s.inEdges[targetHash][sourceHash] = edge
+
+	return nil
+}
+
+func (s *orderedStore[K, T]) UpdateEdge(sourceHash, targetHash K, edge Edge[K]) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.outEdges[sourceHash][targetHash]; !ok {
+		return ErrEdgeNotFound
+	}
+
+	s.outEdges[sourceHash][targetHash] = edge
+	s.inEdges[targetHash][sourceHash] = edge
+
+	return nil
+}
+
+// UpdateEdges updates the given edges under a single lock. If one of them
+// doesn't exist, ErrEdgeNotFound is returned and no edge is updated.
+func (s *orderedStore[K, T]) UpdateEdges(edges []Edge[K]) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, edge := range edges {
+		if _, ok := s.outEdges[edge.Source][edge.Target]; !ok {
+			return ErrEdgeNotFound
+		}
+	}
+
+	for _, edge := range edges {
+		s.outEdges[edge.Source][edge.Target] = edge
+		s.inEdges[edge.Target][edge.Source] = edge
+	}
+
+	return nil
+}
+
+func (s *orderedStore[K, T]) RemoveEdge(sourceHash, targetHash K) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.outEdges[sourceHash][targetHash]; !ok {
+		return nil
+	}
+
+	delete(s.outEdges[sourceHash], targetHash)
+	delete(s.inEdges[targetHash], sourceHash)
+	s.outOrders[sourceHash] = s.remove(s.outOrders[sourceHash], targetHash)
+	s.inOrders[targetHash] = s.remove(s.inOrders[targetHash], sourceHash)
+
+	return nil
+}
+
+func (s *orderedStore[K, T]) Edge(sourceHash, targetHash K) (Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	edge, ok := s.outEdges[sourceHash][targetHash]
+	if !ok {
+		return Edge[K]{}, ErrEdgeNotFound
+	}
+
+	return edge, nil
+}
+
+func (s *orderedStore[K, T]) ListEdges() ([]Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	edges := make([]Edge[K], 0)
+
+	for _, source := range s.order {
+		for _, target := range s.outOrders[source] {
+			edges = append(edges, s.outEdges[source][target])
+		}
+	}
+
+	return edges, nil
+}
+
+// InEdges returns all ingoing edges of the given vertex in the order of their
+// source vertices.
+func (s *orderedStore[K, T]) InEdges(k K) ([]Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.vertices[k]; !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	edges := make([]Edge[K], 0, len(s.inOrders[k]))
+	for _, source := range s.inOrders[k] {
+		edges = append(edges, s.inEdges[k][source])
+	}
+
+	return edges, nil
+}
+
+// OutEdges returns all outgoing edges of the given vertex in the order of their
+// target vertices.
+func (s *orderedStore[K, T]) OutEdges(k K) ([]Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.vertices[k]; !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	edges := make([]Edge[K], 0, len(s.outOrders[k]))
+	for _, target := range s.outOrders[k] {
+		edges = append(edges, s.outEdges[k][target])
+	}
+
+	return edges, nil
+}
+
+// VisitVertices is a fastpath version of [VisitVertices] that iterates over the
+// ordered vertex hashes directly while holding the read lock.
+func (s *orderedStore[K, T]) VisitVertices(visit func(hash K) bool) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, k := range s.order {
+		if visit(k) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// VisitOutEdges is a fastpath version of [VisitAdjacencies].
+func (s *orderedStore[K, T]) VisitOutEdges(k K, visit func(edge Edge[K]) bool) error {
+	return s.visitEdges(k, s.outEdges, s.outOrders, visit)
+}
+
+// VisitInEdges is a fastpath version of [VisitPredecessors].
+func (s *orderedStore[K, T]) VisitInEdges(k K, visit func(edge Edge[K]) bool) error {
+	return s.visitEdges(k, s.inEdges, s.inOrders, visit)
+}
+
+func (s *orderedStore[K, T]) visitEdges(k K, edges map[K]map[K]Edge[K], orders map[K][]K, visit func(edge Edge[K]) bool) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.vertices[k]; !ok {
+		return ErrVertexNotFound
+	}
+
+	for _, adjacency := range orders[k] {
+		if visit(edges[k][adjacency]) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// hashOrder is implemented by stores that enumerate their vertices and edges
+// in a well-defined order. Algorithms that iterate over an adjacency or
+// predecessor map use it to visit the vertices and adjacencies in that order
+// instead of the random order of Go maps, which makes their tie-breaking
+// reproducible.
+type hashOrder[K comparable] interface {
+	vertexOrder() []K
+	adjacencyOrder(hash K) []K
+}
+
+func (s *orderedStore[K, T]) vertexOrder() []K {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	hashes := make([]K, len(s.order))
+	copy(hashes, s.order)
+
+	return hashes
+}
+
+func (s *orderedStore[K, T]) adjacencyOrder(k K) []K {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	hashes := make([]K, len(s.outOrders[k]))
+	copy(hashes, s.outOrders[k])
+
+	return hashes
+}
+
+// orderOf returns the hashOrder of the store of the given graph, or nil if the
+// store doesn't enumerate its vertices and edges in a well-defined order.
+func orderOf[K comparable, T any](g Graph[K, T]) hashOrder[K] {
+	order, _ := rawStoreOf(g).(hashOrder[K])
+	return order
+}
+
+// orderedVertices returns the vertices of the given adjacency or predecessor
+// map in the given order, or in the order of the map if order is nil.
+func orderedVertices[K comparable](order hashOrder[K], m map[K]map[K]Edge[K]) []K {
+	hashes := make([]K, 0, len(m))
+
+	if order == nil {
+		for hash := range m {
+			hashes = append(hashes, hash)
+		}
+		return hashes
+	}
+
+	for _, hash := range order.vertexOrder() {
+		if _, ok := m[hash]; ok {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	return hashes
+}
+
+// rangeAdjacencies calls f for each entry of the given adjacencies of a vertex
+// in the given order, or in the order of the map if order is nil.
+func rangeAdjacencies[K comparable](order hashOrder[K], hash K, adjacencies map[K]Edge[K], f func(adjacency K, edge Edge[K])) {
+	if order == nil {
+		for adjacency, edge := range adjacencies {
+			f(adjacency, edge)
+		}
+		return
+	}
+
+	for _, adjacency := range order.adjacencyOrder(hash) {
+		if edge, ok := adjacencies[adjacency]; ok {
+			f(adjacency, edge)
+		}
+	}
+}
+
+// insert inserts the given hash into the ordered slice of hashes. Without a
+// less function, it is appended to the end. Otherwise, its position is found
+// using a binary search.
+func (s *orderedStore[K, T]) insert(hashes []K, k K) []K {
+	if s.less == nil {
+		return append(hashes, k)
+	}
+
+	i := sort.Search(len(hashes), func(i int) bool {
+		return !s.less(hashes[i], k)
+	})
+
+	var zero K
+	hashes = append(hashes, zero)
+	copy(hashes[i+1:], hashes[i:])
+	hashes[i] = k
+
+	return hashes
+}
+
+// remove removes the given hash from the ordered slice of hashes, keeping the
+// order of the remaining hashes.
+func (s *orderedStore[K, T]) remove(hashes []K, k K) []K {
+	for i, hash := range hashes {
+		if hash == k {
+			return append(hashes[:i], hashes[i+1:]...)
+		}
+	}
+
+	return hashes
+}
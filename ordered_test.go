@@ -0,0 +1,201 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedStore(t *testing.T) {
+	tests := map[string]struct {
+		less               func(a, b int) bool
+		vertices           []int
+		edges              [][2]int
+		expectedVertices   []int
+		expectedEdges      [][2]int
+		expectedSuccessors []int
+	}{
+		"insertion order": {
+			vertices:           []int{3, 1, 4, 2},
+			edges:              [][2]int{{3, 4}, {1, 2}, {3, 1}, {3, 2}},
+			expectedVertices:   []int{3, 1, 4, 2},
+			expectedEdges:      [][2]int{{3, 4}, {3, 1}, {3, 2}, {1, 2}},
+			expectedSuccessors: []int{4, 1, 2},
+		},
+		"sorted order": {
+			less: func(a, b int) bool {
+				return a < b
+			},
+			vertices:           []int{3, 1, 4, 2},
+			edges:              [][2]int{{3, 4}, {1, 2}, {3, 1}, {3, 2}},
+			expectedVertices:   []int{1, 2, 3, 4},
+			expectedEdges:      [][2]int{{1, 2}, {3, 1}, {3, 2}, {3, 4}},
+			expectedSuccessors: []int{1, 2, 4},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithStore(IntHash, NewOrderedStore[int, int](test.less), Directed())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge[0], edge[1]); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			vertices := make([]int, 0)
+			_ = VisitVertices(g, func(hash int) bool {
+				vertices = append(vertices, hash)
+				return false
+			})
+
+			if !reflect.DeepEqual(vertices, test.expectedVertices) {
+				t.Errorf("expected vertices %v, got %v", test.expectedVertices, vertices)
+			}
+
+			edges, _ := g.Edges()
+
+			if len(edges) != len(test.expectedEdges) {
+				t.Fatalf("expected %d edges, got %d", len(test.expectedEdges), len(edges))
+			}
+
+			for i, edge := range edges {
+				if edge.Source != test.expectedEdges[i][0] || edge.Target != test.expectedEdges[i][1] {
+					t.Errorf("expected edge %v at index %d, got (%v, %v)", test.expectedEdges[i], i, edge.Source, edge.Target)
+				}
+			}
+
+			successors, _ := Successors(g, 3)
+
+			if !reflect.DeepEqual(successors, test.expectedSuccessors) {
+				t.Errorf("expected successors %v, got %v", test.expectedSuccessors, successors)
+			}
+		})
+	}
+}
+
+func TestOrderedStore_algorithms(t *testing.T) {
+	newGraph := func() Graph[int, int] {
+		g := NewWithStore(IntHash, NewOrderedStore[int, int](func(a, b int) bool {
+			return a < b
+		}), Directed())
+
+		for _, vertex := range []int{5, 4, 3, 2, 1} {
+			_ = g.AddVertex(vertex)
+		}
+
+		_ = g.AddEdge(1, 3)
+		_ = g.AddEdge(1, 2)
+		_ = g.AddEdge(2, 4)
+		_ = g.AddEdge(3, 4)
+		_ = g.AddEdge(4, 5)
+
+		return g
+	}
+
+	tests := map[string]struct {
+		run      func(g Graph[int, int]) ([]int, error)
+		expected []int
+	}{
+		"BFS": {
+			run: func(g Graph[int, int]) ([]int, error) {
+				visited := make([]int, 0)
+				err := BFS(g, 1, func(hash int) bool {
+					visited = append(visited, hash)
+					return false
+				})
+				return visited, err
+			},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		"DFS": {
+			run: func(g Graph[int, int]) ([]int, error) {
+				visited := make([]int, 0)
+				err := DFS(g, 1, func(hash int) bool {
+					visited = append(visited, hash)
+					return false
+				})
+				return visited, err
+			},
+			expected: []int{1, 3, 4, 5, 2},
+		},
+		"ShortestPath": {
+			run: func(g Graph[int, int]) ([]int, error) {
+				return ShortestPath(g, 1, 5)
+			},
+			expected: []int{1, 2, 4, 5},
+		},
+		"TopologicalSort": {
+			run:      TopologicalSort[int, int],
+			expected: []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Run the algorithm several times, since a random order might
+			// yield the expected result by chance.
+			for i := 0; i < 20; i++ {
+				result, err := test.run(newGraph())
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if !reflect.DeepEqual(result, test.expected) {
+					t.Fatalf("expected %v, got %v", test.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestOrderedStore_remove(t *testing.T) {
+	store := NewOrderedStore[int, int](nil)
+
+	for _, vertex := range []int{1, 2, 3, 4} {
+		_ = store.AddVertex(vertex, vertex, VertexProperties{})
+	}
+
+	for _, edge := range [][2]int{{1, 2}, {1, 3}, {1, 4}} {
+		_ = store.AddEdge(edge[0], edge[1], Edge[int]{Source: edge[0], Target: edge[1]})
+	}
+
+	if err := store.RemoveVertex(3); !errors.Is(err, ErrVertexHasEdges) {
+		t.Fatalf("expected error %v, got %v", ErrVertexHasEdges, err)
+	}
+
+	if err := store.RemoveEdge(1, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := store.RemoveVertex(3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	vertices, _ := store.ListVertices()
+
+	if !reflect.DeepEqual(vertices, []int{1, 2, 4}) {
+		t.Errorf("expected vertices %v, got %v", []int{1, 2, 4}, vertices)
+	}
+
+	edges, _ := store.(edgeIndex[int]).OutEdges(1)
+
+	if len(edges) != 2 || edges[0].Target != 2 || edges[1].Target != 4 {
+		t.Errorf("expected edges to 2 and 4, got %v", edges)
+	}
+
+	// Adding a removed edge again puts it at the end.
+	_ = store.AddEdge(1, 3, Edge[int]{Source: 1, Target: 3})
+	_ = store.AddVertex(3, 3, VertexProperties{})
+
+	edges, _ = store.(edgeIndex[int]).OutEdges(1)
+
+	if len(edges) != 3 || edges[2].Target != 3 {
+		t.Errorf("expected edge to 3 at the end, got %v", edges)
+	}
+}
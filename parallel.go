@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultParallelism is the maximum number of goroutines used by parallel
+// algorithms if no per-call limit is set. Zero means GOMAXPROCS.
+var defaultParallelism int64
+
+// SetParallelism sets the maximum number of goroutines that parallel algorithms
+// like Compute or AggregateNeighbors use by default. This is useful on shared
+// machines where the library shouldn't use all CPUs. A value of zero or less
+// restores the default, which is the number of usable CPUs as reported by
+// runtime.GOMAXPROCS.
+//
+// The limit applies to all subsequent calls and can be overridden per call
+// using [WithParallelism]. SetParallelism is safe for concurrent use.
+func SetParallelism(n int) {
+	atomic.StoreInt64(&defaultParallelism, int64(n))
+}
+
+// WithParallelism limits the number of goroutines used by a parallel algorithm
+// like Compute or AggregateNeighbors to n, overriding the limit set using
+// [SetParallelism]. WithParallelism(1) runs the algorithm sequentially. A value
+// of zero or less uses the default limit.
+func WithParallelism(n int) func(*computation) {
+	return func(c *computation) {
+		c.parallelism = n
+	}
+}
+
+// workerCount returns the number of workers for processing n items in parallel.
+// This is the given per-call parallelism if positive, the default parallelism
+// otherwise, but not more than n.
+func workerCount(parallelism, n int) int {
+	workers := parallelism
+
+	if workers <= 0 {
+		workers = int(atomic.LoadInt64(&defaultParallelism))
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > n {
+		workers = n
+	}
+
+	return workers
+}
+
+// runParallel splits the range [0, n) into contiguous chunks, one per worker,
+// and calls work for each chunk in a separate goroutine. It returns after all
+// workers have finished.
+func runParallel(workers, n int, work func(worker, start, end int)) {
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < workers; worker++ {
+		start := worker * n / workers
+		end := (worker + 1) * n / workers
+
+		wg.Add(1)
+
+		go func(worker, start, end int) {
+			defer wg.Done()
+			work(worker, start, end)
+		}(worker, start, end)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestWorkerCount(t *testing.T) {
+	defer SetParallelism(0)
+
+	tests := map[string]struct {
+		defaultParallelism int
+		parallelism        int
+		n                  int
+		expected           int
+	}{
+		"per-call parallelism": {
+			parallelism: 3,
+			n:           100,
+			expected:    3,
+		},
+		"default parallelism": {
+			defaultParallelism: 2,
+			n:                  100,
+			expected:           2,
+		},
+		"per-call parallelism overrides default": {
+			defaultParallelism: 2,
+			parallelism:        5,
+			n:                  100,
+			expected:           5,
+		},
+		"not more than items": {
+			parallelism: 8,
+			n:           3,
+			expected:    3,
+		},
+		"GOMAXPROCS": {
+			n:        1 << 20,
+			expected: runtime.GOMAXPROCS(0),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			SetParallelism(test.defaultParallelism)
+
+			if workers := workerCount(test.parallelism, test.n); workers != test.expected {
+				t.Errorf("expected %d workers, got %d", test.expected, workers)
+			}
+		})
+	}
+}
+
+func TestWithParallelism(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 100; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	for i := 0; i < 99; i++ {
+		_ = g.AddEdge(i, i+1)
+	}
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+
+	mapper := func(Edge[int]) int {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		runtime.Gosched()
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		return 1
+	}
+
+	sum := func(a, b int) int { return a + b }
+
+	counts, err := AggregateNeighbors(g, mapper, sum, WithParallelism(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(counts) != 99 {
+		t.Errorf("expected 99 counts, got %d", len(counts))
+	}
+
+	if maxRunning != 1 {
+		t.Errorf("expected sequential execution, got %d concurrent calls", maxRunning)
+	}
+
+	states, err := Compute(g, func(v int) int { return v },
+		func(v int, state int, inbox []int) (int, []Message[int, int]) {
+			return mapper(Edge[int]{}) + state, nil
+		}, WithParallelism(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if states[5] != 6 {
+		t.Errorf("expected state 6, got %d", states[5])
+	}
+
+	if maxRunning != 1 {
+		t.Errorf("expected sequential execution, got %d concurrent calls", maxRunning)
+	}
+}
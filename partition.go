@@ -0,0 +1,268 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// partitionImbalance is the fraction by which the size of a part computed
+	// by Partition may deviate from the average size of all parts.
+	partitionImbalance = 0.05
+	// maxPartitionPasses limits the number of refinement passes of Partition.
+	maxPartitionPasses = 20
+	// partitionTries is the number of seed vertices that Partition starts
+	// growing the parts from.
+	partitionTries = 4
+)
+
+// Partition splits the vertices of the given graph into k parts of roughly equal
+// size while minimizing the number of edges between different parts, the cut.
+// This is useful for distributing work across k workers, where edges between
+// parts would require communication. The returned map assigns each vertex the
+// index of its part, ranging from 0 to k-1:
+//
+//	parts, _ := graph.Partition(g, 4)
+//
+//	for vertex, part := range parts {
+//		workers[part].Assign(vertex)
+//	}
+//
+// Graph partitioning is NP-hard, so Partition uses a heuristic: First, the parts
+// are grown one after another from a seed vertex, always adding the unassigned
+// vertex whose addition increases the cut the least. Then, the partition is
+// refined in several passes over all vertices, where each vertex is moved to the
+// adjacent part that reduces the cut the most, as long as the sizes of all parts
+// stay within 5% of the average size. The refinement stops as soon as a pass no
+// longer reduces the cut. Each pass takes O(|V|+|E|) time. This is repeated for
+// a few different seed vertices, and the partition with the smallest cut wins.
+//
+// The direction of the edges is ignored. In a weighted graph, the cut is the sum
+// of the weights of the cut edges. If k is larger than the number of vertices,
+// some parts remain empty.
+func Partition[K comparable, T any](g Graph[K, T], k int) (map[K]int, error) {
+	if k < 1 {
+		return nil, fmt.Errorf("number of parts must be at least 1, got %d", k)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	indices := make(map[K]int, len(adjacencyMap))
+	hashes := make([]K, 0, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		indices[hash] = len(hashes)
+		hashes = append(hashes, hash)
+	}
+
+	n := len(hashes)
+	isDirected := g.Traits().IsDirected
+	isWeighted := g.Traits().IsWeighted
+
+	// neighbors contains the total weight of the edges between each pair of
+	// adjacent vertices, regardless of their direction. The adjacency map of
+	// an undirected graph already contains each edge in both directions.
+	neighbors := make([]map[int]float64, n)
+
+	for i := range neighbors {
+		neighbors[i] = make(map[int]float64)
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			if source == target {
+				continue
+			}
+
+			weight := 1.0
+			if isWeighted {
+				weight = float64(edge.Properties.Weight)
+			}
+
+			s, t := indices[source], indices[target]
+
+			neighbors[s][t] += weight
+			if isDirected {
+				neighbors[t][s] += weight
+			}
+		}
+	}
+
+	p := &partitioning{
+		k:         k,
+		neighbors: neighbors,
+		degrees:   make([]float64, n),
+	}
+
+	for vertex, adjacencies := range neighbors {
+		for _, weight := range adjacencies {
+			p.degrees[vertex] += weight
+		}
+	}
+
+	var best []int
+	bestCut := math.Inf(1)
+
+	// Each try grows the parts starting from a different seed vertex, and the
+	// partition with the smallest cut is kept.
+	for try := 0; try < partitionTries && try < n; try++ {
+		parts := p.grow(try * n / partitionTries)
+		p.refine(parts)
+
+		if cut := p.cut(parts); cut < bestCut {
+			best, bestCut = parts, cut
+		}
+	}
+
+	m := make(map[K]int, n)
+
+	for i, hash := range hashes {
+		m[hash] = best[i]
+	}
+
+	return m, nil
+}
+
+// partitioning holds the state of Partition, where vertices are represented by
+// their indices.
+type partitioning struct {
+	k         int
+	neighbors []map[int]float64
+	// degrees contains the total weight of the edges of each vertex.
+	degrees []float64
+}
+
+// grow assigns the vertices to the parts by growing one part after another,
+// starting with the given seed vertex. A part is grown by adding the vertex
+// with the highest gain, which is the weight of its edges into the part minus
+// the weight of its other edges, so that the cut increases as little as
+// possible.
+func (p *partitioning) grow(seed int) []int {
+	n := len(p.neighbors)
+
+	parts := make([]int, n)
+	for i := range parts {
+		parts[i] = -1
+	}
+
+	for part := 0; part < p.k; part++ {
+		size := n / p.k
+		if part < n%p.k {
+			size++
+		}
+
+		queue := newPriorityQueue[int]()
+		connections := make(map[int]float64)
+
+		for grown := 0; grown < size; grown++ {
+			// If the part cannot grow any further, for example because the
+			// graph is disconnected, it is continued from a new seed vertex.
+			if queue.Len() == 0 {
+				for parts[seed] != -1 {
+					seed = (seed + 1) % n
+				}
+				queue.Push(seed, 0)
+			}
+
+			vertex, _ := queue.Pop()
+			parts[vertex] = part
+
+			for neighbor, weight := range p.neighbors[vertex] {
+				if parts[neighbor] != -1 {
+					continue
+				}
+
+				_, ok := connections[neighbor]
+				connections[neighbor] += weight
+
+				// The priority queue pops the lowest priority first, so the
+				// highest gain gets the lowest priority.
+				priority := p.degrees[neighbor] - 2*connections[neighbor]
+
+				if ok {
+					queue.UpdatePriority(neighbor, priority)
+				} else {
+					queue.Push(neighbor, priority)
+				}
+			}
+		}
+	}
+
+	return parts
+}
+
+// refine repeatedly moves vertices to the adjacent part that reduces the cut the
+// most, keeping the sizes of all parts within the allowed imbalance.
+func (p *partitioning) refine(parts []int) {
+	average := float64(len(parts)) / float64(p.k)
+	maxSize := int(math.Ceil(average * (1 + partitionImbalance)))
+	minSize := int(math.Floor(average * (1 - partitionImbalance)))
+
+	sizes := make([]int, p.k)
+	for _, part := range parts {
+		sizes[part]++
+	}
+
+	connections := make(map[int]float64)
+
+	for pass := 0; pass < maxPartitionPasses; pass++ {
+		isImproved := false
+
+		for vertex, part := range parts {
+			if sizes[part] <= minSize {
+				continue
+			}
+
+			for other := range connections {
+				delete(connections, other)
+			}
+
+			for neighbor, weight := range p.neighbors[vertex] {
+				connections[parts[neighbor]] += weight
+			}
+
+			best, bestGain := -1, 0.0
+
+			for other, connection := range connections {
+				if other == part || sizes[other] >= maxSize {
+					continue
+				}
+
+				if gain := connection - connections[part]; gain > bestGain {
+					best, bestGain = other, gain
+				}
+			}
+
+			if best == -1 {
+				continue
+			}
+
+			parts[vertex] = best
+			sizes[part]--
+			sizes[best]++
+			isImproved = true
+		}
+
+		if !isImproved {
+			break
+		}
+	}
+}
+
+// cut returns the total weight of the edges between different parts.
+func (p *partitioning) cut(parts []int) float64 {
+	cut := 0.0
+
+	for vertex, adjacencies := range p.neighbors {
+		for neighbor, weight := range adjacencies {
+			if parts[vertex] != parts[neighbor] {
+				cut += weight
+			}
+		}
+	}
+
+	return cut / 2
+}
@@ -0,0 +1,176 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPartition(t *testing.T) {
+	tests := map[string]struct {
+		traits      []func(*Traits)
+		vertices    []int
+		edges       []Edge[int]
+		k           int
+		expectedCut int
+		shouldFail  bool
+	}{
+		"two cliques": {
+			vertices: []int{1, 2, 3, 4, 5, 6, 7, 8},
+			edges: append(append(cliqueEdges(1, 2, 3, 4), cliqueEdges(5, 6, 7, 8)...),
+				Edge[int]{Source: 4, Target: 5},
+			),
+			k:           2,
+			expectedCut: 1,
+		},
+		"three directed cliques": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			edges: append(append(append(cliqueEdges(1, 2, 3), cliqueEdges(4, 5, 6)...), cliqueEdges(7, 8, 9)...),
+				Edge[int]{Source: 3, Target: 4},
+				Edge[int]{Source: 6, Target: 7},
+			),
+			k:           3,
+			expectedCut: 2,
+		},
+		"single part": {
+			vertices:    []int{1, 2, 3},
+			edges:       []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}},
+			k:           1,
+			expectedCut: 0,
+		},
+		"more parts than vertices": {
+			vertices:    []int{1, 2},
+			edges:       []Edge[int]{{Source: 1, Target: 2}},
+			k:           3,
+			expectedCut: 1,
+		},
+		"disconnected graph": {
+			vertices:    []int{1, 2, 3, 4},
+			edges:       []Edge[int]{{Source: 1, Target: 2}, {Source: 3, Target: 4}},
+			k:           2,
+			expectedCut: 0,
+		},
+		"invalid number of parts": {
+			vertices:   []int{1},
+			k:          0,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			parts, err := Partition(g, test.k)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			assertBalancedPartition(t, parts, len(test.vertices), test.k)
+
+			if cut := cutSize(test.edges, parts); cut != test.expectedCut {
+				t.Errorf("expected cut %d, got %d for parts %v", test.expectedCut, cut, parts)
+			}
+		})
+	}
+}
+
+func TestPartition_grid(t *testing.T) {
+	g := New(IntHash)
+
+	for vertex := 0; vertex < 100; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	edges := make([]Edge[int], 0)
+
+	for vertex := 0; vertex < 100; vertex++ {
+		if vertex%10 < 9 {
+			edges = append(edges, Edge[int]{Source: vertex, Target: vertex + 1})
+		}
+		if vertex < 90 {
+			edges = append(edges, Edge[int]{Source: vertex, Target: vertex + 10})
+		}
+	}
+
+	rand.New(rand.NewSource(1)).Shuffle(len(edges), func(i, j int) {
+		edges[i], edges[j] = edges[j], edges[i]
+	})
+
+	for _, edge := range edges {
+		_ = g.AddEdge(copyEdge(edge))
+	}
+
+	parts, err := Partition(g, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertBalancedPartition(t, parts, 100, 4)
+
+	// An optimal partition into 4 quadrants cuts 20 edges, and random parts
+	// would cut about 135 of the 180 edges.
+	if cut := cutSize(edges, parts); cut > 40 {
+		t.Errorf("expected cut of at most 40, got %d", cut)
+	}
+}
+
+func assertBalancedPartition(t *testing.T, parts map[int]int, n, k int) {
+	t.Helper()
+
+	if len(parts) != n {
+		t.Fatalf("expected %d assigned vertices, got %d", n, len(parts))
+	}
+
+	sizes := make(map[int]int)
+
+	for _, part := range parts {
+		if part < 0 || part >= k {
+			t.Fatalf("expected part in [0, %d), got %d", k, part)
+		}
+		sizes[part]++
+	}
+
+	for part, size := range sizes {
+		if float64(size) > float64(n)/float64(k)*1.05+1 {
+			t.Errorf("expected balanced parts, got size %d for part %d", size, part)
+		}
+	}
+}
+
+func cutSize(edges []Edge[int], parts map[int]int) int {
+	cut := 0
+
+	for _, edge := range edges {
+		if parts[edge.Source] != parts[edge.Target] {
+			cut++
+		}
+	}
+
+	return cut
+}
+
+func cliqueEdges(vertices ...int) []Edge[int] {
+	edges := make([]Edge[int], 0)
+
+	for i := range vertices {
+		for j := i + 1; j < len(vertices); j++ {
+			edges = append(edges, Edge[int]{Source: vertices[i], Target: vertices[j]})
+		}
+	}
+
+	return edges
+}
@@ -0,0 +1,252 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Path is a walk through a graph, consisting of its vertices in order and the
+// edges between them. In contrast to the plain slices of vertex hashes returned
+// by [ShortestPath] or [AllPathsBetween], a Path also contains the vertex
+// values, the edges including their properties, and the total weight. A path
+// is created from such a slice using [NewPath]:
+//
+//	hashes, _ := graph.ShortestPath(g, "A", "C")
+//	path, _ := graph.NewPath(g, hashes)
+//
+//	fmt.Printf("A -> C costs %d\n", path.Weight)
+//
+// The path algorithms keep returning plain slices for compatibility, and each
+// of them has a variant that returns a Path instead, like
+// [ShortestPathWithEdges] for [ShortestPath].
+//
+// A path created using NewPath always contains at least one vertex, and it
+// contains one edge less than vertices. Edges[i] connects Hashes[i] and
+// Hashes[i+1]. The zero value is an empty path without any vertices.
+type Path[K comparable, T any] struct {
+	Hashes   []K
+	Vertices []T
+	Edges    []Edge[K]
+	// Weight is the sum of the edge weights. In unweighted graphs, each edge
+	// has a weight of 1, so that the weight equals the number of edges.
+	Weight int
+}
+
+// NewPath creates a path from the given vertex hashes, which have to be
+// connected by edges in g. If a vertex doesn't exist, the returned error wraps
+// ErrVertexNotFound, and if two consecutive vertices are not adjacent, it wraps
+// ErrEdgeNotFound. An empty slice of hashes is not a valid path.
+func NewPath[K comparable, T any](g Graph[K, T], hashes []K) (Path[K, T], error) {
+	if len(hashes) == 0 {
+		return Path[K, T]{}, errors.New("path must contain at least one vertex")
+	}
+
+	path := Path[K, T]{
+		Hashes:   append([]K(nil), hashes...),
+		Vertices: make([]T, len(hashes)),
+		Edges:    make([]Edge[K], 0, len(hashes)-1),
+	}
+
+	for i, hash := range hashes {
+		vertex, err := g.Vertex(hash)
+		if err != nil {
+			return Path[K, T]{}, fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+
+		path.Vertices[i] = vertex
+
+		if i == 0 {
+			continue
+		}
+
+		source := hashes[i-1]
+
+		edge, err := g.Edge(source, hash)
+		if err != nil {
+			return Path[K, T]{}, fmt.Errorf("could not get edge (%v, %v): %w", source, hash, err)
+		}
+
+		path.Edges = append(path.Edges, Edge[K]{
+			Source:     source,
+			Target:     hash,
+			Properties: edge.Properties,
+		})
+
+		if g.Traits().IsWeighted {
+			path.Weight += edge.Properties.Weight
+		} else {
+			path.Weight++
+		}
+	}
+
+	return path, nil
+}
+
+// Len returns the number of edges of the path.
+func (p Path[K, T]) Len() int {
+	return len(p.Edges)
+}
+
+// Source returns the hash of the first vertex of the path. For an empty path,
+// the zero value of K is returned.
+func (p Path[K, T]) Source() K {
+	var source K
+	if len(p.Hashes) > 0 {
+		source = p.Hashes[0]
+	}
+	return source
+}
+
+// Target returns the hash of the last vertex of the path. For an empty path,
+// the zero value of K is returned.
+func (p Path[K, T]) Target() K {
+	var target K
+	if len(p.Hashes) > 0 {
+		target = p.Hashes[len(p.Hashes)-1]
+	}
+	return target
+}
+
+// Contains reports whether the path visits the vertex with the given hash.
+func (p Path[K, T]) Contains(hash K) bool {
+	for _, h := range p.Hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// Reverse returns a new path that visits the vertices in reverse order. The
+// sources and targets of the edges are swapped accordingly. In a directed
+// graph, the reversed path only exists if each edge has a counterpart in the
+// opposite direction.
+func (p Path[K, T]) Reverse() Path[K, T] {
+	reversed := Path[K, T]{
+		Hashes:   make([]K, len(p.Hashes)),
+		Vertices: make([]T, len(p.Vertices)),
+		Edges:    make([]Edge[K], len(p.Edges)),
+		Weight:   p.Weight,
+	}
+
+	for i := range p.Hashes {
+		reversed.Hashes[i] = p.Hashes[len(p.Hashes)-1-i]
+		reversed.Vertices[i] = p.Vertices[len(p.Vertices)-1-i]
+	}
+
+	for i, edge := range p.Edges {
+		reversed.Edges[len(p.Edges)-1-i] = Edge[K]{
+			Source:     edge.Target,
+			Target:     edge.Source,
+			Properties: edge.Properties,
+		}
+	}
+
+	return reversed
+}
+
+// Concat returns a new path that consists of this path followed by the given
+// path. The given path has to start at the vertex where this path ends, which
+// is only contained once in the concatenated path. Otherwise, or if one of the
+// paths is empty, an error is returned.
+func (p Path[K, T]) Concat(other Path[K, T]) (Path[K, T], error) {
+	if len(p.Hashes) == 0 || len(other.Hashes) == 0 {
+		return Path[K, T]{}, errors.New("empty paths cannot be concatenated")
+	}
+
+	if p.Target() != other.Source() {
+		return Path[K, T]{}, fmt.Errorf("path ending at %v cannot be concatenated with path starting at %v", p.Target(), other.Source())
+	}
+
+	concatenated := Path[K, T]{
+		Hashes:   make([]K, 0, len(p.Hashes)+len(other.Hashes)-1),
+		Vertices: make([]T, 0, len(p.Vertices)+len(other.Vertices)-1),
+		Edges:    make([]Edge[K], 0, len(p.Edges)+len(other.Edges)),
+		Weight:   p.Weight + other.Weight,
+	}
+
+	concatenated.Hashes = append(append(concatenated.Hashes, p.Hashes...), other.Hashes[1:]...)
+	concatenated.Vertices = append(append(concatenated.Vertices, p.Vertices...), other.Vertices[1:]...)
+	concatenated.Edges = append(append(concatenated.Edges, p.Edges...), other.Edges...)
+
+	return concatenated, nil
+}
+
+// Subgraph creates a graph with the same traits as g that contains the vertices
+// and edges of the path, along with their properties in g. If the path visits a
+// vertex or traverses an edge several times, it is only added once.
+func (p Path[K, T]) Subgraph(g Graph[K, T]) (Graph[K, T], error) {
+	subgraph := NewLike(g)
+
+	for i, hash := range p.Hashes {
+		_, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+
+		err = subgraph.AddVertex(p.Vertices[i], copyVertexProperties(properties))
+		if err != nil && !errors.Is(err, ErrVertexAlreadyExists) {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+	}
+
+	for _, edge := range p.Edges {
+		if err := subgraph.AddEdge(copyEdge(edge)); err != nil && !errors.Is(err, ErrEdgeAlreadyExists) {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return subgraph, nil
+}
+
+// ShortestPathWithEdges is like [ShortestPath], but returns the shortest path
+// as a Path that includes the vertex values, the edges, and the total weight.
+func ShortestPathWithEdges[K comparable, T any](g Graph[K, T], source, target K, options ...func(*pathQuery[K])) (Path[K, T], error) {
+	hashes, err := ShortestPath(g, source, target, options...)
+	if err != nil {
+		return Path[K, T]{}, err
+	}
+
+	return NewPath(g, hashes)
+}
+
+// BidirectionalShortestPathWithEdges is like [BidirectionalShortestPath], but
+// returns the shortest path as a Path.
+func BidirectionalShortestPathWithEdges[K comparable, T any](g Graph[K, T], source, target K) (Path[K, T], error) {
+	hashes, err := BidirectionalShortestPath(g, source, target)
+	if err != nil {
+		return Path[K, T]{}, err
+	}
+
+	return NewPath(g, hashes)
+}
+
+// AllPathsBetweenWithEdges is like [AllPathsBetween], but returns each path as
+// a Path.
+func AllPathsBetweenWithEdges[K comparable, T any](g Graph[K, T], start, end K) ([]Path[K, T], error) {
+	allHashes, err := AllPathsBetween(g, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]Path[K, T], len(allHashes))
+
+	for i, hashes := range allHashes {
+		if paths[i], err = NewPath(g, hashes); err != nil {
+			return nil, err
+		}
+	}
+
+	return paths, nil
+}
+
+// LongestPathWithEdges is like [LongestPath], but returns the longest path as a
+// Path. For an empty graph, the path is empty.
+func LongestPathWithEdges[K comparable, T any](g Graph[K, T]) (Path[K, T], error) {
+	hashes, err := LongestPath(g)
+	if err != nil || len(hashes) == 0 {
+		return Path[K, T]{}, err
+	}
+
+	return NewPath(g, hashes)
+}
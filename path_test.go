@@ -0,0 +1,329 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewPath(t *testing.T) {
+	tests := map[string]struct {
+		isWeighted     bool
+		hashes         []int
+		expectedWeight int
+		expectedEdges  []Edge[int]
+		expectedErr    error
+	}{
+		"weighted path": {
+			isWeighted:     true,
+			hashes:         []int{1, 2, 3},
+			expectedWeight: 7,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 4}},
+			},
+		},
+		"unweighted path": {
+			hashes:         []int{1, 2, 3},
+			expectedWeight: 2,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 4}},
+			},
+		},
+		"single vertex": {
+			isWeighted:    true,
+			hashes:        []int{4},
+			expectedEdges: []Edge[int]{},
+		},
+		"missing edge": {
+			hashes:      []int{1, 4},
+			expectedErr: ErrEdgeNotFound,
+		},
+		"missing vertex": {
+			hashes:      []int{1, 10},
+			expectedErr: ErrVertexNotFound,
+		},
+		"empty path": {
+			hashes:      []int{},
+			expectedErr: errors.New("path must contain at least one vertex"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newPathGraph(test.isWeighted)
+
+			path, err := NewPath(g, test.hashes)
+
+			if test.expectedErr != nil {
+				if err == nil || (!errors.Is(err, test.expectedErr) && err.Error() != test.expectedErr.Error()) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if path.Weight != test.expectedWeight {
+				t.Errorf("expected weight %d, got %d", test.expectedWeight, path.Weight)
+			}
+
+			if !slicesAreEqual(path.Hashes, test.hashes) || !slicesAreEqual(path.Vertices, test.hashes) {
+				t.Errorf("expected vertices %v, got %v and %v", test.hashes, path.Hashes, path.Vertices)
+			}
+
+			if len(path.Edges) != len(test.expectedEdges) {
+				t.Fatalf("expected edges %v, got %v", test.expectedEdges, path.Edges)
+			}
+
+			for i, edge := range path.Edges {
+				expected := test.expectedEdges[i]
+				if edge.Source != expected.Source || edge.Target != expected.Target || edge.Properties.Weight != expected.Properties.Weight {
+					t.Errorf("expected edge %v at %d, got %v", expected, i, edge)
+				}
+			}
+		})
+	}
+}
+
+func TestPath_Reverse(t *testing.T) {
+	g := newPathGraph(true)
+	path, _ := NewPath(g, []int{1, 2, 3})
+
+	reversed := path.Reverse()
+
+	expectedHashes := []int{3, 2, 1}
+
+	for i, hash := range reversed.Hashes {
+		if hash != expectedHashes[i] || reversed.Vertices[i] != expectedHashes[i] {
+			t.Fatalf("expected vertices %v, got %v", expectedHashes, reversed.Hashes)
+		}
+	}
+
+	if reversed.Edges[0].Source != 3 || reversed.Edges[0].Target != 2 || reversed.Edges[0].Properties.Weight != 4 {
+		t.Errorf("expected first edge (3, 2) with weight 4, got %v", reversed.Edges[0])
+	}
+
+	if reversed.Weight != path.Weight {
+		t.Errorf("expected weight %d, got %d", path.Weight, reversed.Weight)
+	}
+
+	if path.Hashes[0] != 1 {
+		t.Errorf("expected original path to be unchanged, got %v", path.Hashes)
+	}
+
+	if reversed.Source() != 3 || reversed.Target() != 1 {
+		t.Errorf("expected path from 3 to 1, got path from %d to %d", reversed.Source(), reversed.Target())
+	}
+}
+
+func TestPath_Concat(t *testing.T) {
+	tests := map[string]struct {
+		first          []int
+		second         []int
+		expectedHashes []int
+		expectedWeight int
+		shouldFail     bool
+	}{
+		"adjacent paths": {
+			first:          []int{1, 2},
+			second:         []int{2, 3, 4},
+			expectedHashes: []int{1, 2, 3, 4},
+			expectedWeight: 12,
+		},
+		"single vertex path": {
+			first:          []int{1, 2},
+			second:         []int{2},
+			expectedHashes: []int{1, 2},
+			expectedWeight: 3,
+		},
+		"disjoint paths": {
+			first:      []int{1, 2},
+			second:     []int{3, 4},
+			shouldFail: true,
+		},
+		"empty path": {
+			first:      []int{},
+			second:     []int{1, 2},
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newPathGraph(true)
+
+			first, _ := NewPath(g, test.first)
+			second, _ := NewPath(g, test.second)
+
+			path, err := first.Concat(second)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if len(path.Hashes) != len(test.expectedHashes) || len(path.Vertices) != len(test.expectedHashes) {
+				t.Fatalf("expected vertices %v, got %v", test.expectedHashes, path.Hashes)
+			}
+
+			for i := range path.Hashes {
+				if path.Hashes[i] != test.expectedHashes[i] {
+					t.Fatalf("expected vertices %v, got %v", test.expectedHashes, path.Hashes)
+				}
+			}
+
+			if path.Len() != len(test.expectedHashes)-1 {
+				t.Errorf("expected %d edges, got %d", len(test.expectedHashes)-1, path.Len())
+			}
+
+			if path.Weight != test.expectedWeight {
+				t.Errorf("expected weight %d, got %d", test.expectedWeight, path.Weight)
+			}
+		})
+	}
+}
+
+func TestPath_Contains(t *testing.T) {
+	g := newPathGraph(true)
+	path, _ := NewPath(g, []int{1, 2, 3})
+
+	for hash, expected := range map[int]bool{1: true, 3: true, 4: false} {
+		if path.Contains(hash) != expected {
+			t.Errorf("expected Contains(%d) to be %v", hash, expected)
+		}
+	}
+}
+
+func TestPath_empty(t *testing.T) {
+	var path Path[int, int]
+
+	if path.Source() != 0 || path.Target() != 0 {
+		t.Errorf("expected source and target 0, got %v and %v", path.Source(), path.Target())
+	}
+
+	if path.Len() != 0 {
+		t.Errorf("expected length 0, got %d", path.Len())
+	}
+}
+
+func TestPathWithEdges(t *testing.T) {
+	tests := map[string]struct {
+		function func(g Graph[int, int]) ([]Path[int, int], error)
+	}{
+		"ShortestPathWithEdges": {
+			function: func(g Graph[int, int]) ([]Path[int, int], error) {
+				path, err := ShortestPathWithEdges(g, 1, 4)
+				return []Path[int, int]{path}, err
+			},
+		},
+		"BidirectionalShortestPathWithEdges": {
+			function: func(g Graph[int, int]) ([]Path[int, int], error) {
+				path, err := BidirectionalShortestPathWithEdges(g, 1, 4)
+				return []Path[int, int]{path}, err
+			},
+		},
+		"AllPathsBetweenWithEdges": {
+			function: func(g Graph[int, int]) ([]Path[int, int], error) {
+				return AllPathsBetweenWithEdges(g, 1, 4)
+			},
+		},
+		"LongestPathWithEdges": {
+			function: func(g Graph[int, int]) ([]Path[int, int], error) {
+				path, err := LongestPathWithEdges(g)
+				return []Path[int, int]{path}, err
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			paths, err := test.function(newPathGraph(true))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(paths) != 1 {
+				t.Fatalf("expected 1 path, got %d", len(paths))
+			}
+
+			path := paths[0]
+
+			if !slicesAreEqual(path.Hashes, []int{1, 2, 3, 4}) || path.Source() != 1 || path.Target() != 4 {
+				t.Errorf("expected path %v, got %v", []int{1, 2, 3, 4}, path.Hashes)
+			}
+
+			if path.Len() != 3 || path.Weight != 12 {
+				t.Errorf("expected 3 edges with weight 12, got %d edges with weight %d", path.Len(), path.Weight)
+			}
+		})
+	}
+}
+
+func TestShortestPathWithEdges_notReachable(t *testing.T) {
+	g := newPathGraph(true)
+
+	if _, err := ShortestPathWithEdges(g, 4, 1); !errors.Is(err, ErrTargetNotReachable) {
+		t.Errorf("expected error %v, got %v", ErrTargetNotReachable, err)
+	}
+}
+
+func TestPath_Subgraph(t *testing.T) {
+	g := newPathGraph(true)
+	_ = g.AddEdge(3, 1, EdgeWeight(2))
+
+	// The path traverses the cycle 1 -> 2 -> 3 -> 1 and visits 1 and 2 twice.
+	path, err := NewPath(g, []int{1, 2, 3, 1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	subgraph, err := path.Subgraph(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if order, _ := subgraph.Order(); order != 3 {
+		t.Errorf("expected 3 vertices, got %d", order)
+	}
+
+	if size, _ := subgraph.Size(); size != 3 {
+		t.Errorf("expected 3 edges, got %d", size)
+	}
+
+	if edge, err := subgraph.Edge(3, 1); err != nil || edge.Properties.Weight != 2 {
+		t.Errorf("expected edge (3, 1) with weight 2, got %v, %v", edge, err)
+	}
+
+	if _, err := subgraph.Vertex(4); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected vertex 4 not to be contained, got %v", err)
+	}
+
+	if !subgraph.Traits().IsDirected || !subgraph.Traits().IsWeighted {
+		t.Errorf("expected traits %v, got %v", g.Traits(), subgraph.Traits())
+	}
+}
+
+// newPathGraph creates a directed graph with the vertices 1 to 4 and the edges
+// 1 -> 2 with weight 3, 2 -> 3 with weight 4, and 3 -> 4 with weight 5.
+func newPathGraph(isWeighted bool) Graph[int, int] {
+	g := New(IntHash, Directed())
+	if isWeighted {
+		g = New(IntHash, Directed(), Weighted())
+	}
+
+	for _, vertex := range []int{1, 2, 3, 4} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2, EdgeWeight(3))
+	_ = g.AddEdge(2, 3, EdgeWeight(4))
+	_ = g.AddEdge(3, 4, EdgeWeight(5))
+
+	return g
+}
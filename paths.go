@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -57,16 +58,138 @@ func CreatesCycle[K comparable, T any](g Graph[K, T], source, target K) (bool, e
 	return false, nil
 }
 
+// HasPath determines whether the target vertex is reachable from the source
+// vertex. In a directed graph, edges are only followed in their direction. A
+// vertex is always reachable from itself. If the source or target vertex
+// doesn't exist, the returned error wraps ErrVertexNotFound.
+//
+// HasPath runs a BFS that stops as soon as the target has been found. For
+// graphs using the default in-memory store, it looks up the outgoing edges of
+// each visited vertex using the store's index, so that it only takes time
+// proportional to the visited part of the graph. For other stores, it computes
+// the full adjacency map first.
+//
+// To find out how the target has been reached, use the [WithProvenance] option.
+// Since the BFS doesn't take the edge weights into account, each edge has the
+// weight 1 in the recorded relaxations, and their lengths are the numbers of
+// edges. The [WithHeuristic] option has no effect.
+func HasPath[K comparable, T any](g Graph[K, T], source, target K, options ...func(*pathQuery[K])) (bool, error) {
+	var query pathQuery[K]
+
+	for _, option := range options {
+		option(&query)
+	}
+
+	if _, err := g.Vertex(source); err != nil {
+		return false, fmt.Errorf("could not get vertex with hash %v: %w", source, err)
+	}
+
+	if _, err := g.Vertex(target); err != nil {
+		return false, fmt.Errorf("could not get vertex with hash %v: %w", target, err)
+	}
+
+	if source == target {
+		return true, nil
+	}
+
+	var successors func(K) ([]Edge[K], error)
+
+	if index, ok := storeOf(g).(edgeIndex[K]); ok {
+		successors = index.OutEdges
+	} else {
+		adjacencyMap, err := g.AdjacencyMap()
+		if err != nil {
+			return false, fmt.Errorf("could not get adjacency map: %w", err)
+		}
+
+		successors = func(hash K) ([]Edge[K], error) {
+			edges := make([]Edge[K], 0, len(adjacencyMap[hash]))
+			for adjacency := range adjacencyMap[hash] {
+				edges = append(edges, Edge[K]{Source: hash, Target: adjacency})
+			}
+			return edges, nil
+		}
+	}
+
+	queue := []K{source}
+	// lengths contains the number of edges of the path over which each visited
+	// vertex has been reached.
+	lengths := map[K]int{source: 0}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		edges, err := successors(current)
+		if err != nil {
+			return false, fmt.Errorf("could not get successors of vertex with hash %v: %w", current, err)
+		}
+
+		for _, edge := range edges {
+			relaxation := Relaxation[K]{
+				Source:    current,
+				Target:    edge.Target,
+				Weight:    1,
+				Candidate: float64(lengths[current] + 1),
+				Current:   math.Inf(1),
+				Outcome:   RelaxationImproved,
+			}
+
+			if length, ok := lengths[edge.Target]; ok {
+				relaxation.Current = float64(length)
+				relaxation.Outcome = RelaxationRejected
+				if relaxation.Candidate == relaxation.Current {
+					relaxation.Outcome = RelaxationTied
+				}
+			}
+
+			query.provenance.record(relaxation)
+
+			if edge.Target == target {
+				return true, nil
+			}
+
+			if relaxation.Outcome == RelaxationImproved {
+				lengths[edge.Target] = lengths[current] + 1
+				queue = append(queue, edge.Target)
+			}
+		}
+	}
+
+	return false, nil
+}
+
 // ShortestPath computes the shortest path between a source and a target vertex
 // under consideration of the edge weights. It returns a slice of hash values of
 // the vertices forming that path.
 //
 // The returned path includes the source and target vertices. If the target is
-// not reachable from the source, ErrTargetNotReachable will be returned. Should
-// there be multiple shortest paths, and arbitrary one will be returned.
+// not reachable from the source, ErrTargetNotReachable will be returned. If the
+// source or target vertex doesn't exist, the returned error wraps
+// ErrVertexNotFound. Should there be multiple shortest paths, and arbitrary one
+// will be returned. For graphs using a store created by [NewOrderedStore], it is
+// always the same one.
+//
+// To find out how the path has been determined, use the [WithProvenance]
+// option, which records all weight comparisons performed by ShortestPath. To
+// guide the search towards the target, use the [WithHeuristic] option.
 //
 // ShortestPath has a time complexity of O(|V|+|E|log(|V|)).
-func ShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, error) {
+func ShortestPath[K comparable, T any](g Graph[K, T], source, target K, options ...func(*pathQuery[K])) ([]K, error) {
+	var query pathQuery[K]
+
+	for _, option := range options {
+		option(&query)
+	}
+
+	if _, err := g.Vertex(source); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", source, err)
+	}
+
+	if _, err := g.Vertex(target); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", target, err)
+	}
+
 	weights := make(map[K]float64)
 	visited := make(map[K]bool)
 
@@ -79,7 +202,9 @@ func ShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, er
 		return nil, fmt.Errorf("could not get adjacency map: %w", err)
 	}
 
-	for hash := range adjacencyMap {
+	order := orderOf(g)
+
+	for _, hash := range orderedVertices(order, adjacencyMap) {
 		if hash != source {
 			weights[hash] = math.Inf(1)
 			visited[hash] = false
@@ -97,7 +222,13 @@ func ShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, er
 		vertex, _ := queue.Pop()
 		hasInfiniteWeight := math.IsInf(weights[vertex], 1)
 
-		for adjacency, edge := range adjacencyMap[vertex] {
+		// With an admissible heuristic, the shortest path to the target is
+		// known as soon as the target has been popped.
+		if vertex == target && query.heuristic != nil {
+			break
+		}
+
+		rangeAdjacencies(order, vertex, adjacencyMap[vertex], func(adjacency K, edge Edge[K]) {
 			edgeWeight := edge.Properties.Weight
 
 			// Setting the weight to 1 is required for unweighted graphs whose
@@ -109,12 +240,35 @@ func ShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, er
 
 			weight := weights[vertex] + float64(edgeWeight)
 
-			if weight < weights[adjacency] && !hasInfiniteWeight {
+			if hasInfiniteWeight {
+				return
+			}
+
+			relaxation := Relaxation[K]{
+				Source:    vertex,
+				Target:    adjacency,
+				Weight:    edgeWeight,
+				Candidate: weight,
+				Current:   weights[adjacency],
+				Outcome:   RelaxationRejected,
+			}
+
+			if weight < weights[adjacency] {
 				weights[adjacency] = weight
 				bestPredecessors[adjacency] = vertex
-				queue.UpdatePriority(adjacency, weight)
+				relaxation.Outcome = RelaxationImproved
+
+				if query.heuristic != nil {
+					queue.UpdatePriority(adjacency, weight+query.heuristic(adjacency))
+				} else {
+					queue.UpdatePriority(adjacency, weight)
+				}
+			} else if weight == weights[adjacency] {
+				relaxation.Outcome = RelaxationTied
 			}
-		}
+
+			query.provenance.record(relaxation)
+		})
 	}
 
 	path := []K{target}
@@ -135,6 +289,414 @@ func ShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, er
 	return path, nil
 }
 
+// WithHeuristic turns a path query like [ShortestPath] into an A* search. The
+// heuristic returns an estimate of the distance from the given vertex to the
+// target, and vertices are visited in the order of their distance from the
+// source plus this estimate. This guides the search towards the target and
+// allows it to stop as soon as the target has been reached.
+//
+// The heuristic must never overestimate the actual distance. Otherwise, the
+// returned path might not be the shortest. It must also satisfy the triangle
+// inequality, that is, the estimate for a vertex must not be larger than the
+// weight of an edge to a neighbor plus the estimate for that neighbor. The
+// heuristic returned by [Landmarks.Heuristic] satisfies both conditions.
+func WithHeuristic[K comparable](heuristic func(K) float64) func(*pathQuery[K]) {
+	return func(q *pathQuery[K]) {
+		q.heuristic = heuristic
+	}
+}
+
+// BidirectionalShortestPath computes the shortest path between a source and a
+// target vertex like [ShortestPath] does, but runs two simultaneous searches:
+// A forward search from the source and a backward search from the target. The
+// path is found once the two searches meet, which typically requires visiting
+// far fewer vertices than a single search, e.g. about half as many in road
+// networks and similar graphs.
+//
+// The returned path includes the source and target vertices. If the target is
+// not reachable from the source, ErrTargetNotReachable will be returned. If the
+// source or target vertex doesn't exist, the returned error wraps
+// ErrVertexNotFound. Negative edge weights are rejected with an error once the
+// search encounters them.
+//
+// For graphs using the default in-memory store, the edges of each visited
+// vertex are looked up using the store's index, so that the search only takes
+// time proportional to the visited part of the graph. For other stores, the
+// full adjacency and predecessor maps are computed first.
+func BidirectionalShortestPath[K comparable, T any](g Graph[K, T], source, target K) ([]K, error) {
+	if _, err := g.Vertex(source); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", source, err)
+	}
+
+	if _, err := g.Vertex(target); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", target, err)
+	}
+
+	if source == target {
+		return []K{source}, nil
+	}
+
+	var successors, predecessors func(K) ([]Edge[K], error)
+
+	if index, ok := storeOf(g).(edgeIndex[K]); ok {
+		successors = index.OutEdges
+		predecessors = index.InEdges
+	} else {
+		adjacencyMap, err := g.AdjacencyMap()
+		if err != nil {
+			return nil, fmt.Errorf("could not get adjacency map: %w", err)
+		}
+
+		predecessorMap := adjacencyMap
+
+		if g.Traits().IsDirected {
+			if predecessorMap, err = g.PredecessorMap(); err != nil {
+				return nil, fmt.Errorf("could not get predecessor map: %w", err)
+			}
+		}
+
+		successors = func(hash K) ([]Edge[K], error) {
+			return edgesOf(adjacencyMap[hash]), nil
+		}
+		predecessors = func(hash K) ([]Edge[K], error) {
+			return edgesOf(predecessorMap[hash]), nil
+		}
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	forward := newBidirectionalSearch(source, successors)
+	backward := newBidirectionalSearch(target, predecessors)
+
+	// best is the weight of the shortest path found so far, which leads through
+	// the meeting vertex. Once the sum of the smallest tentative distances of
+	// both searches reaches best, no shorter path can be found.
+	best := math.Inf(1)
+	var meeting K
+
+	for forward.queue.Len() > 0 && backward.queue.Len() > 0 {
+		if forward.queue.MinPriority()+backward.queue.MinPriority() >= best {
+			break
+		}
+
+		// Always expand the search with the smaller tentative distance, which
+		// keeps both searches balanced.
+		search, other := forward, backward
+		if backward.queue.MinPriority() < forward.queue.MinPriority() {
+			search, other = backward, forward
+		}
+
+		vertex, _ := search.queue.Pop()
+		search.settled[vertex] = true
+
+		edges, err := search.edges(vertex)
+		if err != nil {
+			return nil, fmt.Errorf("could not get edges of vertex with hash %v: %w", vertex, err)
+		}
+
+		for _, edge := range edges {
+			edgeWeight := edge.Properties.Weight
+
+			// Like in ShortestPath, each edge of an unweighted graph has a
+			// weight of 1.
+			if !isWeighted {
+				edgeWeight = 1
+			}
+
+			if edgeWeight < 0 {
+				return nil, fmt.Errorf("edge (%v, %v) has a negative weight", edge.Source, edge.Target)
+			}
+
+			// Depending on the store and the search direction, the current
+			// vertex may be either the source or the target of the edge.
+			adjacency := edge.Target
+			if adjacency == vertex {
+				adjacency = edge.Source
+			}
+
+			if !search.settled[adjacency] {
+				search.relax(vertex, adjacency, search.distances[vertex]+float64(edgeWeight))
+			}
+
+			if distance, ok := other.distances[adjacency]; ok {
+				if weight := search.distances[adjacency] + distance; weight < best {
+					best = weight
+					meeting = adjacency
+				}
+			}
+		}
+	}
+
+	if math.IsInf(best, 1) {
+		return nil, ErrTargetNotReachable
+	}
+
+	path := []K{meeting}
+
+	for current := meeting; current != source; {
+		current = forward.predecessors[current]
+		path = append([]K{current}, path...)
+	}
+
+	for current := meeting; current != target; {
+		current = backward.predecessors[current]
+		path = append(path, current)
+	}
+
+	return path, nil
+}
+
+// bidirectionalSearch is one of the two searches run by
+// [BidirectionalShortestPath]. The forward search follows the outgoing edges
+// of each vertex, while the backward search follows the ingoing edges.
+type bidirectionalSearch[K comparable] struct {
+	edges        func(K) ([]Edge[K], error)
+	queue        *priorityQueue[K]
+	distances    map[K]float64
+	predecessors map[K]K
+	settled      map[K]bool
+}
+
+func newBidirectionalSearch[K comparable](start K, edges func(K) ([]Edge[K], error)) *bidirectionalSearch[K] {
+	s := &bidirectionalSearch[K]{
+		edges:        edges,
+		queue:        newPriorityQueue[K](),
+		distances:    map[K]float64{start: 0},
+		predecessors: make(map[K]K),
+		settled:      make(map[K]bool),
+	}
+
+	s.queue.Push(start, 0)
+
+	return s
+}
+
+// relax updates the tentative distance of the given vertex if reaching it via
+// the given predecessor is shorter.
+func (s *bidirectionalSearch[K]) relax(predecessor, vertex K, distance float64) {
+	current, ok := s.distances[vertex]
+	if ok && distance >= current {
+		return
+	}
+
+	s.distances[vertex] = distance
+	s.predecessors[vertex] = predecessor
+
+	if ok {
+		s.queue.UpdatePriority(vertex, distance)
+	} else {
+		s.queue.Push(vertex, distance)
+	}
+}
+
+// ShortestPaths contains the shortest paths from a single source vertex to all
+// other vertices, as computed by [ShortestPathTree]. The paths form a tree
+// rooted at the source, where each vertex is connected with its predecessor on
+// the shortest path from the source.
+type ShortestPaths[K comparable, T any] struct {
+	g            Graph[K, T]
+	source       K
+	distances    map[K]float64
+	predecessors map[K]K
+}
+
+// ShortestPathTree computes the shortest paths from the given source vertex to
+// all other vertices in a single search. This is much faster than calling
+// [ShortestPath] for each target vertex:
+//
+//	paths, _ := graph.ShortestPathTree(g, "A")
+//
+//	for _, target := range []string{"B", "C", "D"} {
+//		path, _ := paths.ShortestPath(target)
+//		fmt.Println(path)
+//	}
+//
+// For weighted graphs, ShortestPathTree runs Dijkstra's algorithm, which has a
+// time complexity of O(|V|+|E|log(|V|)). Negative edge weights are rejected
+// with an error. For unweighted graphs, where each edge has a weight of 1 like
+// in ShortestPath, it runs a BFS in O(|V|+|E|) instead.
+//
+// Should there be multiple shortest paths to a vertex, an arbitrary one is
+// chosen. If the source vertex doesn't exist, the returned error wraps
+// ErrVertexNotFound.
+func ShortestPathTree[K comparable, T any](g Graph[K, T], source K) (*ShortestPaths[K, T], error) {
+	if _, err := g.Vertex(source); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", source, err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	return shortestPathTree(g, adjacencyMap, source)
+}
+
+// shortestPathTree computes the shortest paths from the given source vertex
+// using the given adjacency map of g. This allows computing the shortest paths
+// from multiple sources without creating an adjacency map each time.
+func shortestPathTree[K comparable, T any](g Graph[K, T], adjacencyMap map[K]map[K]Edge[K], source K) (*ShortestPaths[K, T], error) {
+	s := &ShortestPaths[K, T]{
+		g:            g,
+		source:       source,
+		distances:    map[K]float64{source: 0},
+		predecessors: make(map[K]K),
+	}
+
+	if !g.Traits().IsWeighted {
+		queue := []K{source}
+
+		for len(queue) > 0 {
+			vertex := queue[0]
+			queue = queue[1:]
+
+			for adjacency := range adjacencyMap[vertex] {
+				if _, ok := s.distances[adjacency]; ok {
+					continue
+				}
+
+				s.distances[adjacency] = s.distances[vertex] + 1
+				s.predecessors[adjacency] = vertex
+				queue = append(queue, adjacency)
+			}
+		}
+
+		return s, nil
+	}
+
+	for vertex, adjacencies := range adjacencyMap {
+		for adjacency, edge := range adjacencies {
+			if edge.Properties.Weight < 0 {
+				return nil, fmt.Errorf("edge (%v, %v) has a negative weight", vertex, adjacency)
+			}
+		}
+	}
+
+	queue := newPriorityQueue[K]()
+	queue.Push(source, 0)
+
+	settled := make(map[K]bool)
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Pop()
+		settled[vertex] = true
+
+		for adjacency, edge := range adjacencyMap[vertex] {
+			if settled[adjacency] {
+				continue
+			}
+
+			weight := s.distances[vertex] + float64(edge.Properties.Weight)
+
+			current, ok := s.distances[adjacency]
+			if ok && weight >= current {
+				continue
+			}
+
+			s.distances[adjacency] = weight
+			s.predecessors[adjacency] = vertex
+
+			if ok {
+				queue.UpdatePriority(adjacency, weight)
+			} else {
+				queue.Push(adjacency, weight)
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// Source returns the hash of the source vertex.
+func (s *ShortestPaths[K, T]) Source() K {
+	return s.source
+}
+
+// Distance returns the total weight of the shortest path from the source to the
+// given target vertex. If the target is not reachable from the source, the
+// returned error is ErrTargetNotReachable.
+func (s *ShortestPaths[K, T]) Distance(target K) (int, error) {
+	distance, ok := s.distances[target]
+	if !ok {
+		if _, err := s.g.Vertex(target); err != nil {
+			return 0, fmt.Errorf("could not get vertex with hash %v: %w", target, err)
+		}
+		return 0, ErrTargetNotReachable
+	}
+
+	return int(distance), nil
+}
+
+// ShortestPath returns the shortest path from the source to the given target
+// vertex in the same format as [ShortestPath] does. If the target is not
+// reachable from the source, ErrTargetNotReachable will be returned.
+func (s *ShortestPaths[K, T]) ShortestPath(target K) ([]K, error) {
+	if _, err := s.Distance(target); err != nil {
+		return nil, err
+	}
+
+	path := []K{target}
+	current := target
+
+	for current != s.source {
+		current = s.predecessors[current]
+		path = append(path, current)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, nil
+}
+
+// Predecessors returns the predecessor of each vertex that is reachable from
+// the source, that is, the previous vertex on the shortest path to it. The
+// source itself doesn't have a predecessor.
+func (s *ShortestPaths[K, T]) Predecessors() map[K]K {
+	predecessors := make(map[K]K, len(s.predecessors))
+
+	for vertex, predecessor := range s.predecessors {
+		predecessors[vertex] = predecessor
+	}
+
+	return predecessors
+}
+
+// Tree returns the shortest path tree as a graph with the same traits as the
+// original graph. It contains all vertices reachable from the source and an
+// edge from each vertex's predecessor to the vertex, along with the vertex and
+// edge properties.
+func (s *ShortestPaths[K, T]) Tree() (Graph[K, T], error) {
+	tree := NewLike(s.g)
+
+	for vertex := range s.distances {
+		value, properties, err := s.g.VertexWithProperties(vertex)
+		if err != nil {
+			return nil, fmt.Errorf("could not get vertex with hash %v: %w", vertex, err)
+		}
+
+		if err := tree.AddVertex(value, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", vertex, err)
+		}
+	}
+
+	for vertex, predecessor := range s.predecessors {
+		edge, err := s.g.Edge(predecessor, vertex)
+		if err != nil {
+			return nil, fmt.Errorf("could not get edge (%v, %v): %w", predecessor, vertex, err)
+		}
+
+		treeEdge := Edge[K]{Source: predecessor, Target: vertex, Properties: edge.Properties}
+
+		if err := tree.AddEdge(copyEdge(treeEdge)); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", predecessor, vertex, err)
+		}
+	}
+
+	return tree, nil
+}
+
 type sccState[K comparable] struct {
 	adjacencyMap map[K]map[K]Edge[K]
 	components   [][]K
@@ -214,13 +776,19 @@ func findSCC[K comparable](vertexHash K, state *sccState[K]) {
 	// head vertex of a strongly connected component that's shaped by the vertex
 	// and all vertices on the stack.
 	if state.lowlink[vertexHash] == state.index[vertexHash] {
-		var hash K
 		var component []K
 
-		for hash != vertexHash {
-			hash, _ = state.stack.pop()
+		// Pop vertices until the vertex itself has been popped. The condition
+		// must not be checked before the first pop, because the vertex hash may
+		// be the zero value of K.
+		for {
+			hash, _ := state.stack.pop()
 
 			component = append(component, hash)
+
+			if hash == vertexHash {
+				break
+			}
 		}
 
 		state.components = append(state.components, component)
@@ -234,6 +802,14 @@ func findSCC[K comparable](vertexHash K, state *sccState[K]) {
 // AllPathsBetween utilizes a non-recursive, stack-based implementation. It has
 // an estimated runtime complexity of O(n^2) where n is the number of vertices.
 func AllPathsBetween[K comparable, T any](g Graph[K, T], start, end K) ([][]K, error) {
+	if _, err := g.Vertex(start); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", start, err)
+	}
+
+	if _, err := g.Vertex(end); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", end, err)
+	}
+
 	adjacencyMap, err := g.AdjacencyMap()
 	if err != nil {
 		return nil, err
@@ -331,3 +907,96 @@ func AllPathsBetween[K comparable, T any](g Graph[K, T], start, end K) ([][]K, e
 
 	return allPaths, nil
 }
+
+// AllPaths enumerates all simple paths between two given vertices, that is,
+// all paths that don't visit a vertex more than once. Unlike AllPathsBetween,
+// it doesn't collect the paths but passes each path to the visit function as
+// soon as it has been found, so that the memory usage only depends on the
+// length of the paths and not on their number.
+//
+// Only paths with at most maxDepth edges are enumerated. A maxDepth of 0 or less
+// means that the length of the paths is not limited. If visit returns true, the
+// enumeration stops, which can be used to limit the number of paths:
+//
+//	// Print the first 10 paths from A to B with at most 5 edges.
+//	count := 0
+//
+//	_ = graph.AllPaths(g, "A", "B", 5, func(path []string) bool {
+//		fmt.Println(path)
+//		count++
+//		return count == 10
+//	})
+//
+// Each path includes the source and the target vertex and is passed to visit
+// as a new slice that may be retained. In a directed graph, edges are only
+// followed in their direction. If the source or target vertex doesn't exist,
+// the returned error wraps ErrVertexNotFound.
+//
+// The number of simple paths can grow exponentially with the size of the
+// graph, so limiting the depth or the number of paths is recommended.
+func AllPaths[K comparable, T any](g Graph[K, T], source, target K, maxDepth int, visit func(path []K) bool) error {
+	return AllPathsContext(context.Background(), g, source, target, maxDepth, visit)
+}
+
+// AllPathsContext works like [AllPaths], but stops the enumeration as soon as
+// the given context is cancelled and returns the error of the context. The
+// paths that have been passed to visit until then remain valid.
+func AllPathsContext[K comparable, T any](ctx context.Context, g Graph[K, T], source, target K, maxDepth int, visit func(path []K) bool) error {
+	if _, err := g.Vertex(source); err != nil {
+		return fmt.Errorf("could not get vertex with hash %v: %w", source, err)
+	}
+
+	if _, err := g.Vertex(target); err != nil {
+		return fmt.Errorf("could not get vertex with hash %v: %w", target, err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	path := []K{source}
+	onPath := map[K]bool{source: true}
+	cancel := cancellation{ctx: ctx}
+
+	// search extends the current path and reports whether the enumeration has
+	// been stopped by visit or by cancelling the context.
+	var search func(vertex K) bool
+	search = func(vertex K) bool {
+		if err = cancel.check(); err != nil {
+			return true
+		}
+
+		if vertex == target {
+			return visit(append([]K(nil), path...))
+		}
+
+		if maxDepth > 0 && len(path) > maxDepth {
+			return false
+		}
+
+		for adjacency := range adjacencyMap[vertex] {
+			if onPath[adjacency] {
+				continue
+			}
+
+			path = append(path, adjacency)
+			onPath[adjacency] = true
+
+			stop := search(adjacency)
+
+			path = path[:len(path)-1]
+			delete(onPath, adjacency)
+
+			if stop {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	search(source)
+
+	return err
+}
@@ -1,6 +1,10 @@
 package graph
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
 	"reflect"
 	"sort"
 	"testing"
@@ -654,3 +658,724 @@ func TestAllPathsBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestZeroHash(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for _, vertex := range []int{0, 1, 2} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(0, 1)
+	_ = g.AddEdge(1, 0)
+	_ = g.AddEdge(1, 2)
+
+	if _, err := g.Vertex(0); err != nil {
+		t.Errorf("expected vertex 0 to exist, got error %v", err)
+	}
+
+	path, err := ShortestPath(g, 2, 0)
+	if !errors.Is(err, ErrTargetNotReachable) {
+		t.Errorf("expected error %v, got %v (path %v)", ErrTargetNotReachable, err, path)
+	}
+
+	path, err = ShortestPath(g, 2, 3)
+	if !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v (path %v)", ErrVertexNotFound, err, path)
+	}
+
+	path, err = ShortestPath(g, 1, 0)
+	if err != nil || len(path) != 2 || path[0] != 1 || path[1] != 0 {
+		t.Errorf("expected path [1 0], got %v (error %v)", path, err)
+	}
+
+	if _, err := AllPathsBetween(g, 0, 3); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+
+	components, err := StronglyConnectedComponents(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sizes := make(map[int]int)
+	for _, component := range components {
+		for _, vertex := range component {
+			sizes[vertex] = len(component)
+		}
+	}
+
+	expectedSizes := map[int]int{0: 2, 1: 2, 2: 1}
+
+	for vertex, size := range expectedSizes {
+		if sizes[vertex] != size {
+			t.Errorf("expected vertex %d in a component of size %d, got components %v", vertex, size, components)
+		}
+	}
+}
+
+func TestShortestPath_Provenance(t *testing.T) {
+	g := New(StringHash, Directed(), Weighted())
+
+	for _, vertex := range []string{"A", "B", "C", "D"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge("A", "B", EdgeWeight(4))
+	_ = g.AddEdge("A", "C", EdgeWeight(1))
+	_ = g.AddEdge("C", "B", EdgeWeight(2))
+	_ = g.AddEdge("C", "D", EdgeWeight(5))
+	_ = g.AddEdge("B", "D", EdgeWeight(3))
+
+	var provenance Provenance[string]
+
+	path, err := ShortestPath(g, "A", "D", WithProvenance(&provenance))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(path, []string{"A", "C", "D"}) && !reflect.DeepEqual(path, []string{"A", "C", "B", "D"}) {
+		t.Fatalf("unexpected path %v", path)
+	}
+
+	// Each edge is relaxed exactly once, since there are no unreachable vertices.
+	if len(provenance.Relaxations) != 5 {
+		t.Fatalf("expected 5 relaxations, got %d:\n%s", len(provenance.Relaxations), provenance.String())
+	}
+
+	outcomes := make(map[[2]string]Relaxation[string])
+	for _, relaxation := range provenance.Relaxations {
+		outcomes[[2]string{relaxation.Source, relaxation.Target}] = relaxation
+	}
+
+	expected := map[[2]string]RelaxationOutcome{
+		{"A", "B"}: RelaxationImproved,
+		{"A", "C"}: RelaxationImproved,
+		{"C", "B"}: RelaxationImproved,
+		{"C", "D"}: RelaxationImproved,
+		{"B", "D"}: RelaxationTied,
+	}
+
+	for edge, outcome := range expected {
+		if outcomes[edge].Outcome != outcome {
+			t.Errorf("expected outcome %v for edge %v, got %v", outcome, edge, outcomes[edge])
+		}
+	}
+
+	toB := provenance.RelaxationsOf("B")
+	if len(toB) != 2 {
+		t.Fatalf("expected 2 relaxations of edges to B, got %v", toB)
+	}
+
+	if relaxation := outcomes[[2]string{"C", "B"}]; relaxation.Candidate != 3 || relaxation.Current != 4 {
+		t.Errorf("expected candidate 3 and current 4 for edge (C, B), got %v", relaxation)
+	}
+
+	if got := outcomes[[2]string{"B", "D"}].String(); got != "(B, D) weight 3: 6 = 6, tied" {
+		t.Errorf("unexpected string representation %q", got)
+	}
+
+	// Running another query resets the provenance.
+	_, _ = ShortestPath(g, "C", "B", WithProvenance(&provenance))
+
+	if len(provenance.Relaxations) != 3 {
+		t.Errorf("expected 3 relaxations after second query, got %d:\n%s", len(provenance.Relaxations), provenance.String())
+	}
+}
+
+func TestHasPath_Provenance(t *testing.T) {
+	g := New(StringHash, Directed())
+
+	for _, vertex := range []string{"A", "B", "C", "D", "E"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge("A", "B")
+	_ = g.AddEdge("A", "C")
+	_ = g.AddEdge("B", "D")
+	_ = g.AddEdge("C", "D")
+	_ = g.AddEdge("D", "E")
+
+	var provenance Provenance[string]
+
+	hasPath, err := HasPath(g, "A", "E", WithProvenance(&provenance))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !hasPath {
+		t.Fatal("expected path from A to E")
+	}
+
+	if len(provenance.Relaxations) != 5 {
+		t.Fatalf("expected 5 relaxations, got %d:\n%s", len(provenance.Relaxations), provenance.String())
+	}
+
+	outcomes := make(map[RelaxationOutcome]int)
+	for _, relaxation := range provenance.RelaxationsOf("D") {
+		outcomes[relaxation.Outcome]++
+	}
+
+	// The second edge to D leads to a path as long as the first one.
+	if outcomes[RelaxationImproved] != 1 || outcomes[RelaxationTied] != 1 {
+		t.Errorf("expected one improved and one tied relaxation of edges to D, got %v", provenance.RelaxationsOf("D"))
+	}
+
+	toE := provenance.RelaxationsOf("E")
+	if len(toE) != 1 || toE[0].Candidate != 3 || toE[0].Outcome != RelaxationImproved {
+		t.Errorf("expected an improving relaxation of length 3 for E, got %v", toE)
+	}
+}
+
+func TestWithProvenance_nil(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2)
+
+	if _, err := ShortestPath(g, 1, 2, WithProvenance[int](nil)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := HasPath(g, 1, 2, WithProvenance[int](nil)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestShortestPathTree(t *testing.T) {
+	tests := map[string]struct {
+		isDirected        bool
+		isWeighted        bool
+		edges             []Edge[string]
+		source            string
+		expectedDistances map[string]int
+		expectedPaths     map[string][]string
+		shouldFail        bool
+	}{
+		"weighted directed graph as on img/dijkstra.svg": {
+			isDirected: true,
+			isWeighted: true,
+			edges: []Edge[string]{
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 3}},
+				{Source: "A", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 4}},
+				{Source: "C", Target: "E", Properties: EdgeProperties{Weight: 1}},
+				{Source: "C", Target: "F", Properties: EdgeProperties{Weight: 2}},
+				{Source: "D", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "E", Target: "B", Properties: EdgeProperties{Weight: 2}},
+				{Source: "E", Target: "F", Properties: EdgeProperties{Weight: 3}},
+				{Source: "F", Target: "G", Properties: EdgeProperties{Weight: 5}},
+				{Source: "G", Target: "B", Properties: EdgeProperties{Weight: 2}},
+			},
+			source:            "A",
+			expectedDistances: map[string]int{"A": 0, "B": 6, "C": 3, "D": 7, "E": 4, "F": 2, "G": 7},
+			expectedPaths: map[string][]string{
+				"A": {"A"},
+				"B": {"A", "C", "E", "B"},
+				"D": {"A", "C", "D"},
+				"G": {"A", "F", "G"},
+			},
+		},
+		"unweighted directed graph with unreachable vertices": {
+			isDirected: true,
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 10}},
+				{Source: "B", Target: "C"},
+				{Source: "A", Target: "D"},
+				{Source: "D", Target: "C"},
+				{Source: "C", Target: "E"},
+				{Source: "F", Target: "A"},
+			},
+			source:            "A",
+			expectedDistances: map[string]int{"A": 0, "B": 1, "C": 2, "D": 1, "E": 3},
+			expectedPaths: map[string][]string{
+				"E": {"A", "?", "C", "E"},
+			},
+		},
+		"weighted undirected graph": {
+			isWeighted: true,
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: 1}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Weight: 1}},
+				{Source: "A", Target: "C", Properties: EdgeProperties{Weight: 5}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Weight: 1}},
+			},
+			source:            "D",
+			expectedDistances: map[string]int{"A": 3, "B": 2, "C": 1, "D": 0},
+			expectedPaths: map[string][]string{
+				"A": {"D", "C", "B", "A"},
+			},
+		},
+		"negative edge weight": {
+			isDirected: true,
+			isWeighted: true,
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Weight: -1}},
+			},
+			source:     "A",
+			shouldFail: true,
+		},
+		"missing source": {
+			isDirected: true,
+			source:     "X",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash)
+
+			switch {
+			case test.isDirected && test.isWeighted:
+				g = New(StringHash, Directed(), Weighted())
+			case test.isDirected:
+				g = New(StringHash, Directed())
+			case test.isWeighted:
+				g = New(StringHash, Weighted())
+			}
+
+			for _, vertex := range []string{"A", "B", "C", "D", "E", "F", "G"} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight))
+			}
+
+			paths, err := ShortestPathTree(g, test.source)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			for _, vertex := range []string{"A", "B", "C", "D", "E", "F", "G"} {
+				expected, ok := test.expectedDistances[vertex]
+
+				distance, err := paths.Distance(vertex)
+
+				if !ok {
+					if !errors.Is(err, ErrTargetNotReachable) {
+						t.Errorf("expected %s to be unreachable, got %v, %v", vertex, distance, err)
+					}
+					continue
+				}
+
+				if err != nil || distance != expected {
+					t.Errorf("expected distance %d to %s, got %d, %v", expected, vertex, distance, err)
+				}
+			}
+
+			for target, expected := range test.expectedPaths {
+				path, err := paths.ShortestPath(target)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if len(path) != len(expected) {
+					t.Fatalf("expected path %v to %s, got %v", expected, target, path)
+				}
+
+				// A question mark marks a vertex where several shortest paths
+				// are possible.
+				for i := range expected {
+					if expected[i] != "?" && path[i] != expected[i] {
+						t.Errorf("expected path %v to %s, got %v", expected, target, path)
+						break
+					}
+				}
+			}
+
+			if _, err := paths.Distance("X"); !errors.Is(err, ErrVertexNotFound) {
+				t.Errorf("expected ErrVertexNotFound for missing vertex, got %v", err)
+			}
+
+			tree, err := paths.Tree()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if order, _ := tree.Order(); order != len(test.expectedDistances) {
+				t.Errorf("expected %d vertices in tree, got %d", len(test.expectedDistances), order)
+			}
+
+			if size, _ := tree.Size(); size != len(test.expectedDistances)-1 {
+				t.Errorf("expected %d edges in tree, got %d", len(test.expectedDistances)-1, size)
+			}
+
+			for vertex, predecessor := range paths.Predecessors() {
+				if _, err := tree.Edge(predecessor, vertex); err != nil {
+					t.Errorf("expected tree edge (%s, %s): %v", predecessor, vertex, err)
+				}
+			}
+		})
+	}
+}
+
+func TestAllPaths(t *testing.T) {
+	tests := map[string]struct {
+		source        int
+		target        int
+		maxDepth      int
+		expectedPaths [][]int
+		shouldFail    bool
+	}{
+		"unlimited depth": {
+			source: 3,
+			target: 6,
+			expectedPaths: [][]int{
+				{3, 1, 0, 2, 6},
+				{3, 1, 4, 5, 6},
+				{3, 1, 4, 5, 2, 6},
+				{3, 7, 4, 5, 2, 6},
+				{3, 7, 4, 5, 6},
+			},
+		},
+		"limited depth": {
+			source:   3,
+			target:   6,
+			maxDepth: 4,
+			expectedPaths: [][]int{
+				{3, 1, 0, 2, 6},
+				{3, 1, 4, 5, 6},
+				{3, 7, 4, 5, 6},
+			},
+		},
+		"depth too small": {
+			source:        3,
+			target:        6,
+			maxDepth:      3,
+			expectedPaths: [][]int{},
+		},
+		"source equal to target": {
+			source:        3,
+			target:        3,
+			expectedPaths: [][]int{{3}},
+		},
+		"target not reachable": {
+			source:        6,
+			target:        3,
+			expectedPaths: [][]int{},
+		},
+		"missing vertex": {
+			source:     3,
+			target:     10,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newAllPathsGraph()
+
+			paths := make([][]int, 0)
+
+			err := AllPaths(g, test.source, test.target, test.maxDepth, func(path []int) bool {
+				paths = append(paths, path)
+				return false
+			})
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			toStrings := func(paths [][]int) []string {
+				strings := make([]string, len(paths))
+				for i, path := range paths {
+					strings[i] = fmt.Sprint(path)
+				}
+				sort.Strings(strings)
+				return strings
+			}
+
+			actual, expected := toStrings(paths), toStrings(test.expectedPaths)
+
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("expected paths %v, got %v", expected, actual)
+			}
+		})
+	}
+}
+
+func TestAllPaths_stop(t *testing.T) {
+	g := newAllPathsGraph()
+
+	var paths [][]int
+
+	_ = AllPaths(g, 3, 6, 0, func(path []int) bool {
+		paths = append(paths, path)
+		return len(paths) == 2
+	})
+
+	if len(paths) != 2 {
+		t.Fatalf("expected enumeration to stop after 2 paths, got %v", paths)
+	}
+
+	if reflect.DeepEqual(paths[0], paths[1]) {
+		t.Errorf("expected distinct paths, got %v", paths)
+	}
+}
+
+func TestAllPathsContext(t *testing.T) {
+	g := newAllPathsGraph()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var paths [][]int
+
+	err := AllPathsContext(ctx, g, 3, 6, 0, func(path []int) bool {
+		paths = append(paths, path)
+		return false
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error %v, got %v", context.Canceled, err)
+	}
+
+	if len(paths) != 0 {
+		t.Errorf("expected no paths for cancelled context, got %v", paths)
+	}
+}
+
+// newAllPathsGraph creates the directed graph used by TestAllPathsBetween.
+func newAllPathsGraph() Graph[int, int] {
+	g := New(IntHash, Directed())
+	for i := 0; i <= 8; i++ {
+		_ = g.AddVertex(i)
+	}
+	_ = g.AddEdge(0, 2)
+	_ = g.AddEdge(1, 0)
+	_ = g.AddEdge(1, 4)
+	_ = g.AddEdge(2, 6)
+	_ = g.AddEdge(3, 1)
+	_ = g.AddEdge(3, 7)
+	_ = g.AddEdge(4, 5)
+	_ = g.AddEdge(5, 2)
+	_ = g.AddEdge(5, 6)
+	_ = g.AddEdge(6, 8)
+	_ = g.AddEdge(7, 4)
+	return g
+}
+
+func TestHasPath(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		source      int
+		target      int
+		expected    bool
+		expectedErr error
+	}{
+		"directed graph with direct edge": {
+			isDirected: true,
+			source:     1,
+			target:     3,
+			expected:   true,
+		},
+		"directed graph against edge direction": {
+			isDirected: true,
+			source:     3,
+			target:     1,
+			expected:   false,
+		},
+		"directed graph from another source": {
+			isDirected: true,
+			source:     4,
+			target:     3,
+			expected:   true,
+		},
+		"directed graph between siblings": {
+			isDirected: true,
+			source:     1,
+			target:     4,
+			expected:   false,
+		},
+		"undirected graph via another vertex": {
+			isDirected: false,
+			source:     1,
+			target:     4,
+			expected:   true,
+		},
+		"isolated vertex": {
+			isDirected: false,
+			source:     1,
+			target:     5,
+			expected:   false,
+		},
+		"source equal to target": {
+			isDirected: true,
+			source:     5,
+			target:     5,
+			expected:   true,
+		},
+		"missing vertex": {
+			isDirected:  true,
+			source:      1,
+			target:      10,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		for graphName, g := range newNeighborsGraphs(t, test.isDirected) {
+			t.Run(name+" with "+graphName, func(t *testing.T) {
+				hasPath, err := HasPath(g, test.source, test.target)
+
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				if hasPath != test.expected {
+					t.Errorf("expected %v, got %v", test.expected, hasPath)
+				}
+			})
+		}
+	}
+}
+
+func TestBidirectionalShortestPath(t *testing.T) {
+	tests := map[string]struct {
+		isDirected   bool
+		source       int
+		target       int
+		expectedPath []int
+		expectedErr  error
+	}{
+		"directed graph with direct edge": {
+			isDirected:   true,
+			source:       1,
+			target:       3,
+			expectedPath: []int{1, 3},
+		},
+		"directed graph against edge direction": {
+			isDirected:  true,
+			source:      3,
+			target:      1,
+			expectedErr: ErrTargetNotReachable,
+		},
+		"undirected graph via another vertex": {
+			isDirected:   false,
+			source:       1,
+			target:       4,
+			expectedPath: []int{1, 3, 4},
+		},
+		"undirected graph with cheaper direct edge": {
+			isDirected:   false,
+			source:       2,
+			target:       1,
+			expectedPath: []int{2, 1},
+		},
+		"isolated vertex": {
+			isDirected:  false,
+			source:      1,
+			target:      5,
+			expectedErr: ErrTargetNotReachable,
+		},
+		"source equal to target": {
+			isDirected:   true,
+			source:       3,
+			target:       3,
+			expectedPath: []int{3},
+		},
+		"missing vertex": {
+			isDirected:  true,
+			source:      1,
+			target:      10,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		for graphName, g := range newNeighborsGraphs(t, test.isDirected) {
+			t.Run(name+" with "+graphName, func(t *testing.T) {
+				path, err := BidirectionalShortestPath(g, test.source, test.target)
+
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				if !reflect.DeepEqual(path, test.expectedPath) {
+					t.Errorf("expected path %v, got %v", test.expectedPath, path)
+				}
+			})
+		}
+	}
+}
+
+func TestBidirectionalShortestPath_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for _, isDirected := range []bool{true, false} {
+		g := New(IntHash, Weighted())
+		if isDirected {
+			g = New(IntHash, Directed(), Weighted())
+		}
+
+		for vertex := 0; vertex < 40; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for i := 0; i < 100; i++ {
+			_ = g.AddEdge(random.Intn(40), random.Intn(40), EdgeWeight(random.Intn(10)))
+		}
+
+		for source := 0; source < 40; source++ {
+			paths, err := ShortestPathTree(g, source)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for target := 0; target < 40; target++ {
+				expected, expectedErr := paths.Distance(target)
+
+				path, err := BidirectionalShortestPath(g, source, target)
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("expected error %v from %d to %d, got %v", expectedErr, source, target, err)
+				}
+
+				if err != nil {
+					continue
+				}
+
+				if path[0] != source || path[len(path)-1] != target {
+					t.Fatalf("expected path from %d to %d, got %v", source, target, path)
+				}
+
+				distance := 0
+
+				for i := 1; i < len(path); i++ {
+					edge, err := g.Edge(path[i-1], path[i])
+					if err != nil {
+						t.Fatalf("expected edge (%d, %d) in path %v: %v", path[i-1], path[i], path, err)
+					}
+					distance += edge.Properties.Weight
+				}
+
+				if distance != expected {
+					t.Fatalf("expected distance %d from %d to %d, got %d (%v)", expected, source, target, distance, path)
+				}
+			}
+		}
+	}
+}
+
+func TestBidirectionalShortestPath_negativeWeight(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2, EdgeWeight(-1))
+
+	if _, err := BidirectionalShortestPath(g, 1, 2); err == nil {
+		t.Error("expected error for negative edge weight, got nil")
+	}
+}
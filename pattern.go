@@ -0,0 +1,262 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Pattern is a small query graph whose occurrences can be searched for in a
+// larger graph using [Match]. The vertices of a pattern are identified by names
+// and may have a predicate that the matched vertices have to satisfy. Likewise,
+// the edges of a pattern may have a predicate for the matched edges.
+//
+// The following pattern describes a feed-forward loop, where A has an edge to
+// B, B has an edge to C, and A also has a direct edge to C:
+//
+//	pattern := graph.NewPattern[string]().
+//		Edge("A", "B", nil).
+//		Edge("B", "C", nil).
+//		Edge("A", "C", nil)
+//
+// Like a Builder, a Pattern is built using method chaining.
+type Pattern[T any] struct {
+	names      []string
+	indices    map[string]int
+	predicates []func(T) bool
+	edges      []patternEdge[T]
+}
+
+type patternEdge[T any] struct {
+	source    int
+	target    int
+	predicate func(Edge[T]) bool
+}
+
+// NewPattern creates an empty pattern for graphs with vertices of type T.
+func NewPattern[T any]() *Pattern[T] {
+	return &Pattern[T]{
+		indices: make(map[string]int),
+	}
+}
+
+// Vertex adds a vertex with the given name to the pattern. A vertex of the
+// searched graph only matches this vertex if the predicate returns true for its
+// value. A nil predicate matches any vertex. If the pattern already contains a
+// vertex with the given name, its predicate is replaced.
+func (p *Pattern[T]) Vertex(name string, predicate func(T) bool) *Pattern[T] {
+	p.predicates[p.vertex(name)] = predicate
+	return p
+}
+
+// Edge adds an edge between the vertices with the given names to the pattern.
+// Vertices that don't exist yet are added without a predicate. An edge of the
+// searched graph only matches this edge if the predicate returns true for it,
+// where the source and target of the passed edge correspond to the source and
+// target of the pattern edge. A nil predicate matches any edge.
+func (p *Pattern[T]) Edge(source, target string, predicate func(Edge[T]) bool) *Pattern[T] {
+	p.edges = append(p.edges, patternEdge[T]{
+		source:    p.vertex(source),
+		target:    p.vertex(target),
+		predicate: predicate,
+	})
+	return p
+}
+
+// vertex returns the index of the vertex with the given name, adding it if it
+// doesn't exist yet.
+func (p *Pattern[T]) vertex(name string) int {
+	if i, ok := p.indices[name]; ok {
+		return i
+	}
+
+	p.indices[name] = len(p.names)
+	p.names = append(p.names, name)
+	p.predicates = append(p.predicates, nil)
+
+	return len(p.names) - 1
+}
+
+// Match finds all embeddings of the given pattern in g. An embedding maps each
+// vertex of the pattern to a distinct vertex of g, so that all vertex and edge
+// predicates are satisfied and g contains an edge for each edge of the
+// pattern. The embeddings are returned as maps from the pattern vertex names to
+// the hashes of the matched vertices:
+//
+//	embeddings, _ := graph.Match(g, pattern)
+//
+//	for _, embedding := range embeddings {
+//		fmt.Printf("%v -> %v -> %v\n", embedding["A"], embedding["B"], embedding["C"])
+//	}
+//
+// The edges of the pattern are directed if g is directed. g may contain more
+// edges between the matched vertices than the pattern, so the pattern doesn't
+// have to be an induced subgraph. If the pattern is symmetric, the same set of
+// vertices is found once for each symmetry: In an undirected graph, a triangle
+// pattern matches every triangle six times.
+//
+// Match uses backtracking and visits the pattern vertices in an order where
+// each vertex is connected to the previous ones whenever possible, so that the
+// candidates for a vertex are restricted to the neighbors of vertices that
+// have already been matched. The order of the returned embeddings is undefined.
+// If the pattern is empty, an error is returned.
+func Match[K comparable, T any](g Graph[K, T], pattern *Pattern[T]) ([]map[string]K, error) {
+	if len(pattern.names) == 0 {
+		return nil, errors.New("pattern doesn't contain any vertices")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	predecessorMap := adjacencyMap
+
+	if g.Traits().IsDirected {
+		predecessorMap, err = g.PredecessorMap()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+		}
+	}
+
+	values := make(map[K]T, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		if values[hash], err = g.Vertex(hash); err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+	}
+
+	order := pattern.matchingOrder()
+	mapping := make([]K, len(pattern.names))
+	mapped := make([]bool, len(pattern.names))
+	used := make(map[K]bool, len(pattern.names))
+
+	var embeddings []map[string]K
+
+	// isConsistent checks whether the pattern edges between the given pattern
+	// vertex and the already mapped pattern vertices exist in g and satisfy
+	// their predicates.
+	isConsistent := func(vertex int) bool {
+		for _, edge := range pattern.edges {
+			if !mapped[edge.source] || !mapped[edge.target] || (edge.source != vertex && edge.target != vertex) {
+				continue
+			}
+
+			source, target := mapping[edge.source], mapping[edge.target]
+
+			e, ok := adjacencyMap[source][target]
+			if !ok {
+				return false
+			}
+
+			if edge.predicate != nil && !edge.predicate(Edge[T]{Source: values[source], Target: values[target], Properties: e.Properties}) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	// candidates returns the vertices of g that the given pattern vertex can be
+	// mapped to. If the pattern vertex is adjacent to an already mapped one,
+	// only the neighbors of the corresponding vertex in g are candidates.
+	candidates := func(vertex int) (map[K]Edge[K], bool) {
+		for _, edge := range pattern.edges {
+			if edge.target == vertex && mapped[edge.source] {
+				return adjacencyMap[mapping[edge.source]], true
+			}
+			if edge.source == vertex && mapped[edge.target] {
+				return predecessorMap[mapping[edge.target]], true
+			}
+		}
+		return nil, false
+	}
+
+	var match func(depth int)
+	match = func(depth int) {
+		if depth == len(order) {
+			embedding := make(map[string]K, len(pattern.names))
+			for i, name := range pattern.names {
+				embedding[name] = mapping[i]
+			}
+			embeddings = append(embeddings, embedding)
+			return
+		}
+
+		vertex := order[depth]
+		predicate := pattern.predicates[vertex]
+
+		try := func(hash K) {
+			if used[hash] || (predicate != nil && !predicate(values[hash])) {
+				return
+			}
+
+			mapping[vertex], mapped[vertex], used[hash] = hash, true, true
+
+			if isConsistent(vertex) {
+				match(depth + 1)
+			}
+
+			mapped[vertex], used[hash] = false, false
+		}
+
+		if neighbors, ok := candidates(vertex); ok {
+			for hash := range neighbors {
+				try(hash)
+			}
+			return
+		}
+
+		for hash := range adjacencyMap {
+			try(hash)
+		}
+	}
+
+	match(0)
+
+	return embeddings, nil
+}
+
+// matchingOrder returns the order in which the pattern vertices are matched.
+// It starts with the vertex with the most edges and then repeatedly picks the
+// vertex with the most edges to the vertices picked so far, preferring vertices
+// with more edges overall in case of a tie.
+func (p *Pattern[T]) matchingOrder() []int {
+	degrees := make([]int, len(p.names))
+	for _, edge := range p.edges {
+		degrees[edge.source]++
+		degrees[edge.target]++
+	}
+
+	picked := make([]bool, len(p.names))
+	connections := make([]int, len(p.names))
+	order := make([]int, 0, len(p.names))
+
+	for len(order) < len(p.names) {
+		best := -1
+
+		for i := range p.names {
+			if picked[i] {
+				continue
+			}
+			if best == -1 || connections[i] > connections[best] ||
+				(connections[i] == connections[best] && degrees[i] > degrees[best]) {
+				best = i
+			}
+		}
+
+		picked[best] = true
+		order = append(order, best)
+
+		for _, edge := range p.edges {
+			if edge.source == best {
+				connections[edge.target]++
+			}
+			if edge.target == best {
+				connections[edge.source]++
+			}
+		}
+	}
+
+	return order
+}
@@ -0,0 +1,188 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		edges      []Edge[int]
+		pattern    *Pattern[int]
+		expected   []string
+	}{
+		"feed-forward loop": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2}, {Source: 2, Target: 3}, {Source: 1, Target: 3},
+				{Source: 3, Target: 4}, {Source: 2, Target: 4},
+			},
+			pattern: NewPattern[int]().
+				Edge("A", "B", nil).
+				Edge("B", "C", nil).
+				Edge("A", "C", nil),
+			expected: []string{"A=1 B=2 C=3", "A=2 B=3 C=4"},
+		},
+		"directed path respects edge direction": {
+			isDirected: true,
+			edges:      []Edge[int]{{Source: 1, Target: 2}, {Source: 3, Target: 2}},
+			pattern: NewPattern[int]().
+				Edge("A", "B", nil).
+				Edge("B", "C", nil),
+			expected: nil,
+		},
+		"undirected triangle matches once per symmetry": {
+			isDirected: false,
+			edges:      []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}, {Source: 1, Target: 3}, {Source: 3, Target: 4}},
+			pattern: NewPattern[int]().
+				Edge("A", "B", nil).
+				Edge("B", "C", nil).
+				Edge("C", "A", nil),
+			expected: []string{
+				"A=1 B=2 C=3", "A=1 B=3 C=2", "A=2 B=1 C=3",
+				"A=2 B=3 C=1", "A=3 B=1 C=2", "A=3 B=2 C=1",
+			},
+		},
+		"vertex predicates": {
+			isDirected: true,
+			edges:      []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}, {Source: 4, Target: 3}},
+			pattern: NewPattern[int]().
+				Vertex("A", func(value int) bool { return value%2 == 0 }).
+				Edge("A", "B", nil),
+			expected: []string{"A=2 B=3", "A=4 B=3"},
+		},
+		"edge predicates": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 4, Properties: EdgeProperties{Weight: 7}},
+			},
+			pattern: NewPattern[int]().
+				Edge("A", "B", func(edge Edge[int]) bool { return edge.Properties.Weight > 4 && edge.Source < edge.Target }),
+			expected: []string{"A=1 B=2", "A=3 B=4"},
+		},
+		"self-loop": {
+			isDirected: true,
+			edges:      []Edge[int]{{Source: 1, Target: 1}, {Source: 1, Target: 2}, {Source: 2, Target: 3}},
+			pattern: NewPattern[int]().
+				Edge("A", "A", nil).
+				Edge("A", "B", nil),
+			expected: []string{"A=1 B=2"},
+		},
+		"disconnected pattern": {
+			isDirected: true,
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			pattern: NewPattern[int]().
+				Edge("A", "B", nil).
+				Vertex("C", nil),
+			expected: []string{"A=1 B=2 C=3", "A=1 B=2 C=4"},
+		},
+		"pattern larger than graph": {
+			isDirected: false,
+			edges:      []Edge[int]{{Source: 1, Target: 2}},
+			pattern: NewPattern[int]().
+				Edge("A", "B", nil).
+				Edge("B", "C", nil).
+				Edge("C", "D", nil).
+				Edge("D", "E", nil),
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			}
+
+			for _, vertex := range []int{1, 2, 3, 4} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target, EdgeWeight(edge.Properties.Weight))
+			}
+
+			embeddings, err := Match(g, test.pattern)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			actual := make([]string, 0, len(embeddings))
+			for _, embedding := range embeddings {
+				actual = append(actual, formatEmbedding(embedding))
+			}
+
+			sort.Strings(actual)
+
+			if len(actual) != len(test.expected) {
+				t.Fatalf("expected embeddings %v, got %v", test.expected, actual)
+			}
+
+			for i := range actual {
+				if actual[i] != test.expected[i] {
+					t.Errorf("expected embeddings %v, got %v", test.expected, actual)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestMatch_emptyPattern(t *testing.T) {
+	g := New(IntHash)
+	_ = g.AddVertex(1)
+
+	if _, err := Match(g, NewPattern[int]()); err == nil {
+		t.Error("expected error for empty pattern, got nil")
+	}
+}
+
+func TestPattern_matchingOrder(t *testing.T) {
+	pattern := NewPattern[int]().
+		Vertex("isolated", nil).
+		Edge("A", "B", nil).
+		Edge("B", "C", nil).
+		Edge("B", "D", nil).
+		Edge("C", "D", nil)
+
+	order := pattern.matchingOrder()
+
+	names := make([]string, len(order))
+	for i, vertex := range order {
+		names[i] = pattern.names[vertex]
+	}
+
+	expected := []string{"B", "C", "D", "A", "isolated"}
+
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatalf("expected order %v, got %v", expected, names)
+		}
+	}
+}
+
+// formatEmbedding formats an embedding as a string with the pattern vertices in
+// alphabetical order, such as "A=1 B=2".
+func formatEmbedding(embedding map[string]int) string {
+	names := make([]string, 0, len(embedding))
+	for name := range embedding {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	formatted := ""
+	for i, name := range names {
+		if i > 0 {
+			formatted += " "
+		}
+		formatted += fmt.Sprintf("%s=%d", name, embedding[name])
+	}
+
+	return formatted
+}
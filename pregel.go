@@ -0,0 +1,202 @@
+package graph
+
+import "fmt"
+
+// Message is a message of type M that a vertex program sends to the vertex with
+// the hash Target. It is delivered at the beginning of the next superstep.
+type Message[K comparable, M any] struct {
+	Target K
+	Value  M
+}
+
+// VertexProgram is the function that Compute runs for each vertex in each
+// superstep. It receives the vertex hash, the current state of the vertex, and
+// all messages sent to the vertex in the previous superstep. It returns the new
+// state of the vertex and the messages to send to other vertices.
+type VertexProgram[K comparable, S any, M any] func(vertex K, state S, inbox []M) (S, []Message[K, M])
+
+type computation struct {
+	maxSupersteps int
+	parallelism   int
+	progress      func(processed, total int)
+	// combiner is the func(M, M) M registered using WithCombiner. It has to be
+	// stored as any because computation isn't generic.
+	combiner any
+}
+
+// MaxSupersteps limits the number of supersteps that Compute runs. By default,
+// the number of supersteps is unlimited.
+func MaxSupersteps(n int) func(*computation) {
+	return func(c *computation) {
+		c.maxSupersteps = n
+	}
+}
+
+// WithCombiner registers a function that Compute uses to combine all messages
+// sent to the same vertex in a superstep into a single message, as soon as they
+// are sent. This reduces the memory needed for the messages when many of them
+// are sent to the same vertex, and the vertex program receives an inbox with
+// at most one message:
+//
+//	// Only the smallest distance sent to a vertex matters.
+//	states, _ := graph.Compute(g, initial, program, graph.WithCombiner(func(a, b int) int {
+//		if a < b {
+//			return a
+//		}
+//		return b
+//	}))
+//
+// The combiner must be commutative and associative, because the messages are
+// combined in an arbitrary order. It has to accept two messages of the type M
+// used by the vertex program, otherwise Compute returns an error.
+func WithCombiner[M any](combine func(a, b M) M) func(*computation) {
+	return func(c *computation) {
+		c.combiner = combine
+	}
+}
+
+// Compute runs a vertex program in bulk-synchronous supersteps, as described in
+// Google's Pregel paper. The state of each vertex is initialized using initial.
+// Then, Compute runs the supersteps:
+//
+//   - In the first superstep, the program runs for all vertices with an empty
+//     inbox.
+//   - In each following superstep, the program runs for all vertices that have
+//     received messages in the previous superstep. All other vertices keep
+//     their state.
+//
+// The computation ends when no messages have been sent in a superstep or when
+// the maximum number of supersteps set using [MaxSupersteps] is reached. It
+// returns the final state of all vertices.
+//
+// Within a superstep, the program runs for multiple vertices in parallel, so
+// it must not modify shared data without synchronization. The number of
+// goroutines can be limited using [WithParallelism] or [SetParallelism]. Use
+// [WithProgress] to report the progress after each superstep, and
+// [WithCombiner] to combine the messages sent to the same vertex.
+//
+// Messages can only be sent to existing vertices, but apart from that, they
+// don't have to follow the graph's edges. Typically, the program obtains the
+// neighbors of a vertex from an adjacency map retrieved before calling Compute:
+//
+//	adjacencyMap, _ := g.AdjacencyMap()
+//
+//	// Compute the smallest vertex reachable from each vertex.
+//	states, _ := graph.Compute(g, func(v int) int { return v },
+//		func(v int, state int, inbox []int) (int, []graph.Message[int, int]) {
+//			changed := len(inbox) == 0
+//			for _, m := range inbox {
+//				if m < state {
+//					state, changed = m, true
+//				}
+//			}
+//			if !changed {
+//				return state, nil
+//			}
+//			var messages []graph.Message[int, int]
+//			for neighbor := range adjacencyMap[v] {
+//				messages = append(messages, graph.Message[int, int]{Target: neighbor, Value: state})
+//			}
+//			return state, messages
+//		})
+func Compute[K comparable, T any, S any, M any](g Graph[K, T], initial func(vertex K) S, program VertexProgram[K, S, M], options ...func(*computation)) (map[K]S, error) {
+	var c computation
+
+	for _, option := range options {
+		option(&c)
+	}
+
+	var combine func(a, b M) M
+
+	if c.combiner != nil {
+		var ok bool
+		if combine, ok = c.combiner.(func(a, b M) M); !ok {
+			return nil, fmt.Errorf("combiner of type %T doesn't accept the messages of the vertex program", c.combiner)
+		}
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	states := make(map[K]S, len(adjacencyMap))
+	active := make([]K, 0, len(adjacencyMap))
+
+	for vertex := range adjacencyMap {
+		states[vertex] = initial(vertex)
+		active = append(active, vertex)
+	}
+
+	inboxes := make(map[K][]M)
+
+	for superstep := 0; c.maxSupersteps <= 0 || superstep < c.maxSupersteps; superstep++ {
+		outboxes := runSuperstep(c.parallelism, active, states, inboxes, program)
+
+		inboxes = make(map[K][]M)
+		active = active[:0]
+
+		for _, outbox := range outboxes {
+			for _, message := range outbox {
+				if _, ok := states[message.Target]; !ok {
+					return nil, fmt.Errorf("failed to send message to vertex %v: %w", message.Target, ErrVertexNotFound)
+				}
+
+				inbox, ok := inboxes[message.Target]
+				if !ok {
+					active = append(active, message.Target)
+				}
+
+				if combine != nil && ok {
+					inbox[0] = combine(inbox[0], message.Value)
+					continue
+				}
+
+				inboxes[message.Target] = append(inboxes[message.Target], message.Value)
+			}
+		}
+
+		if c.progress != nil {
+			total := c.maxSupersteps
+			if total < 0 {
+				total = 0
+			}
+			c.progress(superstep+1, total)
+		}
+
+		if len(active) == 0 {
+			break
+		}
+	}
+
+	return states, nil
+}
+
+// runSuperstep runs the vertex program for all active vertices in parallel and
+// stores their new states. It returns the messages sent by the vertices, one
+// slice per worker.
+func runSuperstep[K comparable, S any, M any](parallelism int, active []K, states map[K]S, inboxes map[K][]M, program VertexProgram[K, S, M]) [][]Message[K, M] {
+	workers := workerCount(parallelism, len(active))
+
+	outboxes := make([][]Message[K, M], workers)
+	newStates := make([]S, len(active))
+
+	runParallel(workers, len(active), func(worker, start, end int) {
+		for i := start; i < end; i++ {
+			vertex := active[i]
+
+			// The states map is only read concurrently here. The new states are
+			// written after all workers have finished.
+			state, messages := program(vertex, states[vertex], inboxes[vertex])
+
+			newStates[i] = state
+			outboxes[worker] = append(outboxes[worker], messages...)
+		}
+	})
+
+	for i, vertex := range active {
+		states[vertex] = newStates[i]
+	}
+
+	return outboxes
+}
@@ -0,0 +1,242 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	g := New(IntHash)
+
+	for i := 0; i < 8; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	_ = g.AddEdge(3, 1)
+	_ = g.AddEdge(1, 4)
+	_ = g.AddEdge(4, 7)
+	_ = g.AddEdge(2, 6)
+	_ = g.AddEdge(6, 5)
+
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	// Label each vertex with the smallest vertex in its component.
+	program := func(v int, state int, inbox []int) (int, []Message[int, int]) {
+		changed := len(inbox) == 0
+		for _, m := range inbox {
+			if m < state {
+				state, changed = m, true
+			}
+		}
+		if !changed {
+			return state, nil
+		}
+		var messages []Message[int, int]
+		for neighbor := range adjacencyMap[v] {
+			messages = append(messages, Message[int, int]{Target: neighbor, Value: state})
+		}
+		return state, messages
+	}
+
+	states, err := Compute(g, func(v int) int { return v }, program)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[int]int{0: 0, 1: 1, 2: 2, 3: 1, 4: 1, 5: 2, 6: 2, 7: 1}
+
+	for vertex, component := range expected {
+		if states[vertex] != component {
+			t.Errorf("expected component %d for vertex %d, got %d", component, vertex, states[vertex])
+		}
+	}
+}
+
+func TestCompute_ShortestPaths(t *testing.T) {
+	g := New(StringHash, Directed(), Weighted())
+
+	for _, vertex := range []string{"A", "B", "C", "D", "E"} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge("A", "B", EdgeWeight(4))
+	_ = g.AddEdge("A", "C", EdgeWeight(1))
+	_ = g.AddEdge("C", "B", EdgeWeight(2))
+	_ = g.AddEdge("B", "D", EdgeWeight(1))
+	_ = g.AddEdge("C", "D", EdgeWeight(5))
+
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	const infinity = 1 << 30
+
+	initial := func(v string) int {
+		if v == "A" {
+			return 0
+		}
+		return infinity
+	}
+
+	program := func(v string, distance int, inbox []int) (int, []Message[string, int]) {
+		improved := len(inbox) == 0 && distance == 0
+		for _, m := range inbox {
+			if m < distance {
+				distance, improved = m, true
+			}
+		}
+		if !improved {
+			return distance, nil
+		}
+		var messages []Message[string, int]
+		for target, edge := range adjacencyMap[v] {
+			messages = append(messages, Message[string, int]{Target: target, Value: distance + edge.Properties.Weight})
+		}
+		return distance, messages
+	}
+
+	states, err := Compute(g, initial, program)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]int{"A": 0, "B": 3, "C": 1, "D": 4, "E": infinity}
+
+	for vertex, distance := range expected {
+		if states[vertex] != distance {
+			t.Errorf("expected distance %d for vertex %s, got %d", distance, vertex, states[vertex])
+		}
+	}
+}
+
+func TestCompute_MaxSupersteps(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+
+	// Both vertices keep sending messages to each other forever.
+	program := func(v int, count int, _ []struct{}) (int, []Message[int, struct{}]) {
+		return count + 1, []Message[int, struct{}]{{Target: 3 - v}}
+	}
+
+	states, err := Compute(g, func(int) int { return 0 }, program, MaxSupersteps(5))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if states[1] != 5 || states[2] != 5 {
+		t.Errorf("expected both vertices to run 5 times, got %v", states)
+	}
+}
+
+func TestCompute_UnknownTarget(t *testing.T) {
+	g := New(IntHash)
+
+	_ = g.AddVertex(1)
+
+	program := func(v int, state bool, _ []bool) (bool, []Message[int, bool]) {
+		return state, []Message[int, bool]{{Target: 2, Value: true}}
+	}
+
+	_, err := Compute(g, func(int) bool { return false }, program)
+	if !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+}
+
+func TestCompute_Combiner(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 5; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	// In the first superstep, all vertices send their hash to vertex 0.
+	program := func(v int, state int, inbox []int) (int, []Message[int, int]) {
+		if len(inbox) > 1 {
+			t.Errorf("expected at most one message, got %v", inbox)
+		}
+		if len(inbox) == 1 {
+			return inbox[0], nil
+		}
+		return state, []Message[int, int]{{Target: 0, Value: v}}
+	}
+
+	sum := func(a, b int) int { return a + b }
+
+	states, err := Compute(g, func(int) int { return 0 }, program, WithCombiner(sum))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if states[0] != 10 {
+		t.Errorf("expected combined message 10, got %d", states[0])
+	}
+
+	mismatched := func(a, b string) string { return a + b }
+
+	if _, err := Compute(g, func(int) int { return 0 }, program, WithCombiner(mismatched)); err == nil {
+		t.Error("expected error for combiner with the wrong message type")
+	}
+}
+
+func TestCompute_LabelPropagation(t *testing.T) {
+	g := New(IntHash)
+
+	for i := 1; i <= 6; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	// Two triangles connected by a single edge.
+	for _, edge := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {4, 5}, {5, 6}, {6, 4}, {3, 4}} {
+		_ = g.AddEdge(edge[0], edge[1])
+	}
+
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	// Each vertex adopts the most frequent label among its neighbors, breaking
+	// ties using the smallest label. Synchronous label propagation may
+	// oscillate, so the number of supersteps is limited.
+	program := func(v int, label int, inbox []int) (int, []Message[int, int]) {
+		if len(inbox) > 0 {
+			counts := make(map[int]int)
+			for _, m := range inbox {
+				counts[m]++
+			}
+			best := label
+			for candidate, count := range counts {
+				if count > counts[best] || count == counts[best] && candidate < best {
+					best = candidate
+				}
+			}
+			if best == label {
+				return label, nil
+			}
+			label = best
+		}
+		var messages []Message[int, int]
+		for neighbor := range adjacencyMap[v] {
+			messages = append(messages, Message[int, int]{Target: neighbor, Value: label})
+		}
+		return label, messages
+	}
+
+	initial := func(v int) int {
+		if v <= 3 {
+			return 1
+		}
+		return 4
+	}
+
+	states, err := Compute(g, initial, program, MaxSupersteps(10))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[int]int{1: 1, 2: 1, 3: 1, 4: 4, 5: 4, 6: 4}
+
+	for vertex, label := range expected {
+		if states[vertex] != label {
+			t.Errorf("expected label %d for vertex %d, got %d", label, vertex, states[vertex])
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package graph
+
+import "sync"
+
+// WithProgress registers a function that is called while a long-running
+// computation like AllPairsShortestPaths or AggregateNeighbors is running, so
+// that CLIs and UIs can show a progress bar:
+//
+//	paths, _ := graph.AllPairsShortestPaths(g, graph.WithProgress(func(processed, total int) {
+//		fmt.Printf("\r%d%%", processed*100/total)
+//	}))
+//
+// processed is the number of vertices that have been processed so far, and
+// total is the number of vertices to process. To keep the overhead low, the
+// function is only called when the percentage of processed vertices has
+// increased and after the last vertex has been processed. It is never called
+// concurrently, even if the computation runs in parallel.
+//
+// For Compute, processed is the number of supersteps that have been completed,
+// and total is the maximum number of supersteps set using [MaxSupersteps], or
+// zero if the number of supersteps is unlimited. The function is called after
+// each superstep. For AggregateEdges, processed and total count edges instead
+// of vertices.
+func WithProgress(progress func(processed, total int)) func(*computation) {
+	return func(c *computation) {
+		c.progress = progress
+	}
+}
+
+// progressReporter counts the processed items of a computation and passes the
+// progress to the function registered using [WithProgress]. It is safe for
+// concurrent use.
+type progressReporter struct {
+	lock      sync.Mutex
+	report    func(processed, total int)
+	processed int
+	total     int
+	percent   int
+}
+
+func newProgressReporter(report func(processed, total int), total int) *progressReporter {
+	return &progressReporter{
+		report: report,
+		total:  total,
+	}
+}
+
+// add adds n processed items and reports the progress if the percentage of
+// processed items has increased or all items have been processed.
+func (p *progressReporter) add(n int) {
+	if p.report == nil {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.processed += n
+
+	percent := p.processed * 100 / p.total
+
+	if percent > p.percent || p.processed == p.total {
+		p.percent = percent
+		p.report(p.processed, p.total)
+	}
+}
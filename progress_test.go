@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProgressReporter(t *testing.T) {
+	tests := map[string]struct {
+		total           int
+		expectedReports int
+	}{
+		"fewer items than percentages": {
+			total:           4,
+			expectedReports: 4,
+		},
+		"more items than percentages": {
+			total:           1000,
+			expectedReports: 100,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reported := make([]int, 0)
+
+			p := newProgressReporter(func(processed, total int) {
+				if total != test.total {
+					t.Errorf("expected total %d, got %d", test.total, total)
+				}
+				reported = append(reported, processed)
+			}, test.total)
+
+			for i := 0; i < test.total; i++ {
+				p.add(1)
+			}
+
+			// Each percentage is reported only once.
+			if len(reported) != test.expectedReports {
+				t.Fatalf("expected %d reports, got %d", test.expectedReports, len(reported))
+			}
+
+			if reported[len(reported)-1] != test.total {
+				t.Errorf("expected last report to be %d, got %d", test.total, reported[len(reported)-1])
+			}
+		})
+	}
+}
+
+func TestWithProgress(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for i := 0; i < 10; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	for i := 0; i < 9; i++ {
+		_ = g.AddEdge(i, i+1)
+	}
+
+	last := 0
+	progress := WithProgress(func(processed, total int) {
+		if total != 10 {
+			t.Errorf("expected total 10, got %d", total)
+		}
+		last = processed
+	})
+
+	if _, err := AllPairsShortestPaths(g, progress); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if last != 10 {
+		t.Errorf("expected 10 processed vertices, got %d", last)
+	}
+
+	last = 0
+	sum := func(a, b int) int { return a + b }
+
+	if _, err := AggregateNeighbors(g, func(Edge[int]) int { return 1 }, sum, progress); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if last != 10 {
+		t.Errorf("expected 10 processed vertices, got %d", last)
+	}
+
+	supersteps := make([]int, 0)
+
+	_, err := Compute(g, func(int) int { return 0 },
+		func(v int, state int, _ []struct{}) (int, []Message[int, struct{}]) {
+			return state, []Message[int, struct{}]{{Target: v}}
+		},
+		MaxSupersteps(3),
+		WithProgress(func(processed, total int) {
+			if total != 3 {
+				t.Errorf("expected total 3, got %d", total)
+			}
+			supersteps = append(supersteps, processed)
+		}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(supersteps, []int{1, 2, 3}) {
+		t.Errorf("expected progress after each superstep, got %v", supersteps)
+	}
+}
@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RelaxationOutcome is the result of relaxing an edge in a path query.
+type RelaxationOutcome int
+
+const (
+	// RelaxationImproved indicates that the edge has led to a shorter path to
+	// its target vertex, and the source became the target's new predecessor.
+	RelaxationImproved RelaxationOutcome = iota
+	// RelaxationRejected indicates that the path over the edge has been longer
+	// than the shortest known path to the target vertex.
+	RelaxationRejected
+	// RelaxationTied indicates that the path over the edge has been as long as
+	// the shortest known path to the target vertex. Ties are broken in favor of
+	// the path found first, so the target vertex kept its predecessor.
+	RelaxationTied
+)
+
+func (o RelaxationOutcome) String() string {
+	switch o {
+	case RelaxationImproved:
+		return "improved"
+	case RelaxationRejected:
+		return "rejected"
+	case RelaxationTied:
+		return "tied"
+	}
+
+	return fmt.Sprintf("RelaxationOutcome(%d)", int(o))
+}
+
+// Relaxation describes a single edge relaxation performed by a path query: the
+// comparison of the path to Target over Source against the shortest path to
+// Target known at that time.
+type Relaxation[K comparable] struct {
+	Source K
+	Target K
+	// Weight is the weight of the edge as used by the query, which is 1 for
+	// edges of unweighted graphs.
+	Weight int
+	// Candidate is the length of the path to Target over Source.
+	Candidate float64
+	// Current is the length of the shortest known path to Target before the
+	// relaxation. It is positive infinity if no path had been known yet.
+	Current float64
+	// Outcome is the result of comparing Candidate with Current.
+	Outcome RelaxationOutcome
+}
+
+func (r Relaxation[K]) String() string {
+	comparison := ">"
+	switch r.Outcome {
+	case RelaxationImproved:
+		comparison = "<"
+	case RelaxationTied:
+		comparison = "="
+	}
+
+	return fmt.Sprintf("(%v, %v) weight %d: %v %s %v, %s", r.Source, r.Target, r.Weight, r.Candidate, comparison, r.Current, r.Outcome)
+}
+
+// Provenance records how a path query arrived at its result. It can be used to
+// explain why a particular path has been chosen and why alternatives have been
+// rejected. Pass a Provenance to a path query using [WithProvenance].
+type Provenance[K comparable] struct {
+	// Relaxations contains all edge relaxations in the order they have been
+	// performed.
+	Relaxations []Relaxation[K]
+}
+
+// RelaxationsOf returns all relaxations of edges leading to the given vertex,
+// which explain why the vertex ended up with its predecessor on the path.
+func (p *Provenance[K]) RelaxationsOf(target K) []Relaxation[K] {
+	relaxations := make([]Relaxation[K], 0)
+
+	for _, relaxation := range p.Relaxations {
+		if relaxation.Target == target {
+			relaxations = append(relaxations, relaxation)
+		}
+	}
+
+	return relaxations
+}
+
+// String returns a human-readable report with one relaxation per line.
+func (p *Provenance[K]) String() string {
+	var builder strings.Builder
+
+	for _, relaxation := range p.Relaxations {
+		builder.WriteString(relaxation.String())
+		builder.WriteByte('\n')
+	}
+
+	return builder.String()
+}
+
+func (p *Provenance[K]) record(relaxation Relaxation[K]) {
+	if p != nil {
+		p.Relaxations = append(p.Relaxations, relaxation)
+	}
+}
+
+type pathQuery[K comparable] struct {
+	provenance *Provenance[K]
+	heuristic  func(K) float64
+}
+
+// WithProvenance makes a path query like [ShortestPath] or [HasPath] record its
+// decisions in the given Provenance, which is reset before the query runs:
+//
+//	var provenance graph.Provenance[string]
+//
+//	path, _ := graph.ShortestPath(g, "A", "B", graph.WithProvenance(&provenance))
+//
+//	// Explain why the path to B goes over its predecessor.
+//	for _, relaxation := range provenance.RelaxationsOf("B") {
+//		fmt.Println(relaxation)
+//	}
+//
+// A nil Provenance doesn't record anything.
+func WithProvenance[K comparable](provenance *Provenance[K]) func(*pathQuery[K]) {
+	return func(q *pathQuery[K]) {
+		if provenance != nil {
+			provenance.Relaxations = provenance.Relaxations[:0]
+		}
+		q.provenance = provenance
+	}
+}
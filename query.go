@@ -0,0 +1,233 @@
+package graph
+
+import "fmt"
+
+// Traversal is a multi-hop query on a graph, created using [Query]. Similar to
+// Gremlin, a traversal is a sequence of steps: It starts at one or more
+// vertices, and each step either moves to the neighbors of the current
+// vertices or filters them. The result of the last step can be retrieved using
+// Hashes, Values, or Count.
+//
+//	// Find the names of all friends of friends of Alice that are older than 30.
+//	names, err := graph.Query(g).
+//		From("alice").
+//		Out().
+//		Out().
+//		Where(func(p person) bool { return p.age > 30 }).
+//		Dedup().
+//		Values()
+//
+// Like in Gremlin, each step is applied to every current vertex separately, so
+// a vertex that is reachable via several paths occurs several times. Use Dedup
+// to remove these duplicates. The order of the vertices is undefined.
+//
+// Errors that occur in any step are deferred and returned by the final method,
+// like with a Builder. Once an error occurred, all subsequent steps are no-ops.
+type Traversal[K comparable, T any] struct {
+	g              Graph[K, T]
+	adjacencyMap   map[K]map[K]Edge[K]
+	predecessorMap map[K]map[K]Edge[K]
+	current        []K
+	err            error
+}
+
+// Query creates a new traversal for the given graph. A traversal has to be
+// started using [Traversal.From].
+func Query[K comparable, T any](g Graph[K, T]) *Traversal[K, T] {
+	return &Traversal[K, T]{
+		g: g,
+	}
+}
+
+// From sets the current vertices of the traversal to the given vertices. If one
+// of the vertices doesn't exist, the traversal fails with an error that wraps
+// ErrVertexNotFound.
+func (t *Traversal[K, T]) From(hashes ...K) *Traversal[K, T] {
+	if t.err != nil {
+		return t
+	}
+
+	for _, hash := range hashes {
+		if _, err := t.g.Vertex(hash); err != nil {
+			t.err = fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+			return t
+		}
+	}
+
+	t.current = append([]K(nil), hashes...)
+
+	return t
+}
+
+// Out moves the traversal to the vertices that the current vertices have an
+// outgoing edge to. In an undirected graph, these are all neighbors.
+func (t *Traversal[K, T]) Out() *Traversal[K, T] {
+	return t.step(false, true)
+}
+
+// In moves the traversal to the vertices that have an edge to the current
+// vertices. In an undirected graph, these are all neighbors.
+func (t *Traversal[K, T]) In() *Traversal[K, T] {
+	return t.step(true, false)
+}
+
+// Both moves the traversal to all vertices that are connected with the current
+// vertices by an outgoing or incoming edge.
+func (t *Traversal[K, T]) Both() *Traversal[K, T] {
+	return t.step(true, true)
+}
+
+// Where only keeps the current vertices for which the predicate returns true.
+func (t *Traversal[K, T]) Where(predicate func(T) bool) *Traversal[K, T] {
+	if t.err != nil {
+		return t
+	}
+
+	filtered := t.current[:0]
+
+	for _, hash := range t.current {
+		value, err := t.g.Vertex(hash)
+		if err != nil {
+			t.err = fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+			return t
+		}
+
+		if predicate(value) {
+			filtered = append(filtered, hash)
+		}
+	}
+
+	t.current = filtered
+
+	return t
+}
+
+// Dedup removes duplicate vertices from the current vertices, keeping the
+// first occurrence of each vertex.
+func (t *Traversal[K, T]) Dedup() *Traversal[K, T] {
+	if t.err != nil {
+		return t
+	}
+
+	seen := make(map[K]struct{}, len(t.current))
+	deduplicated := t.current[:0]
+
+	for _, hash := range t.current {
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+
+		seen[hash] = struct{}{}
+		deduplicated = append(deduplicated, hash)
+	}
+
+	t.current = deduplicated
+
+	return t
+}
+
+// Limit only keeps the first n current vertices.
+func (t *Traversal[K, T]) Limit(n int) *Traversal[K, T] {
+	if t.err != nil {
+		return t
+	}
+
+	if n < 0 {
+		n = 0
+	}
+
+	if n < len(t.current) {
+		t.current = t.current[:n]
+	}
+
+	return t
+}
+
+// Hashes returns the hashes of the current vertices or the first error that
+// occurred during the traversal.
+func (t *Traversal[K, T]) Hashes() ([]K, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	return append([]K(nil), t.current...), nil
+}
+
+// Values returns the values of the current vertices or the first error that
+// occurred during the traversal.
+func (t *Traversal[K, T]) Values() ([]T, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	values := make([]T, len(t.current))
+
+	for i, hash := range t.current {
+		value, err := t.g.Vertex(hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+// Count returns the number of current vertices or the first error that
+// occurred during the traversal.
+func (t *Traversal[K, T]) Count() (int, error) {
+	if t.err != nil {
+		return 0, t.err
+	}
+
+	return len(t.current), nil
+}
+
+// step moves the traversal along the incoming edges, the outgoing edges, or
+// both. The adjacency and predecessor maps are only obtained once, when they
+// are needed for the first time.
+func (t *Traversal[K, T]) step(in, out bool) *Traversal[K, T] {
+	if t.err != nil {
+		return t
+	}
+
+	if t.adjacencyMap == nil {
+		if t.adjacencyMap, t.err = t.g.AdjacencyMap(); t.err != nil {
+			t.err = fmt.Errorf("failed to get adjacency map: %w", t.err)
+			return t
+		}
+	}
+
+	// In an undirected graph, incoming and outgoing edges are the same, so
+	// they must not be followed twice.
+	if !t.g.Traits().IsDirected {
+		in, out = false, true
+	}
+
+	if in && t.predecessorMap == nil {
+		if t.predecessorMap, t.err = t.g.PredecessorMap(); t.err != nil {
+			t.err = fmt.Errorf("failed to get predecessor map: %w", t.err)
+			return t
+		}
+	}
+
+	next := make([]K, 0, len(t.current))
+
+	for _, hash := range t.current {
+		if out {
+			for adjacency := range t.adjacencyMap[hash] {
+				next = append(next, adjacency)
+			}
+		}
+		if in {
+			for predecessor := range t.predecessorMap[hash] {
+				next = append(next, predecessor)
+			}
+		}
+	}
+
+	t.current = next
+
+	return t
+}
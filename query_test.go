@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		query       func(*Traversal[int, int]) *Traversal[int, int]
+		expected    []int
+		expectedErr error
+	}{
+		"from": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(1, 2)
+			},
+			expected: []int{1, 2},
+		},
+		"out": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(1).Out()
+			},
+			expected: []int{2, 3},
+		},
+		"two hops with duplicates": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(1).Out().Out()
+			},
+			expected: []int{4, 4, 5},
+		},
+		"two hops with dedup": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(1).Out().Out().Dedup()
+			},
+			expected: []int{4, 5},
+		},
+		"in": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(4).In()
+			},
+			expected: []int{2, 3},
+		},
+		"both": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(2).Both()
+			},
+			expected: []int{1, 4},
+		},
+		"where": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(1).Out().Out().Where(func(value int) bool { return value > 4 })
+			},
+			expected: []int{5},
+		},
+		"limit": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(1, 2, 3).Limit(2)
+			},
+			expected: []int{1, 2},
+		},
+		"dead end": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(5).Out().Out()
+			},
+			expected: []int{},
+		},
+		"undirected both follows each edge once": {
+			isDirected: false,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(2).Both()
+			},
+			expected: []int{1, 4},
+		},
+		"undirected in": {
+			isDirected: false,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(4).In()
+			},
+			expected: []int{2, 3},
+		},
+		"missing start vertex": {
+			isDirected: true,
+			query: func(q *Traversal[int, int]) *Traversal[int, int] {
+				return q.From(1, 10).Out()
+			},
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			}
+
+			for _, vertex := range []int{1, 2, 3, 4, 5} {
+				_ = g.AddVertex(vertex)
+			}
+
+			_ = g.AddEdge(1, 2)
+			_ = g.AddEdge(1, 3)
+			_ = g.AddEdge(2, 4)
+			_ = g.AddEdge(3, 4)
+			_ = g.AddEdge(3, 5)
+
+			hashes, err := test.query(Query(g)).Hashes()
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			sort.Ints(hashes)
+
+			if len(hashes) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, hashes)
+			}
+
+			for i := range hashes {
+				if hashes[i] != test.expected[i] {
+					t.Fatalf("expected %v, got %v", test.expected, hashes)
+				}
+			}
+
+			values, err := test.query(Query(g)).Values()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(values) != len(test.expected) {
+				t.Errorf("expected %d values, got %v", len(test.expected), values)
+			}
+
+			count, err := test.query(Query(g)).Count()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if count != len(test.expected) {
+				t.Errorf("expected count %d, got %d", len(test.expected), count)
+			}
+		})
+	}
+}
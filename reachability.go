@@ -0,0 +1,100 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ReachabilityIndex answers whether a vertex of a directed graph is reachable
+// from another vertex in constant time. It precomputes the transitive closure
+// of the graph as one bitset per vertex, where each bit indicates whether the
+// corresponding vertex is reachable.
+//
+// Vertices in the same strongly connected component can reach each other and
+// the same set of other vertices, so they share a bitset. For a directed
+// acyclic graph, each vertex is its own component. Building the index takes
+// O(|V|+|E|*|C|/64) time and the bitsets take O(|C|²/8) bytes of memory, where
+// |C| is the number of strongly connected components. This makes the index a
+// good fit for answering many reachability queries on graphs with up to tens
+// of thousands of components. For a few queries, use [HasPath] instead.
+//
+// The index reflects the state of the graph at the time it has been built, and
+// it has to be built again after the structure of the graph has changed.
+type ReachabilityIndex[K comparable] struct {
+	components map[K]int
+	closure    [][]uint64
+}
+
+// NewReachabilityIndex builds a reachability index for the given graph, which
+// has to be directed.
+func NewReachabilityIndex[K comparable, T any](g Graph[K, T]) (*ReachabilityIndex[K], error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("reachability index cannot be built for undirected graph")
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	// StronglyConnectedComponents uses Tarjan's algorithm, which returns the
+	// components in reverse topological order. Hence, all components that are
+	// reachable from a component come before it.
+	sccs, err := StronglyConnectedComponents(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute strongly connected components: %w", err)
+	}
+
+	r := &ReachabilityIndex[K]{
+		components: make(map[K]int, len(adjacencyMap)),
+		closure:    make([][]uint64, len(sccs)),
+	}
+
+	for component, vertices := range sccs {
+		for _, vertex := range vertices {
+			r.components[vertex] = component
+		}
+	}
+
+	words := (len(sccs) + 63) / 64
+
+	for component, vertices := range sccs {
+		reachable := make([]uint64, words)
+		reachable[component/64] |= 1 << (component % 64)
+
+		for _, vertex := range vertices {
+			for adjacency := range adjacencyMap[vertex] {
+				successor := r.components[adjacency]
+				if successor == component {
+					continue
+				}
+
+				for i, word := range r.closure[successor] {
+					reachable[i] |= word
+				}
+			}
+		}
+
+		r.closure[component] = reachable
+	}
+
+	return r, nil
+}
+
+// Reachable reports whether the target vertex is reachable from the source
+// vertex by following the edges in their direction. A vertex is always
+// reachable from itself. If one of the vertices is not contained in the index,
+// the returned error wraps ErrVertexNotFound.
+func (r *ReachabilityIndex[K]) Reachable(source, target K) (bool, error) {
+	sourceComponent, ok := r.components[source]
+	if !ok {
+		return false, fmt.Errorf("could not get vertex with hash %v: %w", source, ErrVertexNotFound)
+	}
+
+	targetComponent, ok := r.components[target]
+	if !ok {
+		return false, fmt.Errorf("could not get vertex with hash %v: %w", target, ErrVertexNotFound)
+	}
+
+	return r.closure[sourceComponent][targetComponent/64]&(1<<(targetComponent%64)) != 0, nil
+}
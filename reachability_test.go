@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestReachabilityIndex(t *testing.T) {
+	tests := map[string]struct {
+		edges    []Edge[int]
+		expected map[[2]int]bool
+	}{
+		"directed acyclic graph": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expected: map[[2]int]bool{
+				{1, 4}: true,
+				{1, 5}: false,
+				{2, 3}: false,
+				{4, 1}: false,
+				{5, 5}: true,
+				{3, 4}: true,
+			},
+		},
+		"graph with cycle": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+				{Source: 5, Target: 1},
+			},
+			expected: map[[2]int]bool{
+				{2, 1}: true,
+				{1, 4}: true,
+				{4, 1}: false,
+				{5, 3}: true,
+				{1, 5}: false,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed())
+
+			for _, vertex := range []int{1, 2, 3, 4, 5} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target)
+			}
+
+			index, err := NewReachabilityIndex(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for pair, expected := range test.expected {
+				reachable, err := index.Reachable(pair[0], pair[1])
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if reachable != expected {
+					t.Errorf("expected reachable(%d, %d) to be %v, got %v", pair[0], pair[1], expected, reachable)
+				}
+			}
+
+			if _, err := index.Reachable(1, 10); !errors.Is(err, ErrVertexNotFound) {
+				t.Errorf("expected ErrVertexNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReachabilityIndex_undirected(t *testing.T) {
+	if _, err := NewReachabilityIndex(New(IntHash)); err == nil {
+		t.Error("expected error for undirected graph, got nil")
+	}
+}
+
+func TestReachabilityIndex_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for _, isAcyclic := range []bool{true, false} {
+		g := New(IntHash, Directed())
+
+		// More than 64 vertices are used so that the bitsets need several
+		// words.
+		for vertex := 0; vertex < 150; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for i := 0; i < 200; i++ {
+			source, target := random.Intn(150), random.Intn(150)
+			if isAcyclic && source >= target {
+				continue
+			}
+			_ = g.AddEdge(source, target)
+		}
+
+		index, err := NewReachabilityIndex(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for i := 0; i < 500; i++ {
+			source, target := random.Intn(150), random.Intn(150)
+
+			expected, _ := HasPath(g, source, target)
+			reachable, _ := index.Reachable(source, target)
+
+			if reachable != expected {
+				t.Fatalf("expected reachable(%d, %d) to be %v, got %v (acyclic: %v)", source, target, expected, reachable, isAcyclic)
+			}
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Reservoir draws a uniform random sample of a fixed size from a stream of
+// items of unknown length, using reservoir sampling. Each item of the stream
+// ends up in the sample with the same probability, and only the sample is kept
+// in memory:
+//
+//	reservoir := graph.NewReservoir[graph.Edge[string]](100, rand.New(rand.NewSource(42)))
+//
+//	for edge := range edges {
+//		reservoir.Add(edge)
+//	}
+//
+//	sample := reservoir.Items()
+//
+// Adding an item takes O(1) time. A Reservoir is not safe for concurrent use.
+type Reservoir[T any] struct {
+	items []T
+	size  int
+	seen  int
+	rng   *rand.Rand
+}
+
+// NewReservoir creates a Reservoir for a sample of the given size that uses rng
+// as its source of randomness.
+func NewReservoir[T any](size int, rng *rand.Rand) *Reservoir[T] {
+	return &Reservoir[T]{
+		items: make([]T, 0),
+		size:  size,
+		rng:   rng,
+	}
+}
+
+// Add adds an item of the stream to the reservoir.
+func (r *Reservoir[T]) Add(item T) {
+	r.seen++
+
+	if len(r.items) < r.size {
+		r.items = append(r.items, item)
+		return
+	}
+
+	if i := r.rng.Intn(r.seen); i < r.size {
+		r.items[i] = item
+	}
+}
+
+// Items returns the current sample in an arbitrary order. If fewer items than
+// the sample size have been added, all of them are returned.
+func (r *Reservoir[T]) Items() []T {
+	items := make([]T, len(r.items))
+	copy(items, r.items)
+	return items
+}
+
+// WeightedReservoir draws a weighted random sample of a fixed size from a stream
+// of items of unknown length, without replacement. Items with a higher weight
+// are more likely to end up in the sample. The sample is drawn using the A-Res
+// algorithm by Efraimidis and Spirakis, which only keeps the sample in memory.
+//
+// Adding an item takes O(log n) time, where n is the size of the sample. A
+// WeightedReservoir is not safe for concurrent use.
+type WeightedReservoir[T any] struct {
+	items reservoirHeap[T]
+	size  int
+	rng   *rand.Rand
+}
+
+// NewWeightedReservoir creates a WeightedReservoir for a sample of the given
+// size that uses rng as its source of randomness.
+func NewWeightedReservoir[T any](size int, rng *rand.Rand) *WeightedReservoir[T] {
+	return &WeightedReservoir[T]{
+		items: make(reservoirHeap[T], 0),
+		size:  size,
+		rng:   rng,
+	}
+}
+
+// Add adds an item of the stream with the given weight to the reservoir. Items
+// with a weight of 0 or less are never sampled.
+func (r *WeightedReservoir[T]) Add(item T, weight float64) {
+	if weight <= 0 || r.size < 1 {
+		return
+	}
+
+	// Each item gets the key u^(1/weight) for a random u in (0, 1), and the
+	// items with the largest keys form the sample. The logarithm of the key
+	// is used instead, which preserves the order of the keys but doesn't
+	// underflow for large weights.
+	key := math.Log(1-r.rng.Float64()) / weight
+
+	if len(r.items) < r.size {
+		heap.Push(&r.items, reservoirItem[T]{item: item, key: key})
+		return
+	}
+
+	if key > r.items[0].key {
+		r.items[0] = reservoirItem[T]{item: item, key: key}
+		heap.Fix(&r.items, 0)
+	}
+}
+
+// Items returns the current sample in an arbitrary order. If fewer items with a
+// positive weight than the sample size have been added, all of them are
+// returned.
+func (r *WeightedReservoir[T]) Items() []T {
+	items := make([]T, len(r.items))
+	for i, item := range r.items {
+		items[i] = item.item
+	}
+	return items
+}
+
+// SampleVertices draws a uniform random sample of n vertices without
+// replacement and returns their hashes in an arbitrary order. This is useful for
+// approximating statistics of large graphs, like the average clustering
+// coefficient, from a subset of their vertices:
+//
+//	sample, _ := graph.SampleVertices(g, 1000, rand.New(rand.NewSource(42)))
+//
+// If the graph has fewer than n vertices, all vertices are returned. The
+// vertices are enumerated once using [VisitVertices] and sampled using a
+// [Reservoir], so SampleVertices only takes O(n) memory if the store provides a
+// fastpath for enumerating its vertices.
+func SampleVertices[K comparable, T any](g Graph[K, T], n int, rng *rand.Rand) ([]K, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("sample size must not be negative, got %d", n)
+	}
+
+	reservoir := NewReservoir[K](n, rng)
+
+	err := VisitVertices(g, func(hash K) bool {
+		reservoir.Add(hash)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit vertices: %w", err)
+	}
+
+	return reservoir.Items(), nil
+}
+
+// SampleEdges draws a random sample of n edges without replacement and returns
+// them in an arbitrary order. In a weighted graph, the probability of an edge
+// to be sampled is proportional to its weight, and edges with a weight of 0 are
+// never sampled. Otherwise, all edges are sampled with the same probability:
+//
+//	sample, _ := graph.SampleEdges(g, 1000, rand.New(rand.NewSource(42)))
+//
+// If the graph has fewer than n edges, all edges are returned. In an undirected
+// graph, each edge is sampled only once, just like [Graph.Edges] returns it
+// once. Negative weights are not permitted.
+func SampleEdges[K comparable, T any](g Graph[K, T], n int, rng *rand.Rand) ([]Edge[K], error) {
+	if n < 0 {
+		return nil, fmt.Errorf("sample size must not be negative, got %d", n)
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	return sampleEdges(edges, n, g.Traits().IsWeighted, rng)
+}
+
+// SampleNeighbors draws a random sample of n adjacent vertices of the given
+// vertex without replacement, which is the neighbor sampling step of graph
+// neural networks like GraphSAGE. In a directed graph, only successors are
+// sampled. Like in [SampleEdges], the probability of a neighbor to be sampled
+// is proportional to the weight of the edge to the neighbor in a weighted
+// graph, and the neighbors are returned in an arbitrary order:
+//
+//	neighbors, _ := graph.SampleNeighbors(g, "A", 10, rand.New(rand.NewSource(42)))
+//
+// If the vertex has fewer than n neighbors, all neighbors are returned. The
+// outgoing edges of the vertex are retrieved in O(deg) time if the store
+// supports it.
+func SampleNeighbors[K comparable, T any](g Graph[K, T], vertex K, n int, rng *rand.Rand) ([]K, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("sample size must not be negative, got %d", n)
+	}
+
+	edges, err := outEdges(g, vertex)
+	if err != nil {
+		return nil, err
+	}
+
+	sample, err := sampleEdges(edges, n, g.Traits().IsWeighted, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	neighbors := make([]K, len(sample))
+	for i, edge := range sample {
+		neighbors[i] = edge.Target
+	}
+
+	return neighbors, nil
+}
+
+// sampleEdges draws a sample of n edges from the given edges, which is weighted
+// by the edge weights if isWeighted is true and uniform otherwise.
+func sampleEdges[K comparable](edges []Edge[K], n int, isWeighted bool, rng *rand.Rand) ([]Edge[K], error) {
+	if !isWeighted {
+		reservoir := NewReservoir[Edge[K]](n, rng)
+
+		for _, edge := range edges {
+			reservoir.Add(edge)
+		}
+
+		return reservoir.Items(), nil
+	}
+
+	reservoir := NewWeightedReservoir[Edge[K]](n, rng)
+
+	for _, edge := range edges {
+		if edge.Properties.Weight < 0 {
+			return nil, fmt.Errorf("edge (%v, %v) has a negative weight", edge.Source, edge.Target)
+		}
+
+		reservoir.Add(edge, float64(edge.Properties.Weight))
+	}
+
+	return reservoir.Items(), nil
+}
+
+type reservoirItem[T any] struct {
+	item T
+	key  float64
+}
+
+// reservoirHeap is a minimum binary heap of reservoir items ordered by their
+// keys that implements heap.Interface.
+type reservoirHeap[T any] []reservoirItem[T]
+
+func (h reservoirHeap[T]) Len() int {
+	return len(h)
+}
+
+func (h reservoirHeap[T]) Less(i, j int) bool {
+	return h[i].key < h[j].key
+}
+
+func (h reservoirHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *reservoirHeap[T]) Push(item interface{}) {
+	*h = append(*h, item.(reservoirItem[T]))
+}
+
+func (h *reservoirHeap[T]) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
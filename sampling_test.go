@@ -0,0 +1,234 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestReservoir(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	counts := make(map[int]int)
+
+	// Each of the 10 items should be sampled in about 3 of 10 runs.
+	for run := 0; run < 10000; run++ {
+		reservoir := NewReservoir[int](3, rng)
+
+		for item := 0; item < 10; item++ {
+			reservoir.Add(item)
+		}
+
+		items := reservoir.Items()
+
+		if len(items) != 3 {
+			t.Fatalf("expected 3 items, got %v", items)
+		}
+
+		for _, item := range items {
+			counts[item]++
+		}
+	}
+
+	for item := 0; item < 10; item++ {
+		if math.Abs(float64(counts[item])/10000-0.3) > 0.02 {
+			t.Errorf("expected item %d to be sampled with probability 0.3, got %v", item, float64(counts[item])/10000)
+		}
+	}
+}
+
+func TestReservoir_fewerItems(t *testing.T) {
+	reservoir := NewReservoir[int](5, rand.New(rand.NewSource(1)))
+
+	reservoir.Add(1)
+	reservoir.Add(2)
+
+	if items := reservoir.Items(); !slicesAreEqual(items, []int{1, 2}) {
+		t.Errorf("expected all items, got %v", items)
+	}
+}
+
+func TestWeightedReservoir(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	counts := make(map[string]int)
+
+	// With a sample size of 1, each item is sampled with a probability that
+	// is proportional to its weight.
+	for run := 0; run < 10000; run++ {
+		reservoir := NewWeightedReservoir[string](1, rng)
+
+		reservoir.Add("A", 1)
+		reservoir.Add("B", 3)
+		reservoir.Add("C", 0)
+
+		for _, item := range reservoir.Items() {
+			counts[item]++
+		}
+	}
+
+	if counts["C"] != 0 {
+		t.Errorf("expected item with weight 0 not to be sampled, got %d times", counts["C"])
+	}
+
+	if p := float64(counts["B"]) / 10000; math.Abs(p-0.75) > 0.02 {
+		t.Errorf("expected item B to be sampled with probability 0.75, got %v", p)
+	}
+}
+
+func TestSampleVertices(t *testing.T) {
+	tests := map[string]struct {
+		vertices []int
+		n        int
+		expected int
+	}{
+		"sample": {
+			vertices: []int{1, 2, 3, 4, 5, 6},
+			n:        4,
+			expected: 4,
+		},
+		"fewer vertices than n": {
+			vertices: []int{1, 2},
+			n:        4,
+			expected: 2,
+		},
+		"empty sample": {
+			vertices: []int{1, 2},
+			n:        0,
+			expected: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			sample, err := SampleVertices(g, test.n, rand.New(rand.NewSource(1)))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(sample) != test.expected {
+				t.Fatalf("expected %d vertices, got %v", test.expected, sample)
+			}
+
+			seen := make(map[int]bool)
+
+			for _, vertex := range sample {
+				if _, err := g.Vertex(vertex); err != nil || seen[vertex] {
+					t.Errorf("expected distinct vertices of the graph, got %v", sample)
+				}
+				seen[vertex] = true
+			}
+		})
+	}
+}
+
+func TestSampleEdges(t *testing.T) {
+	tests := map[string]struct {
+		traits     []func(*Traits)
+		edges      []Edge[int]
+		n          int
+		expected   []Edge[int]
+		shouldFail bool
+	}{
+		"undirected graph": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			n:        5,
+			expected: []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}},
+		},
+		"edges with weight 0 are never sampled": {
+			traits: []func(*Traits){Directed(), Weighted()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 0}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+			},
+			n:        2,
+			expected: []Edge[int]{{Source: 2, Target: 3}},
+		},
+		"negative weight": {
+			traits: []func(*Traits){Weighted()},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -1}},
+			},
+			n:          1,
+			shouldFail: true,
+		},
+		"negative sample size": {
+			n:          -1,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range []int{1, 2, 3} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			sample, err := SampleEdges(g, test.n, rand.New(rand.NewSource(1)))
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if len(sample) != len(test.expected) {
+				t.Fatalf("expected %d edges, got %v", len(test.expected), sample)
+			}
+
+			for _, expected := range test.expected {
+				found := false
+
+				for _, edge := range sample {
+					isSame := edge.Source == expected.Source && edge.Target == expected.Target
+					isReversed := edge.Source == expected.Target && edge.Target == expected.Source
+					if isSame || isReversed && test.traits == nil {
+						found = true
+					}
+				}
+
+				if !found {
+					t.Errorf("expected edge (%d, %d) in sample %v", expected.Source, expected.Target, sample)
+				}
+			}
+		})
+	}
+}
+
+func TestSampleNeighbors(t *testing.T) {
+	g := New(IntHash, Directed(), Weighted())
+
+	for vertex := 1; vertex <= 5; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2, EdgeWeight(1))
+	_ = g.AddEdge(1, 3, EdgeWeight(1))
+	_ = g.AddEdge(1, 4, EdgeWeight(0))
+	_ = g.AddEdge(5, 1, EdgeWeight(1))
+
+	neighbors, err := SampleNeighbors(g, 1, 3, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !slicesAreEqual(neighbors, []int{2, 3}) {
+		t.Errorf("expected neighbors %v, got %v", []int{2, 3}, neighbors)
+	}
+
+	if _, err := SampleNeighbors(g, 6, 1, rand.New(rand.NewSource(1))); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("expected error %v, got %v", ErrVertexNotFound, err)
+	}
+}
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -59,6 +60,136 @@ func Union[K comparable, T any](g, h Graph[K, T]) (Graph[K, T], error) {
 	return union, nil
 }
 
+// ConnectedSubgraphContaining returns the maximal connected subgraph of g that
+// contains the seed vertex and only consists of edges for which the predicate
+// returns true. This answers queries like "which vertices can be reached from
+// this vertex using only encrypted links":
+//
+//	encrypted, _ := graph.ConnectedSubgraphContaining(g, "A", func(e graph.Edge[string]) bool {
+//		return e.Properties.Attributes["encryption"] == "tls"
+//	})
+//
+// Starting at the seed, the subgraph is expanded along all edges that satisfy
+// the predicate. In a directed graph, only outgoing edges are followed, so the
+// subgraph contains all vertices reachable from the seed. The predicate receives
+// the edge with its source and target vertex values and properties, where the
+// source is the vertex that the edge is reached from.
+//
+// The returned graph has the same traits as g and contains the vertices and
+// edges with their properties. If the seed vertex doesn't exist, an error that
+// wraps ErrVertexNotFound is returned.
+func ConnectedSubgraphContaining[K comparable, T any](g Graph[K, T], seed K, predicate func(Edge[T]) bool) (Graph[K, T], error) {
+	if _, err := g.Vertex(seed); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", seed, err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	subgraph := NewLike(g)
+
+	addVertex := func(hash K) (T, error) {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return vertex, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		if err := subgraph.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return vertex, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+
+		return vertex, nil
+	}
+
+	seedValue, err := addVertex(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[K]T{seed: seedValue}
+	queue := []K{seed}
+	edges := make([]Edge[K], 0)
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for adjacency, edge := range adjacencyMap[current] {
+			target, ok := values[adjacency]
+			if !ok {
+				target, _ = g.Vertex(adjacency)
+			}
+
+			if !predicate(Edge[T]{Source: values[current], Target: target, Properties: edge.Properties}) {
+				continue
+			}
+
+			edges = append(edges, edge)
+
+			if ok {
+				continue
+			}
+
+			if values[adjacency], err = addVertex(adjacency); err != nil {
+				return nil, err
+			}
+
+			queue = append(queue, adjacency)
+		}
+	}
+
+	for _, edge := range edges {
+		// In an undirected graph, an edge may have been found from both of its
+		// vertices, so it might already exist.
+		if err := subgraph.AddEdge(copyEdge(edge)); err != nil && !errors.Is(err, ErrEdgeAlreadyExists) {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return subgraph, nil
+}
+
+// inducedSubgraph returns the subgraph of g induced by the given vertices. It
+// contains these vertices and all edges between them along with their
+// properties, and it has the same traits as g.
+func inducedSubgraph[K comparable, T any](g Graph[K, T], vertices map[K]struct{}) (Graph[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	subgraph := NewLike(g)
+
+	for hash := range vertices {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		if err := subgraph.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+	}
+
+	for source := range vertices {
+		for target, edge := range adjacencyMap[source] {
+			if _, ok := vertices[target]; !ok {
+				continue
+			}
+
+			// In an undirected graph, each edge is contained in the adjacency
+			// map twice, so it might already exist.
+			if err := subgraph.AddEdge(copyEdge(edge)); err != nil && !errors.Is(err, ErrEdgeAlreadyExists) {
+				return nil, fmt.Errorf("failed to add edge (%v, %v): %w", source, target, err)
+			}
+		}
+	}
+
+	return subgraph, nil
+}
+
 // unionFind implements a union-find or disjoint set data structure that works
 // with vertex hashes as vertices. It's an internal helper type at the moment,
 // but could perhaps be exposed publicly in the future.
@@ -66,15 +197,20 @@ func Union[K comparable, T any](g, h Graph[K, T]) (Graph[K, T], error) {
 // unionFind is not related to the Union function.
 type unionFind[K comparable] struct {
 	parents map[K]K
+	// sizes contains the number of vertices in the set of each root. Merging
+	// the smaller set into the larger one keeps the trees flat.
+	sizes map[K]int
 }
 
 func newUnionFind[K comparable](vertices ...K) *unionFind[K] {
 	u := &unionFind[K]{
 		parents: make(map[K]K, len(vertices)),
+		sizes:   make(map[K]int, len(vertices)),
 	}
 
 	for _, vertex := range vertices {
 		u.parents[vertex] = vertex
+		u.sizes[vertex] = 1
 	}
 
 	return u
@@ -82,6 +218,7 @@ func newUnionFind[K comparable](vertices ...K) *unionFind[K] {
 
 func (u *unionFind[K]) add(vertex K) {
 	u.parents[vertex] = vertex
+	u.sizes[vertex] = 1
 }
 
 func (u *unionFind[K]) union(vertex1, vertex2 K) {
@@ -92,7 +229,13 @@ func (u *unionFind[K]) union(vertex1, vertex2 K) {
 		return
 	}
 
+	if u.sizes[root1] < u.sizes[root2] {
+		root1, root2 = root2, root1
+	}
+
 	u.parents[root2] = root1
+	u.sizes[root1] += u.sizes[root2]
+	delete(u.sizes, root2)
 }
 
 func (u *unionFind[K]) find(vertex K) K {
@@ -106,8 +249,8 @@ func (u *unionFind[K]) find(vertex K) K {
 	current := vertex
 
 	for u.parents[current] != root {
-		parent := u.parents[vertex]
-		u.parents[vertex] = root
+		parent := u.parents[current]
+		u.parents[current] = root
 		current = parent
 	}
 
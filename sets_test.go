@@ -285,6 +285,27 @@ func TestUnionFind_find(t *testing.T) {
 	}
 }
 
+func TestUnionFind_pathCompression(t *testing.T) {
+	u := unionFind[int]{
+		parents: map[int]int{
+			1: 1,
+			2: 1,
+			3: 2,
+			4: 3,
+		},
+	}
+
+	if root := u.find(4); root != 1 {
+		t.Fatalf("expected root 1, got %v", root)
+	}
+
+	for _, vertex := range []int{2, 3, 4} {
+		if u.parents[vertex] != 1 {
+			t.Errorf("expected parent of %v to be 1 after path compression, got %v", vertex, u.parents[vertex])
+		}
+	}
+}
+
 func adjacencyMapsAreEqual[K comparable](a, b map[K]map[K]Edge[K], edgesAreEqual func(a, b Edge[K]) bool) bool {
 	for aHash, aAdjacencies := range a {
 		bAdjacencies, ok := b[aHash]
@@ -347,3 +368,126 @@ func mapsAreEqual[K comparable](a, b map[K]K) bool {
 
 	return true
 }
+
+func TestConnectedSubgraphContaining(t *testing.T) {
+	tests := map[string]struct {
+		isDirected       bool
+		edges            []Edge[string]
+		seed             string
+		expectedVertices []string
+		expectedEdges    []Edge[string]
+		shouldFail       bool
+	}{
+		"directed graph": {
+			isDirected: true,
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Attributes: map[string]string{"tls": "yes"}}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Attributes: map[string]string{"tls": "yes"}}},
+				{Source: "C", Target: "A", Properties: EdgeProperties{Attributes: map[string]string{"tls": "yes"}}},
+				{Source: "B", Target: "D", Properties: EdgeProperties{Attributes: map[string]string{"tls": "no"}}},
+				{Source: "E", Target: "A", Properties: EdgeProperties{Attributes: map[string]string{"tls": "yes"}}},
+			},
+			seed:             "A",
+			expectedVertices: []string{"A", "B", "C"},
+			expectedEdges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+				{Source: "C", Target: "A"},
+			},
+		},
+		"undirected graph": {
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Attributes: map[string]string{"tls": "yes"}}},
+				{Source: "B", Target: "C", Properties: EdgeProperties{Attributes: map[string]string{"tls": "yes"}}},
+				{Source: "C", Target: "A", Properties: EdgeProperties{Attributes: map[string]string{"tls": "yes"}}},
+				{Source: "C", Target: "D", Properties: EdgeProperties{Attributes: map[string]string{"tls": "no"}}},
+				{Source: "E", Target: "A", Properties: EdgeProperties{Attributes: map[string]string{"tls": "yes"}}},
+			},
+			seed:             "B",
+			expectedVertices: []string{"A", "B", "C", "E"},
+			expectedEdges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "B", Target: "C"},
+				{Source: "C", Target: "A"},
+				{Source: "E", Target: "A"},
+			},
+		},
+		"no matching edges": {
+			isDirected: true,
+			edges: []Edge[string]{
+				{Source: "A", Target: "B", Properties: EdgeProperties{Attributes: map[string]string{"tls": "no"}}},
+			},
+			seed:             "A",
+			expectedVertices: []string{"A"},
+		},
+		"non-existent seed": {
+			seed:       "X",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var g Graph[string, string]
+
+			if test.isDirected {
+				g = New(StringHash, Directed())
+			} else {
+				g = New(StringHash)
+			}
+
+			for _, vertex := range []string{"A", "B", "C", "D", "E"} {
+				_ = g.AddVertex(vertex, VertexWeight(len(vertex)))
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			subgraph, err := ConnectedSubgraphContaining(g, test.seed, func(edge Edge[string]) bool {
+				return edge.Properties.Attributes["tls"] == "yes"
+			})
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if subgraph.Traits().IsDirected != test.isDirected {
+				t.Errorf("expected directed to be %v", test.isDirected)
+			}
+
+			if order, _ := subgraph.Order(); order != len(test.expectedVertices) {
+				t.Errorf("expected %d vertices, got %d", len(test.expectedVertices), order)
+			}
+
+			for _, vertex := range test.expectedVertices {
+				_, properties, err := subgraph.VertexWithProperties(vertex)
+				if err != nil {
+					t.Errorf("expected vertex %v: %v", vertex, err)
+				}
+				if properties.Weight != 1 {
+					t.Errorf("expected vertex properties to be copied for %v", vertex)
+				}
+			}
+
+			if size, _ := subgraph.Size(); size != len(test.expectedEdges) {
+				t.Errorf("expected %d edges, got %d", len(test.expectedEdges), size)
+			}
+
+			for _, edge := range test.expectedEdges {
+				e, err := subgraph.Edge(edge.Source, edge.Target)
+				if err != nil {
+					t.Errorf("expected edge (%v, %v): %v", edge.Source, edge.Target, err)
+					continue
+				}
+				if e.Properties.Attributes["tls"] != "yes" {
+					t.Errorf("expected edge properties to be copied for (%v, %v)", edge.Source, edge.Target)
+				}
+			}
+		})
+	}
+}
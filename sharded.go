@@ -0,0 +1,326 @@
+package graph
+
+import (
+	"fmt"
+	"hash/fnv"
+	"runtime"
+)
+
+// shardedStore is a Store implementation that partitions the vertices across
+// several in-memory stores, the shards, each of which has its own lock. Each
+// vertex belongs to exactly one shard, which also holds the outgoing and
+// ingoing edges of the vertex. Hence, an edge between vertices in different
+// shards is stored in both shards.
+type shardedStore[K comparable, T any] struct {
+	shards []*memoryStore[K, T]
+	key    func(K) uint64
+}
+
+// NewShardedStore creates a new in-memory store that partitions the vertices and
+// edges across the given number of shards, each of which is protected by its own
+// lock. Unlike the default in-memory store, where all writes wait for a single
+// lock, the sharded store lets vertices and edges be added concurrently from
+// multiple goroutines, which makes ingesting large graphs scale with the number
+// of CPUs:
+//
+//	store := graph.NewShardedStore[string, City](16, graph.StringShardKey)
+//	g := graph.NewWithStore(cityHash, store)
+//
+//	for _, batch := range batches {
+//		go func(batch []City) {
+//			for _, city := range batch {
+//				_ = g.AddVertex(city)
+//			}
+//		}(batch)
+//	}
+//
+// The shard of a vertex is determined by the given key function, which should
+// spread the vertex hashes evenly. StringShardKey and IntShardKey can be used
+// for string and integer hashes. If shards is less than 1, the number of usable
+// CPUs as reported by runtime.GOMAXPROCS is used.
+//
+// Adding or removing an edge between vertices in different shards locks both
+// shards. Functions that enumerate all vertices or edges, like [Graph.Edges] or
+// [VisitVertices], visit one shard after another, so they don't see a
+// consistent snapshot of the graph while it is being modified concurrently.
+func NewShardedStore[K comparable, T any](shards int, key func(K) uint64) Store[K, T] {
+	if shards < 1 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+
+	s := &shardedStore[K, T]{
+		shards: make([]*memoryStore[K, T], shards),
+		key:    key,
+	}
+
+	for i := range s.shards {
+		s.shards[i] = newMemoryStore[K, T]().(*memoryStore[K, T])
+	}
+
+	return s
+}
+
+// StringShardKey is a key function for NewShardedStore that spreads string
+// hashes evenly across the shards.
+func StringShardKey(hash string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(hash))
+	return h.Sum64()
+}
+
+// IntShardKey is a key function for NewShardedStore that spreads integer hashes
+// evenly across the shards.
+func IntShardKey(hash int) uint64 {
+	// Multiplying by a large odd constant scatters vertices with hashes that
+	// have a common stride, like multiples of the number of shards.
+	return uint64(hash) * 0x9e3779b97f4a7c15
+}
+
+func (s *shardedStore[K, T]) AddVertex(k K, t T, p VertexProperties) error {
+	return s.shard(k).AddVertex(k, t, p)
+}
+
+// UpdateVertex replaces the value and properties of an existing vertex while
+// keeping its edges. If the vertex doesn't exist, ErrVertexNotFound is
+// returned.
+func (s *shardedStore[K, T]) UpdateVertex(k K, t T, p VertexProperties) error {
+	return s.shard(k).UpdateVertex(k, t, p)
+}
+
+func (s *shardedStore[K, T]) ListVertices() ([]K, error) {
+	hashes := make([]K, 0)
+
+	for _, shard := range s.shards {
+		vertices, _ := shard.ListVertices()
+		hashes = append(hashes, vertices...)
+	}
+
+	return hashes, nil
+}
+
+func (s *shardedStore[K, T]) VertexCount() (int, error) {
+	count := 0
+
+	for _, shard := range s.shards {
+		n, _ := shard.VertexCount()
+		count += n
+	}
+
+	return count, nil
+}
+
+func (s *shardedStore[K, T]) Vertex(k K) (T, VertexProperties, error) {
+	return s.shard(k).Vertex(k)
+}
+
+func (s *shardedStore[K, T]) RemoveVertex(k K) error {
+	return s.shard(k).RemoveVertex(k)
+}
+
+func (s *shardedStore[K, T]) AddEdge(sourceHash, targetHash K, edge Edge[K]) error {
+	source, target := s.shard(sourceHash), s.shard(targetHash)
+
+	unlock := s.lockShards(sourceHash, targetHash)
+	defer unlock()
+
+	if _, ok := source.outEdges[sourceHash]; !ok {
+		source.outEdges[sourceHash] = make(map[K]Edge[K])
+	}
+
+	source.outEdges[sourceHash][targetHash] = edge
+
+	if _, ok := target.inEdges[targetHash]; !ok {
+		target.inEdges[targetHash] = make(map[K]Edge[K])
+	}
+
+	target.inEdges[targetHash][sourceHash] = edge
+
+	return nil
+}
+
+func (s *shardedStore[K, T]) UpdateEdge(sourceHash, targetHash K, edge Edge[K]) error {
+	source, target := s.shard(sourceHash), s.shard(targetHash)
+
+	unlock := s.lockShards(sourceHash, targetHash)
+	defer unlock()
+
+	if _, ok := source.outEdges[sourceHash][targetHash]; !ok {
+		return ErrEdgeNotFound
+	}
+
+	source.outEdges[sourceHash][targetHash] = edge
+	target.inEdges[targetHash][sourceHash] = edge
+
+	return nil
+}
+
+// UpdateEdges updates the given edges while holding the locks of all shards, so
+// that concurrent readers either see all or none of the updates. If one of the
+// edges doesn't exist, ErrEdgeNotFound is returned and no edge is updated.
+func (s *shardedStore[K, T]) UpdateEdges(edges []Edge[K]) error {
+	for _, shard := range s.shards {
+		shard.lock.Lock()
+	}
+
+	defer func() {
+		for i := len(s.shards) - 1; i >= 0; i-- {
+			s.shards[i].lock.Unlock()
+		}
+	}()
+
+	for _, edge := range edges {
+		if _, ok := s.shard(edge.Source).outEdges[edge.Source][edge.Target]; !ok {
+			return ErrEdgeNotFound
+		}
+	}
+
+	for _, edge := range edges {
+		s.shard(edge.Source).outEdges[edge.Source][edge.Target] = edge
+		s.shard(edge.Target).inEdges[edge.Target][edge.Source] = edge
+	}
+
+	return nil
+}
+
+func (s *shardedStore[K, T]) RemoveEdge(sourceHash, targetHash K) error {
+	source, target := s.shard(sourceHash), s.shard(targetHash)
+
+	unlock := s.lockShards(sourceHash, targetHash)
+	defer unlock()
+
+	delete(target.inEdges[targetHash], sourceHash)
+	delete(source.outEdges[sourceHash], targetHash)
+	return nil
+}
+
+func (s *shardedStore[K, T]) Edge(sourceHash, targetHash K) (Edge[K], error) {
+	return s.shard(sourceHash).Edge(sourceHash, targetHash)
+}
+
+func (s *shardedStore[K, T]) ListEdges() ([]Edge[K], error) {
+	res := make([]Edge[K], 0)
+
+	for _, shard := range s.shards {
+		edges, _ := shard.ListEdges()
+		res = append(res, edges...)
+	}
+
+	return res, nil
+}
+
+// InEdges returns all ingoing edges of the given vertex in O(deg) time.
+func (s *shardedStore[K, T]) InEdges(k K) ([]Edge[K], error) {
+	return s.shard(k).InEdges(k)
+}
+
+// OutEdges returns all outgoing edges of the given vertex in O(deg) time.
+func (s *shardedStore[K, T]) OutEdges(k K) ([]Edge[K], error) {
+	return s.shard(k).OutEdges(k)
+}
+
+// VisitVertices is a fastpath version of [VisitVertices] that iterates over the
+// vertices of one shard after another while holding the read lock of the shard.
+func (s *shardedStore[K, T]) VisitVertices(visit func(hash K) bool) error {
+	isStopped := false
+
+	for _, shard := range s.shards {
+		_ = shard.VisitVertices(func(hash K) bool {
+			isStopped = visit(hash)
+			return isStopped
+		})
+
+		if isStopped {
+			break
+		}
+	}
+
+	return nil
+}
+
+// VisitOutEdges is a fastpath version of [VisitAdjacencies].
+func (s *shardedStore[K, T]) VisitOutEdges(k K, visit func(edge Edge[K]) bool) error {
+	return s.shard(k).VisitOutEdges(k, visit)
+}
+
+// VisitInEdges is a fastpath version of [VisitPredecessors].
+func (s *shardedStore[K, T]) VisitInEdges(k K, visit func(edge Edge[K]) bool) error {
+	return s.shard(k).VisitInEdges(k, visit)
+}
+
+// CreatesCycle is a fastpath version of [CreatesCycle] that walks the ingoing
+// edges of the source vertex instead of calling [PredecessorMap].
+func (s *shardedStore[K, T]) CreatesCycle(source, target K) (bool, error) {
+	if _, _, err := s.Vertex(source); err != nil {
+		return false, fmt.Errorf("could not get vertex with hash %v: %w", source, err)
+	}
+
+	if _, _, err := s.Vertex(target); err != nil {
+		return false, fmt.Errorf("could not get vertex with hash %v: %w", target, err)
+	}
+
+	if source == target {
+		return true, nil
+	}
+
+	stack := newStack[K]()
+	visited := make(map[K]struct{})
+
+	stack.push(source)
+
+	for !stack.isEmpty() {
+		currentHash, _ := stack.pop()
+
+		if _, ok := visited[currentHash]; ok {
+			continue
+		}
+
+		// If the adjacent vertex also is the target vertex, the target is a
+		// parent of the source vertex. An edge would introduce a cycle.
+		if currentHash == target {
+			return true, nil
+		}
+
+		visited[currentHash] = struct{}{}
+
+		_ = s.VisitInEdges(currentHash, func(edge Edge[K]) bool {
+			stack.push(edge.Source)
+			return false
+		})
+	}
+
+	return false, nil
+}
+
+// shard returns the shard that the given vertex belongs to.
+func (s *shardedStore[K, T]) shard(k K) *memoryStore[K, T] {
+	return s.shards[s.index(k)]
+}
+
+func (s *shardedStore[K, T]) index(k K) int {
+	return int(s.key(k) % uint64(len(s.shards)))
+}
+
+// lockShards acquires the write locks of the shards of the given vertices and
+// returns a function that releases them. The locks are always acquired in the
+// order of the shards, so that two goroutines locking the same shards can't
+// deadlock.
+func (s *shardedStore[K, T]) lockShards(a, b K) func() {
+	i, j := s.index(a), s.index(b)
+
+	if i == j {
+		s.shards[i].lock.Lock()
+		return s.shards[i].lock.Unlock
+	}
+
+	if i > j {
+		i, j = j, i
+	}
+
+	s.shards[i].lock.Lock()
+	s.shards[j].lock.Lock()
+
+	return func() {
+		s.shards[j].lock.Unlock()
+		s.shards[i].lock.Unlock()
+	}
+}
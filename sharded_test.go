@@ -0,0 +1,142 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestShardedStore(t *testing.T) {
+	tests := map[string]struct {
+		traits []func(*Traits)
+		shards int
+		edges  [][2]int
+	}{
+		"directed graph": {
+			traits: []func(*Traits){Directed()},
+			shards: 4,
+			edges:  [][2]int{{1, 2}, {2, 3}, {3, 1}, {1, 4}, {4, 4}},
+		},
+		"undirected graph": {
+			shards: 3,
+			edges:  [][2]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 1}},
+		},
+		"single shard": {
+			traits: []func(*Traits){Directed()},
+			shards: 1,
+			edges:  [][2]int{{1, 2}, {2, 3}},
+		},
+		"default number of shards": {
+			shards: 0,
+			edges:  [][2]int{{1, 2}, {2, 3}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			sharded := NewWithStore(IntHash, NewShardedStore[int, int](test.shards, IntShardKey), test.traits...)
+			expected := New(IntHash, test.traits...)
+
+			for _, g := range []Graph[int, int]{sharded, expected} {
+				for vertex := 1; vertex <= 5; vertex++ {
+					_ = g.AddVertex(vertex)
+				}
+
+				for _, edge := range test.edges {
+					if err := g.AddEdge(edge[0], edge[1]); err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+				}
+			}
+
+			expectedMap, _ := expected.AdjacencyMap()
+			actualMap, _ := sharded.AdjacencyMap()
+
+			if !reflect.DeepEqual(expectedMap, actualMap) {
+				t.Errorf("expected adjacency map %v, got %v", expectedMap, actualMap)
+			}
+
+			expectedPredecessors, _ := expected.PredecessorMap()
+			actualPredecessors, _ := sharded.PredecessorMap()
+
+			if !reflect.DeepEqual(expectedPredecessors, actualPredecessors) {
+				t.Errorf("expected predecessor map %v, got %v", expectedPredecessors, actualPredecessors)
+			}
+
+			if order, _ := sharded.Order(); order != 5 {
+				t.Errorf("expected order 5, got %d", order)
+			}
+
+			edge := test.edges[0]
+
+			if err := sharded.RemoveVertex(edge[1]); !errors.Is(err, ErrVertexHasEdges) {
+				t.Errorf("expected error %v, got %v", ErrVertexHasEdges, err)
+			}
+
+			if err := sharded.RemoveEdge(edge[0], edge[1]); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _, err := sharded.Edge(edge[0], edge[1]); !errors.Is(err, ErrEdgeNotFound) {
+				t.Errorf("expected error %v, got %v", ErrEdgeNotFound, err)
+			}
+		})
+	}
+}
+
+func TestShardedStore_concurrent(t *testing.T) {
+	g := NewWithStore(IntHash, NewShardedStore[int, int](8, IntShardKey), Directed(), PreventCycles())
+
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+
+		go func(worker int) {
+			defer wg.Done()
+
+			for vertex := worker * 100; vertex < (worker+1)*100; vertex++ {
+				_ = g.AddVertex(vertex)
+			}
+		}(worker)
+	}
+
+	wg.Wait()
+
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+
+		go func(worker int) {
+			defer wg.Done()
+
+			for vertex := worker; vertex < 399; vertex += 4 {
+				_ = g.AddEdge(vertex, vertex+1)
+			}
+		}(worker)
+	}
+
+	wg.Wait()
+
+	if order, _ := g.Order(); order != 400 {
+		t.Errorf("expected order 400, got %d", order)
+	}
+
+	if size, _ := g.Size(); size != 399 {
+		t.Errorf("expected size 399, got %d", size)
+	}
+
+	if err := g.AddEdge(399, 0); !errors.Is(err, ErrEdgeCreatesCycle) {
+		t.Errorf("expected error %v, got %v", ErrEdgeCreatesCycle, err)
+	}
+}
+
+func TestStringShardKey(t *testing.T) {
+	if StringShardKey("a") == StringShardKey("b") {
+		t.Error("expected different keys for different strings")
+	}
+
+	if StringShardKey("a") != StringShardKey("a") {
+		t.Error("expected the same key for the same string")
+	}
+}
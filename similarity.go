@@ -0,0 +1,271 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// SimRank computes the SimRank similarity of each pair of vertices. Two vertices
+// are similar if they are referenced by similar vertices, which captures
+// structural similarity even if the vertices don't have any neighbors in
+// common. This is useful for entity resolution, where two entities that are
+// related to similar entities likely are the same:
+//
+//	similarities, _ := graph.SimRank(g, 0.8, 5)
+//
+//	fmt.Println(similarities["A"]["B"])
+//
+// Each vertex has a similarity of 1 to itself. The similarity of two different
+// vertices is the average similarity of their ingoing neighbors, multiplied by
+// the given decay factor, which has to be in (0, 1) and usually is 0.8. It is 0
+// if one of the vertices doesn't have ingoing neighbors. In an undirected graph,
+// all neighbors of a vertex are its ingoing neighbors. Edge weights are ignored.
+//
+// The similarities are computed iteratively, starting with a similarity of 0 for
+// all pairs of different vertices. After k iterations, the similarities differ
+// from the exact ones by at most decay^(k+1), so a few iterations usually are
+// enough. Using partial sums, each iteration takes O(|V|*|E|) time, and the
+// similarities take O(|V|^2) memory, which limits SimRank to small and medium
+// graphs. The returned map contains the similarity of each pair of vertices.
+func SimRank[K comparable, T any](g Graph[K, T], decay float64, iterations int) (map[K]map[K]float64, error) {
+	if decay <= 0 || decay >= 1 {
+		return nil, fmt.Errorf("decay factor must be in (0, 1), got %v", decay)
+	}
+
+	if iterations < 0 {
+		return nil, errors.New("number of iterations must not be negative")
+	}
+
+	predecessorMap, err := g.PredecessorMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get predecessor map: %w", err)
+	}
+
+	indices := make(map[K]int, len(predecessorMap))
+	hashes := make([]K, 0, len(predecessorMap))
+
+	for hash := range predecessorMap {
+		indices[hash] = len(hashes)
+		hashes = append(hashes, hash)
+	}
+
+	n := len(hashes)
+	predecessors := make([][]int, n)
+
+	for target, sources := range predecessorMap {
+		for source := range sources {
+			predecessors[indices[target]] = append(predecessors[indices[target]], indices[source])
+		}
+	}
+
+	similarities := newSquareMatrix(n)
+	next := newSquareMatrix(n)
+
+	for i := 0; i < n; i++ {
+		similarities[i][i] = 1
+	}
+
+	// partial[i][b] contains the sum of the similarities of vertex i to all
+	// ingoing neighbors of vertex b, which is shared by all vertices that have
+	// i as an ingoing neighbor.
+	partial := newSquareMatrix(n)
+
+	for iteration := 0; iteration < iterations; iteration++ {
+		for i := 0; i < n; i++ {
+			for b := 0; b < n; b++ {
+				sum := 0.0
+				for _, j := range predecessors[b] {
+					sum += similarities[i][j]
+				}
+				partial[i][b] = sum
+			}
+		}
+
+		for a := 0; a < n; a++ {
+			for b := 0; b < n; b++ {
+				if a == b {
+					next[a][b] = 1
+					continue
+				}
+
+				if len(predecessors[a]) == 0 || len(predecessors[b]) == 0 {
+					next[a][b] = 0
+					continue
+				}
+
+				sum := 0.0
+				for _, i := range predecessors[a] {
+					sum += partial[i][b]
+				}
+
+				next[a][b] = decay * sum / float64(len(predecessors[a])*len(predecessors[b]))
+			}
+		}
+
+		similarities, next = next, similarities
+	}
+
+	m := make(map[K]map[K]float64, n)
+
+	for a, source := range hashes {
+		m[source] = make(map[K]float64, n)
+
+		for b, target := range hashes {
+			m[source][target] = similarities[a][b]
+		}
+	}
+
+	return m, nil
+}
+
+// LinkScorer determines how PredictLinks scores a candidate edge between two
+// vertices, based on the neighbors of both vertices.
+type LinkScorer int
+
+const (
+	// CommonNeighbors scores a candidate edge with the number of neighbors
+	// that both vertices have in common.
+	CommonNeighbors LinkScorer = iota
+	// JaccardCoefficient scores a candidate edge with the number of common
+	// neighbors divided by the number of vertices that are a neighbor of any
+	// of both vertices.
+	JaccardCoefficient
+	// AdamicAdar scores a candidate edge with the sum of 1/log(d) over all
+	// common neighbors, where d is the degree of the common neighbor. Hence,
+	// neighbors that are connected to few vertices count more than hubs.
+	AdamicAdar
+	// PreferentialAttachment scores a candidate edge with the product of the
+	// degrees of both vertices, so high-degree vertices likely get connected.
+	PreferentialAttachment
+)
+
+func (s LinkScorer) String() string {
+	switch s {
+	case CommonNeighbors:
+		return "common neighbors"
+	case JaccardCoefficient:
+		return "Jaccard coefficient"
+	case AdamicAdar:
+		return "Adamic-Adar"
+	case PreferentialAttachment:
+		return "preferential attachment"
+	}
+
+	return fmt.Sprintf("LinkScorer(%d)", int(s))
+}
+
+// LinkPrediction is a candidate edge from a vertex to Target, as predicted by
+// PredictLinks. The higher the score, the more likely the edge.
+type LinkPrediction[K comparable] struct {
+	Target K
+	Score  float64
+}
+
+// PredictLinks predicts the k most likely edges from the given vertex to the
+// vertices it isn't adjacent to yet, using the given scorer. The predictions
+// are sorted by their score in descending order, and candidates with a score
+// of 0 are left out. This is a common building block of recommendation
+// pipelines, like suggesting friends in a social network:
+//
+//	predictions, _ := graph.PredictLinks(g, "alice", 10, graph.AdamicAdar)
+//
+//	for _, prediction := range predictions {
+//		fmt.Printf("%s: %.2f\n", prediction.Target, prediction.Score)
+//	}
+//
+// The neighbors of a vertex are all vertices it is adjacent to, regardless of
+// the direction of the edges, and self-loops are ignored. Candidates with the
+// same score are returned in an arbitrary order. If there are fewer than k
+// candidates, all of them are returned.
+//
+// The scores only depend on the direct neighbors of the two vertices. Except for
+// PreferentialAttachment, only vertices that are reachable over two edges can
+// have a score greater than 0, so only those have to be examined.
+func PredictLinks[K comparable, T any](g Graph[K, T], vertex K, k int, scorer LinkScorer) ([]LinkPrediction[K], error) {
+	if k < 1 {
+		return nil, fmt.Errorf("number of predictions must be at least 1, got %d", k)
+	}
+
+	if _, err := g.Vertex(vertex); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", vertex, err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	hashes, neighbors := undirectedNeighbors(adjacencyMap)
+
+	source := 0
+	for i, hash := range hashes {
+		if hash == vertex {
+			source = i
+		}
+	}
+
+	isAdjacent := make(map[int]bool, len(neighbors[source]))
+	for _, neighbor := range neighbors[source] {
+		isAdjacent[neighbor] = true
+	}
+
+	scores := make(map[int]float64)
+
+	switch scorer {
+	case CommonNeighbors, JaccardCoefficient, AdamicAdar:
+		for _, neighbor := range neighbors[source] {
+			for _, candidate := range neighbors[neighbor] {
+				if scorer == AdamicAdar {
+					scores[candidate] += 1 / math.Log(float64(len(neighbors[neighbor])))
+				} else {
+					scores[candidate]++
+				}
+			}
+		}
+
+		if scorer == JaccardCoefficient {
+			for candidate, common := range scores {
+				scores[candidate] = common / (float64(len(neighbors[source])+len(neighbors[candidate])) - common)
+			}
+		}
+	case PreferentialAttachment:
+		for candidate := range hashes {
+			scores[candidate] = float64(len(neighbors[source]) * len(neighbors[candidate]))
+		}
+	default:
+		return nil, fmt.Errorf("unknown link scorer %v", scorer)
+	}
+
+	predictions := make([]LinkPrediction[K], 0, len(scores))
+
+	for candidate, score := range scores {
+		if isAdjacent[candidate] || candidate == source || score == 0 {
+			continue
+		}
+
+		predictions = append(predictions, LinkPrediction[K]{Target: hashes[candidate], Score: score})
+	}
+
+	sort.Slice(predictions, func(i, j int) bool {
+		return predictions[i].Score > predictions[j].Score
+	})
+
+	if len(predictions) > k {
+		predictions = predictions[:k]
+	}
+
+	return predictions, nil
+}
+
+// newSquareMatrix creates an n*n matrix of zeros.
+func newSquareMatrix(n int) [][]float64 {
+	matrix := make([][]float64, n)
+
+	for i := range matrix {
+		matrix[i] = make([]float64, n)
+	}
+
+	return matrix
+}
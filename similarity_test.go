@@ -0,0 +1,210 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSimRank(t *testing.T) {
+	tests := map[string]struct {
+		traits     []func(*Traits)
+		vertices   []string
+		edges      []Edge[string]
+		decay      float64
+		expected   map[[2]string]float64
+		shouldFail bool
+	}{
+		"university graph": {
+			// The example by Jeh and Widom, who introduced SimRank.
+			traits:   []func(*Traits){Directed()},
+			vertices: []string{"Univ", "ProfA", "ProfB", "StudentA", "StudentB"},
+			edges: []Edge[string]{
+				{Source: "Univ", Target: "ProfA"},
+				{Source: "Univ", Target: "ProfB"},
+				{Source: "ProfA", Target: "StudentA"},
+				{Source: "ProfB", Target: "StudentB"},
+				{Source: "StudentA", Target: "Univ"},
+				{Source: "StudentB", Target: "ProfB"},
+			},
+			decay: 0.8,
+			expected: map[[2]string]float64{
+				{"ProfA", "ProfB"}:       0.414,
+				{"StudentA", "StudentB"}: 0.331,
+				{"Univ", "Univ"}:         1,
+			},
+		},
+		"undirected star": {
+			vertices: []string{"A", "B", "C", "D"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+				{Source: "A", Target: "C"},
+				{Source: "A", Target: "D"},
+			},
+			decay: 0.8,
+			expected: map[[2]string]float64{
+				{"B", "C"}: 0.8,
+				{"C", "D"}: 0.8,
+				{"A", "B"}: 0,
+			},
+		},
+		"vertex without ingoing neighbors": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []string{"A", "B"},
+			edges: []Edge[string]{
+				{Source: "A", Target: "B"},
+			},
+			decay: 0.8,
+			expected: map[[2]string]float64{
+				{"A", "B"}: 0,
+				{"B", "B"}: 1,
+			},
+		},
+		"invalid decay factor": {
+			vertices:   []string{"A"},
+			decay:      1,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			similarities, err := SimRank(g, test.decay, 100)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if len(similarities) != len(test.vertices) {
+				t.Fatalf("expected similarities for %d vertices, got %d", len(test.vertices), len(similarities))
+			}
+
+			for pair, expected := range test.expected {
+				if similarity := similarities[pair[0]][pair[1]]; math.Abs(similarity-expected) > 1e-3 {
+					t.Errorf("expected similarity %v of %v, got %v", expected, pair, similarity)
+				}
+
+				if math.Abs(similarities[pair[0]][pair[1]]-similarities[pair[1]][pair[0]]) > 1e-9 {
+					t.Errorf("expected similarity of %v to be symmetric", pair)
+				}
+			}
+		})
+	}
+}
+
+func TestPredictLinks(t *testing.T) {
+	tests := map[string]struct {
+		scorer     LinkScorer
+		k          int
+		expected   []LinkPrediction[string]
+		vertex     string
+		shouldFail bool
+	}{
+		"common neighbors": {
+			scorer: CommonNeighbors,
+			k:      10,
+			expected: []LinkPrediction[string]{
+				{Target: "D", Score: 2},
+				{Target: "E", Score: 1},
+			},
+		},
+		"Jaccard coefficient": {
+			scorer: JaccardCoefficient,
+			k:      10,
+			expected: []LinkPrediction[string]{
+				{Target: "D", Score: 1},
+				{Target: "E", Score: 0.5},
+			},
+		},
+		"Adamic-Adar": {
+			scorer: AdamicAdar,
+			k:      10,
+			expected: []LinkPrediction[string]{
+				{Target: "D", Score: 1/math.Log(2) + 1/math.Log(3)},
+				{Target: "E", Score: 1 / math.Log(3)},
+			},
+		},
+		"preferential attachment": {
+			scorer: PreferentialAttachment,
+			k:      10,
+			expected: []LinkPrediction[string]{
+				{Target: "D", Score: 4},
+				{Target: "E", Score: 2},
+			},
+		},
+		"top candidate": {
+			scorer: CommonNeighbors,
+			k:      1,
+			expected: []LinkPrediction[string]{
+				{Target: "D", Score: 2},
+			},
+		},
+		"isolated vertex": {
+			scorer:   CommonNeighbors,
+			k:        10,
+			vertex:   "F",
+			expected: []LinkPrediction[string]{},
+		},
+		"invalid number of predictions": {
+			scorer:     CommonNeighbors,
+			k:          0,
+			shouldFail: true,
+		},
+		"unknown vertex": {
+			scorer:     CommonNeighbors,
+			k:          10,
+			vertex:     "G",
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(StringHash)
+
+			for _, vertex := range []string{"A", "B", "C", "D", "E", "F"} {
+				_ = g.AddVertex(vertex)
+			}
+
+			_ = g.AddEdge("A", "B")
+			_ = g.AddEdge("A", "C")
+			_ = g.AddEdge("B", "D")
+			_ = g.AddEdge("C", "D")
+			_ = g.AddEdge("C", "E")
+
+			vertex := test.vertex
+			if vertex == "" {
+				vertex = "A"
+			}
+
+			predictions, err := PredictLinks(g, vertex, test.k, test.scorer)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if len(predictions) != len(test.expected) {
+				t.Fatalf("expected predictions %v, got %v", test.expected, predictions)
+			}
+
+			for i, expected := range test.expected {
+				if predictions[i].Target != expected.Target || math.Abs(predictions[i].Score-expected.Score) > 1e-9 {
+					t.Errorf("expected prediction %v at index %d, got %v", expected, i, predictions[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SlidingWindow is a graph that only keeps the edges of a sliding time window,
+// such as the interactions of the last hour. Edges that are older than the
+// window expire and are removed automatically. This is useful for monitoring
+// recent interaction networks, like the communication between services:
+//
+//	g, _ := graph.NewSlidingWindow(graph.New(graph.StringHash), time.Hour, nil)
+//
+//	_ = g.AddEdge("checkout", "payments")
+//
+//	// One hour later, the edge has expired.
+//	_, _ = g.Prune()
+//
+// A SlidingWindow wraps an existing graph and implements the Graph interface
+// itself, so it can be used wherever a graph is expected. The timestamp of an
+// edge is the start of its validity, as set using [EdgeTimestamp] or
+// [EdgeValidity], or the time it has been added if the start is zero. Hence, the
+// window acts as a time to live for edges without a timestamp. In a graph
+// created using [MergeEdges] or [KeepFirstEdge], adding an edge that already
+// exists tracks the resulting edge again, so that edges without a timestamp stay
+// alive as long as the interaction recurs.
+//
+// Expired edges are removed whenever an edge is added or updated, and when Prune
+// is called. Reading the graph doesn't remove expired edges, so Prune should be
+// called before analyzing the graph. Vertices are never removed. Changes made
+// directly on the wrapped graph are not tracked. Like the graph implementations
+// of this package, a SlidingWindow is not safe for concurrent mutations.
+type SlidingWindow[K comparable, T any] struct {
+	Graph[K, T]
+	window     time.Duration
+	clock      func() time.Time
+	isDirected bool
+	// expiries contains the expiry time of each tracked edge, and queue has an
+	// entry for each expiry time. Entries whose time differs from expiries are
+	// outdated and skipped.
+	expiries map[EdgeKey[K]]time.Time
+	queue    expiryQueue[K]
+}
+
+// NewSlidingWindow creates a SlidingWindow for the given graph that keeps the
+// edges of the given time window. All edges of the graph are tracked from now
+// on. clock returns the current time and defaults to time.Now if it is nil. For
+// replaying historical events, clock can return the timestamp of the latest
+// event instead.
+func NewSlidingWindow[K comparable, T any](g Graph[K, T], window time.Duration, clock func() time.Time) (*SlidingWindow[K, T], error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("window must be positive, got %v", window)
+	}
+
+	if clock == nil {
+		clock = time.Now
+	}
+
+	s := &SlidingWindow[K, T]{
+		Graph:      g,
+		window:     window,
+		clock:      clock,
+		isDirected: g.Traits().IsDirected,
+		expiries:   make(map[EdgeKey[K]]time.Time),
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	now := clock()
+
+	for _, edge := range edges {
+		s.track(EdgeKey[K]{Source: edge.Source, Target: edge.Target}, edge.Properties, now)
+	}
+
+	return s, nil
+}
+
+func (s *SlidingWindow[K, T]) unwrap() Graph[K, T] {
+	return s.Graph
+}
+
+// Window returns the duration of the sliding window.
+func (s *SlidingWindow[K, T]) Window() time.Duration {
+	return s.window
+}
+
+// Prune removes all edges that are older than the window and returns the number
+// of removed edges.
+func (s *SlidingWindow[K, T]) Prune() (int, error) {
+	now := s.clock()
+	removed := 0
+
+	for s.queue.Len() > 0 && s.queue[0].expiry.Before(now) {
+		entry := heap.Pop(&s.queue).(expiryEntry[K])
+
+		if expiry, ok := s.expiries[entry.key]; !ok || !expiry.Equal(entry.expiry) {
+			continue
+		}
+
+		delete(s.expiries, entry.key)
+
+		err := s.Graph.RemoveEdge(entry.key.Source, entry.key.Target)
+		if errors.Is(err, ErrEdgeNotFound) {
+			continue
+		}
+		if err != nil {
+			return removed, fmt.Errorf("failed to remove edge (%v, %v): %w", entry.key.Source, entry.key.Target, err)
+		}
+
+		removed++
+	}
+
+	return removed, nil
+}
+
+// AddEdge adds an edge to the window after removing the expired edges. See
+// [graph.Graph.AddEdge].
+func (s *SlidingWindow[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
+	return s.addEdges([]EdgeKey[K]{{Source: sourceHash, Target: targetHash}}, func() error {
+		return s.Graph.AddEdge(sourceHash, targetHash, options...)
+	})
+}
+
+// AddEdges adds the given edges to the window after removing the expired edges.
+// See [graph.Graph.AddEdges].
+func (s *SlidingWindow[K, T]) AddEdges(edges []Edge[K]) error {
+	keys := make([]EdgeKey[K], len(edges))
+	for i, edge := range edges {
+		keys[i] = EdgeKey[K]{Source: edge.Source, Target: edge.Target}
+	}
+
+	return s.addEdges(keys, func() error {
+		return s.Graph.AddEdges(edges)
+	})
+}
+
+// AddEdgesFrom adds the edges of the given graph to the window after removing
+// the expired edges. See [graph.Graph.AddEdgesFrom].
+func (s *SlidingWindow[K, T]) AddEdgesFrom(g Graph[K, T]) error {
+	edges, err := g.Edges()
+	if err != nil {
+		return fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	keys := make([]EdgeKey[K], len(edges))
+	for i, edge := range edges {
+		keys[i] = EdgeKey[K]{Source: edge.Source, Target: edge.Target}
+	}
+
+	return s.addEdges(keys, func() error {
+		return s.Graph.AddEdgesFrom(g)
+	})
+}
+
+// UpdateEdge updates the properties of an edge after removing the expired
+// edges. If the update changes the start of the edge's validity, the edge
+// expires relative to the new timestamp. See [graph.Graph.UpdateEdge].
+func (s *SlidingWindow[K, T]) UpdateEdge(source, target K, options ...func(properties *EdgeProperties)) error {
+	if _, err := s.Prune(); err != nil {
+		return err
+	}
+
+	edge, err := s.Graph.Edge(source, target)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Graph.UpdateEdge(source, target, options...); err != nil {
+		return err
+	}
+
+	updated, err := s.Graph.Edge(source, target)
+	if err != nil {
+		return fmt.Errorf("failed to get edge (%v, %v): %w", source, target, err)
+	}
+
+	if !validFrom(updated.Properties).Equal(validFrom(edge.Properties)) {
+		s.track(s.key(source, target), updated.Properties, s.clock())
+	}
+
+	return nil
+}
+
+// RemoveEdge removes an edge and stops tracking it. See
+// [graph.Graph.RemoveEdge].
+func (s *SlidingWindow[K, T]) RemoveEdge(source, target K) error {
+	if err := s.Graph.RemoveEdge(source, target); err != nil {
+		return err
+	}
+
+	delete(s.expiries, s.key(source, target))
+
+	return nil
+}
+
+// addEdges removes the expired edges, runs the given mutation, and tracks the
+// edges among keys that exist afterwards. If the mutation fails, only the edges
+// that haven't been tracked before are tracked, since the mutation might have
+// added some of them.
+func (s *SlidingWindow[K, T]) addEdges(keys []EdgeKey[K], mutate func() error) error {
+	if _, err := s.Prune(); err != nil {
+		return err
+	}
+
+	err := mutate()
+	now := s.clock()
+
+	for _, key := range keys {
+		key = s.key(key.Source, key.Target)
+
+		if _, ok := s.expiries[key]; ok && err != nil {
+			continue
+		}
+
+		edge, edgeErr := s.Graph.Edge(key.Source, key.Target)
+		if edgeErr != nil {
+			continue
+		}
+
+		s.track(key, edge.Properties, now)
+	}
+
+	return err
+}
+
+// track sets the expiry time of the given edge based on the start of its
+// validity, or based on now if the start is zero.
+func (s *SlidingWindow[K, T]) track(key EdgeKey[K], properties EdgeProperties, now time.Time) {
+	timestamp := validFrom(properties)
+	if timestamp.IsZero() {
+		timestamp = now
+	}
+
+	expiry := timestamp.Add(s.window)
+
+	s.expiries[key] = expiry
+	heap.Push(&s.queue, expiryEntry[K]{key: key, expiry: expiry})
+}
+
+// key returns the key under which the given edge is tracked. In an undirected
+// graph, this is the reversed key if the edge is tracked as (target, source).
+func (s *SlidingWindow[K, T]) key(source, target K) EdgeKey[K] {
+	key := EdgeKey[K]{Source: source, Target: target}
+
+	if !s.isDirected {
+		reversed := EdgeKey[K]{Source: target, Target: source}
+		if _, ok := s.expiries[reversed]; ok {
+			return reversed
+		}
+	}
+
+	return key
+}
+
+type expiryEntry[K comparable] struct {
+	key    EdgeKey[K]
+	expiry time.Time
+}
+
+// expiryQueue is a minimum binary heap of expiry times that implements
+// heap.Interface.
+type expiryQueue[K comparable] []expiryEntry[K]
+
+func (q expiryQueue[K]) Len() int {
+	return len(q)
+}
+
+func (q expiryQueue[K]) Less(i, j int) bool {
+	return q[i].expiry.Before(q[j].expiry)
+}
+
+func (q expiryQueue[K]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *expiryQueue[K]) Push(item interface{}) {
+	*q = append(*q, item.(expiryEntry[K]))
+}
+
+func (q *expiryQueue[K]) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
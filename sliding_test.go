@@ -0,0 +1,185 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSlidingWindow(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	clock := func() time.Time { return now }
+
+	g, err := NewSlidingWindow(New(IntHash, Directed()), time.Hour, clock)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, vertex := range []int{1, 2, 3, 4} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2)
+
+	now = start.Add(30 * time.Minute)
+	_ = g.AddEdge(2, 3)
+
+	// An edge with a timestamp expires relative to its timestamp instead of
+	// the time it has been added.
+	_ = g.AddEdge(3, 4, EdgeTimestamp(start.Add(-50*time.Minute)))
+
+	now = start.Add(61 * time.Minute)
+
+	removed, err := g.Prune()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if removed != 2 {
+		t.Errorf("expected 2 removed edges, got %d", removed)
+	}
+
+	if _, err := g.Edge(1, 2); !errors.Is(err, ErrEdgeNotFound) {
+		t.Errorf("expected edge (1, 2) to expire, got %v", err)
+	}
+
+	if _, err := g.Edge(2, 3); err != nil {
+		t.Errorf("expected edge (2, 3) to remain, got %v", err)
+	}
+
+	// Adding an edge prunes the expired edges automatically.
+	now = start.Add(91 * time.Minute)
+
+	if err := g.AddEdge(4, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if size, _ := g.Size(); size != 1 {
+		t.Errorf("expected size 1, got %d", size)
+	}
+
+	if order, _ := g.Order(); order != 4 {
+		t.Errorf("expected vertices to remain, got order %d", order)
+	}
+}
+
+func TestSlidingWindow_renewal(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	clock := func() time.Time { return now }
+
+	g, _ := NewSlidingWindow(New(StringHash, KeepFirstEdge()), time.Hour, clock)
+
+	_ = g.AddVertex("A")
+	_ = g.AddVertex("B")
+	_ = g.AddEdge("A", "B")
+
+	// The reversed edge is the same edge in an undirected graph and renews it.
+	now = start.Add(45 * time.Minute)
+	_ = g.AddEdge("B", "A")
+
+	now = start.Add(90 * time.Minute)
+
+	if removed, _ := g.Prune(); removed != 0 {
+		t.Errorf("expected renewed edge to remain, got %d removed edges", removed)
+	}
+
+	now = start.Add(106 * time.Minute)
+
+	if removed, _ := g.Prune(); removed != 1 {
+		t.Errorf("expected renewed edge to expire, got %d removed edges", removed)
+	}
+}
+
+func TestSlidingWindow_existingEdges(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	clock := func() time.Time { return now }
+
+	inner := New(IntHash, Directed())
+
+	_ = inner.AddVertex(1)
+	_ = inner.AddVertex(2)
+	_ = inner.AddEdge(1, 2)
+
+	g, _ := NewSlidingWindow(inner, time.Minute, clock)
+
+	// A failed addition doesn't renew the existing edge.
+	now = start.Add(30 * time.Second)
+
+	if err := g.AddEdge(1, 2); !errors.Is(err, ErrEdgeAlreadyExists) {
+		t.Fatalf("expected error %v, got %v", ErrEdgeAlreadyExists, err)
+	}
+
+	now = start.Add(2 * time.Minute)
+
+	if removed, _ := g.Prune(); removed != 1 {
+		t.Errorf("expected existing edge to expire, got %d removed edges", removed)
+	}
+}
+
+func TestSlidingWindow_removedEdge(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	clock := func() time.Time { return now }
+
+	g, _ := NewSlidingWindow(New(IntHash), time.Minute, clock)
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2)
+
+	if err := g.RemoveEdge(2, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_ = g.AddEdge(1, 2, EdgeTimestamp(start.Add(time.Minute)))
+
+	now = start.Add(90 * time.Second)
+
+	if removed, _ := g.Prune(); removed != 0 {
+		t.Errorf("expected re-added edge to remain, got %d removed edges", removed)
+	}
+}
+
+func TestNewSlidingWindow_invalidWindow(t *testing.T) {
+	if _, err := NewSlidingWindow(New(IntHash), 0, nil); err == nil {
+		t.Error("expected error for a window of 0")
+	}
+}
+
+func TestSlidingWindow_graphFunctions(t *testing.T) {
+	g, _ := NewSlidingWindow(New(IntHash, Directed()), time.Minute, nil)
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2)
+
+	if like := NewLike[int, int](g); !like.Traits().IsDirected {
+		t.Error("expected NewLike to create a directed graph")
+	}
+
+	h := NewHistory[int, int](g)
+
+	if err := h.AddVertex(3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := h.Undo(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if order, _ := g.Order(); order != 2 {
+		t.Errorf("expected order 2, got %d", order)
+	}
+
+	frozen, err := Freeze[int, int](g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := frozen.Edge(1, 2); err != nil {
+		t.Errorf("expected frozen graph to contain edge (1, 2), got %v", err)
+	}
+}
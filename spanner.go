@@ -0,0 +1,158 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Spanner returns a t-spanner of the given graph, which is a subgraph with fewer
+// edges in which the distance between any two vertices is at most t times their
+// distance in the original graph. Running expensive algorithms like all-pairs
+// shortest paths on the spanner instead of the original graph is faster, while
+// the error of the distances is bounded by the stretch factor t:
+//
+//	// Keep only the edges needed to preserve all distances within a factor of 2.
+//	spanner, _ := graph.Spanner(g, 2)
+//
+// The spanner is constructed using the greedy algorithm by Althöfer et al.: The
+// edges are examined in ascending order of their weights, and an edge is added
+// to the spanner if the distance between its vertices in the spanner is larger
+// than t times its weight. For an undirected graph and a stretch factor of 2k-1,
+// the spanner has O(|V|^(1+1/k)) edges. With a stretch factor of 1, all
+// distances are preserved exactly.
+//
+// The spanner contains all vertices of the given graph along with their
+// properties, and the kept edges are copied along with their properties. The
+// original graph remains unchanged. In an unweighted graph, each edge has a
+// length of 1. Negative weights are not permitted. Each edge requires a
+// Dijkstra search that is limited to t times the weight of the edge, so the
+// construction takes O(|E|*(|E|+|V|*log(|V|))) time in the worst case.
+func Spanner[K comparable, T any](g Graph[K, T], t float64) (Graph[K, T], error) {
+	if t < 1 {
+		return nil, fmt.Errorf("stretch factor must be at least 1, got %v", t)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	isDirected := g.Traits().IsDirected
+	isWeighted := g.Traits().IsWeighted
+
+	length := func(edge Edge[K]) float64 {
+		if isWeighted {
+			return float64(edge.Properties.Weight)
+		}
+		return 1
+	}
+
+	for _, edge := range edges {
+		if length(edge) < 0 {
+			return nil, fmt.Errorf("edge (%v, %v) has a negative weight", edge.Source, edge.Target)
+		}
+	}
+
+	spanner := NewLike(g)
+	indices := make(map[K]int, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		if err := spanner.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+
+		indices[hash] = len(indices)
+	}
+
+	sort.SliceStable(edges, func(i, j int) bool {
+		return length(edges[i]) < length(edges[j])
+	})
+
+	// arcs contains the outgoing edges of each vertex in the spanner.
+	arcs := make([][]centralityArc, len(indices))
+	distances := make([]float64, len(indices))
+
+	for i := range distances {
+		distances[i] = math.Inf(1)
+	}
+
+	for _, edge := range edges {
+		if edge.Source == edge.Target {
+			continue
+		}
+
+		source, target := indices[edge.Source], indices[edge.Target]
+
+		if boundedDistance(arcs, distances, source, target, t*length(edge)) <= t*length(edge) {
+			continue
+		}
+
+		if err := spanner.AddEdge(copyEdge(edge)); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+
+		arcs[source] = append(arcs[source], centralityArc{vertex: target, weight: length(edge)})
+		if !isDirected {
+			arcs[target] = append(arcs[target], centralityArc{vertex: source, weight: length(edge)})
+		}
+	}
+
+	return spanner, nil
+}
+
+// boundedDistance returns the distance from source to target using Dijkstra's
+// algorithm, only exploring vertices whose distance is at most limit. If the
+// target is farther away than limit, positive infinity is returned. distances
+// must only contain positive infinity and is restored before returning.
+func boundedDistance(arcs [][]centralityArc, distances []float64, source, target int, limit float64) float64 {
+	queue := newPriorityQueue[int]()
+	reached := []int{source}
+
+	distances[source] = 0
+	queue.Push(source, 0)
+
+	result := math.Inf(1)
+
+	for queue.Len() > 0 {
+		vertex, _ := queue.Pop()
+
+		if vertex == target {
+			result = distances[vertex]
+			break
+		}
+
+		for _, arc := range arcs[vertex] {
+			distance := distances[vertex] + arc.weight
+
+			if distance > limit || distance >= distances[arc.vertex] {
+				continue
+			}
+
+			if math.IsInf(distances[arc.vertex], 1) {
+				reached = append(reached, arc.vertex)
+				distances[arc.vertex] = distance
+				queue.Push(arc.vertex, distance)
+			} else {
+				distances[arc.vertex] = distance
+				queue.UpdatePriority(arc.vertex, distance)
+			}
+		}
+	}
+
+	for _, vertex := range reached {
+		distances[vertex] = math.Inf(1)
+	}
+
+	return result
+}
@@ -0,0 +1,216 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSpanner(t *testing.T) {
+	tests := map[string]struct {
+		traits        []func(*Traits)
+		vertices      []int
+		edges         []Edge[int]
+		stretch       float64
+		expectedEdges []Edge[int]
+		shouldFail    bool
+	}{
+		"triangle with a long edge": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 3}},
+			},
+			stretch: 1,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+		},
+		"stretch factor keeps the direct edge": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 3}},
+			},
+			stretch: 1,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+		},
+		"unweighted cycle": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			stretch: 3,
+			// Any three edges of the cycle form a 3-spanner.
+			expectedEdges: nil,
+		},
+		"directed graph": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 1}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 2}},
+			},
+			stretch: 1,
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+		},
+		"stretch factor below 1": {
+			vertices:   []int{1},
+			stretch:    0.5,
+			shouldFail: true,
+		},
+		"negative weight": {
+			traits:   []func(*Traits){Weighted()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: -1}},
+			},
+			stretch:    2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			spanner, err := Spanner(g, test.stretch)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if test.shouldFail {
+				return
+			}
+
+			if order, _ := spanner.Order(); order != len(test.vertices) {
+				t.Errorf("expected order %d, got %d", len(test.vertices), order)
+			}
+
+			if test.expectedEdges != nil {
+				if size, _ := spanner.Size(); size != len(test.expectedEdges) {
+					t.Errorf("expected size %d, got %d", len(test.expectedEdges), size)
+				}
+
+				for _, edge := range test.expectedEdges {
+					if _, err := spanner.Edge(edge.Source, edge.Target); err != nil {
+						t.Errorf("expected edge (%d, %d): %v", edge.Source, edge.Target, err)
+					}
+				}
+			}
+
+			assertStretch(t, g, spanner, test.stretch)
+		})
+	}
+}
+
+func TestSpanner_completeGraph(t *testing.T) {
+	g := New(IntHash)
+
+	// A complete graph on 20 vertices, where the direct edge is always the
+	// shortest path, which a 3-spanner doesn't need to keep.
+	for vertex := 0; vertex < 20; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	for source := 0; source < 20; source++ {
+		for target := source + 1; target < 20; target++ {
+			_ = g.AddEdge(source, target)
+		}
+	}
+
+	spanner, err := Spanner(g, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// For a stretch factor of 3, the greedy spanner doesn't contain any cycles
+	// of length 4 or less, so a star with 19 edges is expected.
+	if size, _ := spanner.Size(); size != 19 {
+		t.Errorf("expected size 19, got %d", size)
+	}
+
+	assertStretch(t, g, spanner, 3)
+}
+
+// assertStretch checks that all distances in the spanner are at most stretch
+// times the distances in the original graph.
+func assertStretch(t *testing.T, g, spanner Graph[int, int], stretch float64) {
+	t.Helper()
+
+	vertices, _ := g.AdjacencyMap()
+
+	for source := range vertices {
+		for target := range vertices {
+			if source == target {
+				continue
+			}
+
+			original, err := ShortestPath(g, source, target)
+			if err != nil {
+				continue
+			}
+
+			path, err := ShortestPath(spanner, source, target)
+			if err != nil {
+				t.Errorf("expected path from %d to %d in spanner: %v", source, target, err)
+				continue
+			}
+
+			if pathLength(t, spanner, path) > stretch*pathLength(t, g, original)+1e-9 {
+				t.Errorf("expected path from %d to %d with stretch at most %v, got %v", source, target, stretch, path)
+			}
+		}
+	}
+}
+
+func pathLength(t *testing.T, g Graph[int, int], path []int) float64 {
+	t.Helper()
+
+	length := 0.0
+
+	for i := 1; i < len(path); i++ {
+		edge, err := g.Edge(path[i-1], path[i])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if g.Traits().IsWeighted {
+			length += float64(edge.Properties.Weight)
+		} else {
+			length++
+		}
+	}
+
+	if math.IsNaN(length) {
+		t.Fatalf("invalid path length")
+	}
+
+	return length
+}
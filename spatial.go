@@ -0,0 +1,368 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// earthRadius is the mean radius of the Earth in meters, which is used for the
+// haversine distance.
+const earthRadius = 6371000
+
+// SpatialIndex attaches coordinates to the vertices of a graph. It derives the
+// weights of new edges from the distance between their vertices and answers
+// nearest-neighbor queries using a k-d tree.
+//
+// The coordinates are obtained from the vertex values using the function passed
+// to [NewSpatialIndex]. By default, they are interpreted as Euclidean (x, y)
+// coordinates. Using the [Haversine] option, they are interpreted as latitude
+// and longitude in degrees, and distances are great-circle distances in meters:
+//
+//	type city struct {
+//		name     string
+//		lat, lon float64
+//	}
+//
+//	g := graph.New(func(c city) string { return c.name }, graph.Weighted())
+//
+//	cities, _ := graph.NewSpatialIndex(g, func(c city) (float64, float64) {
+//		return c.lat, c.lon
+//	}, graph.Haversine())
+//
+//	_ = cities.AddVertex(city{"Berlin", 52.52, 13.405})
+//	_ = cities.AddVertex(city{"Paris", 48.857, 2.352})
+//
+//	// Creates an edge with a weight of about 878000 meters.
+//	_ = cities.AddEdge("Berlin", "Paris")
+//
+// Like ConnectivityIndex, SpatialIndex only reflects changes that are made
+// using its own methods. After adding or removing vertices, the k-d tree is
+// rebuilt on the next query.
+type SpatialIndex[K comparable, T any] struct {
+	g           Graph[K, T]
+	hash        Hash[K, T]
+	coordinates func(T) (float64, float64)
+	spatial     spatial
+	points      map[K][]float64
+	tree        []kdNode[K]
+	dirty       bool
+}
+
+type spatial struct {
+	haversine bool
+	scale     float64
+}
+
+type kdNode[K comparable] struct {
+	hash  K
+	point []float64
+	axis  int
+	left  int
+	right int
+}
+
+// Haversine is a functional option for [NewSpatialIndex] that interprets the
+// coordinates of the vertices as latitude and longitude in degrees. Distances
+// are computed using the haversine formula and are measured in meters.
+func Haversine() func(*spatial) {
+	return func(s *spatial) {
+		s.haversine = true
+	}
+}
+
+// DistanceScale is a functional option for [NewSpatialIndex] that sets the
+// factor by which distances are multiplied before they are rounded to integer
+// edge weights. For example, a scale of 1000 keeps three decimal places of
+// Euclidean distances. The default scale is 1.
+func DistanceScale(scale float64) func(*spatial) {
+	return func(s *spatial) {
+		s.scale = scale
+	}
+}
+
+// NewSpatialIndex creates a SpatialIndex for the given graph, using coordinates
+// to obtain the coordinates of a vertex. All vertices that already exist in the
+// graph are added to the index, but the weights of existing edges are not
+// changed.
+func NewSpatialIndex[K comparable, T any](g Graph[K, T], coordinates func(T) (float64, float64), options ...func(*spatial)) (*SpatialIndex[K, T], error) {
+	s := &SpatialIndex[K, T]{
+		g:           g,
+		hash:        hashOf(g),
+		coordinates: coordinates,
+		spatial: spatial{
+			scale: 1,
+		},
+		points: make(map[K][]float64),
+		dirty:  true,
+	}
+
+	for _, option := range options {
+		option(&s.spatial)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	for hash := range adjacencyMap {
+		vertex, err := g.Vertex(hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not get vertex %v: %w", hash, err)
+		}
+
+		s.points[hash] = s.embed(coordinates(vertex))
+	}
+
+	return s, nil
+}
+
+// AddVertex adds the given vertex to the graph and to the spatial index.
+func (s *SpatialIndex[K, T]) AddVertex(value T, options ...func(*VertexProperties)) error {
+	if err := s.g.AddVertex(value, options...); err != nil {
+		return err
+	}
+
+	s.points[s.hash(value)] = s.embed(s.coordinates(value))
+	s.dirty = true
+
+	return nil
+}
+
+// AddEdge creates an edge between the given vertices, whose weight is the
+// distance between them multiplied by the distance scale and rounded to the
+// nearest integer. An EdgeWeight option passed to AddEdge takes precedence
+// over the computed weight.
+func (s *SpatialIndex[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
+	distance, err := s.Distance(sourceHash, targetHash)
+	if err != nil {
+		return err
+	}
+
+	weight := EdgeWeight(int(math.Round(distance * s.spatial.scale)))
+
+	return s.g.AddEdge(sourceHash, targetHash, append([]func(*EdgeProperties){weight}, options...)...)
+}
+
+// RemoveVertex removes the given vertex from the graph and from the spatial
+// index. Like [graph.Graph.RemoveVertex], it fails if the vertex still has
+// edges.
+func (s *SpatialIndex[K, T]) RemoveVertex(hash K) error {
+	if err := s.g.RemoveVertex(hash); err != nil {
+		return err
+	}
+
+	delete(s.points, hash)
+	s.dirty = true
+
+	return nil
+}
+
+// Distance returns the distance between the given vertices. For the Haversine
+// option, this is the great-circle distance in meters.
+func (s *SpatialIndex[K, T]) Distance(a, b K) (float64, error) {
+	for _, hash := range []K{a, b} {
+		if _, ok := s.points[hash]; !ok {
+			return 0, fmt.Errorf("could not get vertex with hash %v: %w", hash, ErrVertexNotFound)
+		}
+	}
+
+	return s.distance(euclidean(s.points[a], s.points[b])), nil
+}
+
+// Nearest returns the hashes of the k vertices closest to the given position,
+// ordered by their distance. If the graph has fewer than k vertices, all
+// vertices are returned. Queries take O(log |V|) time on average.
+func (s *SpatialIndex[K, T]) Nearest(x, y float64, k int) []K {
+	if k <= 0 {
+		return nil
+	}
+
+	nearest := s.search(s.embed(x, y), k, math.Inf(1))
+
+	return hashesOf(nearest)
+}
+
+// Within returns the hashes of all vertices whose distance to the given position
+// is at most radius, ordered by their distance.
+func (s *SpatialIndex[K, T]) Within(x, y, radius float64) []K {
+	if radius < 0 {
+		return nil
+	}
+
+	within := s.search(s.embed(x, y), len(s.points), s.embeddedDistance(radius))
+
+	return hashesOf(within)
+}
+
+type neighbor[K comparable] struct {
+	hash     K
+	distance float64
+}
+
+func hashesOf[K comparable](neighbors []neighbor[K]) []K {
+	hashes := make([]K, len(neighbors))
+	for i, n := range neighbors {
+		hashes[i] = n.hash
+	}
+	return hashes
+}
+
+// search returns up to k vertices with an embedded distance of at most limit to
+// the given point, ordered by their distance.
+func (s *SpatialIndex[K, T]) search(point []float64, k int, limit float64) []neighbor[K] {
+	if s.dirty {
+		s.build()
+	}
+
+	nearest := make([]neighbor[K], 0, k)
+
+	// worst is the distance that a vertex has to undercut in order to be among
+	// the nearest vertices found so far.
+	worst := func() float64 {
+		if len(nearest) < k {
+			return limit
+		}
+		return nearest[len(nearest)-1].distance
+	}
+
+	var visit func(i int)
+	visit = func(i int) {
+		if i < 0 {
+			return
+		}
+
+		node := s.tree[i]
+
+		if distance := euclidean(point, node.point); distance <= worst() {
+			position := sort.Search(len(nearest), func(j int) bool {
+				return nearest[j].distance > distance
+			})
+
+			if len(nearest) < k {
+				nearest = append(nearest, neighbor[K]{})
+			}
+
+			if position < len(nearest) {
+				copy(nearest[position+1:], nearest[position:])
+				nearest[position] = neighbor[K]{hash: node.hash, distance: distance}
+			}
+		}
+
+		near, far := node.left, node.right
+
+		delta := point[node.axis] - node.point[node.axis]
+		if delta > 0 {
+			near, far = far, near
+		}
+
+		visit(near)
+
+		// The other side of the splitting plane can only contain closer
+		// vertices if the plane itself is close enough.
+		if math.Abs(delta) <= worst() {
+			visit(far)
+		}
+	}
+
+	if len(s.tree) > 0 {
+		visit(0)
+	}
+
+	return nearest
+}
+
+// build creates a balanced k-d tree from all points. The root of the tree is
+// the first node.
+func (s *SpatialIndex[K, T]) build() {
+	nodes := make([]kdNode[K], 0, len(s.points))
+	for hash, point := range s.points {
+		nodes = append(nodes, kdNode[K]{hash: hash, point: point})
+	}
+
+	s.tree = make([]kdNode[K], 0, len(nodes))
+
+	var split func(nodes []kdNode[K], depth int) int
+	split = func(nodes []kdNode[K], depth int) int {
+		if len(nodes) == 0 {
+			return -1
+		}
+
+		axis := depth % len(nodes[0].point)
+
+		sort.Slice(nodes, func(i, j int) bool {
+			return nodes[i].point[axis] < nodes[j].point[axis]
+		})
+
+		median := len(nodes) / 2
+
+		node := nodes[median]
+		node.axis = axis
+
+		i := len(s.tree)
+		s.tree = append(s.tree, node)
+
+		left := split(nodes[:median], depth+1)
+		right := split(nodes[median+1:], depth+1)
+
+		s.tree[i].left = left
+		s.tree[i].right = right
+
+		return i
+	}
+
+	split(nodes, 0)
+
+	s.dirty = false
+}
+
+// embed maps the given coordinates into the space of the k-d tree. Euclidean
+// coordinates are used as they are, whereas latitude and longitude are mapped
+// to a point on a sphere with the radius of the Earth. The straight-line
+// distance between two such points grows with their great-circle distance, so
+// the nearest points on the sphere are the nearest points in the tree.
+func (s *SpatialIndex[K, T]) embed(x, y float64) []float64 {
+	if !s.spatial.haversine {
+		return []float64{x, y}
+	}
+
+	latitude, longitude := x*math.Pi/180, y*math.Pi/180
+
+	return []float64{
+		earthRadius * math.Cos(latitude) * math.Cos(longitude),
+		earthRadius * math.Cos(latitude) * math.Sin(longitude),
+		earthRadius * math.Sin(latitude),
+	}
+}
+
+// distance converts a distance between embedded points into the actual
+// distance.
+func (s *SpatialIndex[K, T]) distance(embedded float64) float64 {
+	if !s.spatial.haversine {
+		return embedded
+	}
+
+	return 2 * earthRadius * math.Asin(math.Min(1, embedded/(2*earthRadius)))
+}
+
+// embeddedDistance is the inverse of distance.
+func (s *SpatialIndex[K, T]) embeddedDistance(distance float64) float64 {
+	if !s.spatial.haversine {
+		return distance
+	}
+
+	if distance >= math.Pi*earthRadius {
+		return math.Inf(1)
+	}
+
+	return 2 * earthRadius * math.Sin(distance/(2*earthRadius))
+}
+
+func euclidean(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return math.Sqrt(sum)
+}
@@ -0,0 +1,280 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type spatialVertex struct {
+	id   int
+	x, y float64
+}
+
+func spatialVertexHash(v spatialVertex) int {
+	return v.id
+}
+
+func spatialVertexCoordinates(v spatialVertex) (float64, float64) {
+	return v.x, v.y
+}
+
+func TestSpatialIndex_AddEdge(t *testing.T) {
+	tests := map[string]struct {
+		vertices       []spatialVertex
+		options        []func(*spatial)
+		source         int
+		target         int
+		edgeOptions    []func(*EdgeProperties)
+		expectedWeight int
+		expectedErr    error
+	}{
+		"euclidean distance": {
+			vertices:       []spatialVertex{{1, 0, 0}, {2, 3, 4}},
+			source:         1,
+			target:         2,
+			expectedWeight: 5,
+		},
+		"scaled euclidean distance": {
+			vertices:       []spatialVertex{{1, 0, 0}, {2, 1, 1}},
+			options:        []func(*spatial){DistanceScale(1000)},
+			source:         1,
+			target:         2,
+			expectedWeight: 1414,
+		},
+		"haversine distance": {
+			// Berlin and Paris, which are about 877 km apart.
+			vertices:       []spatialVertex{{1, 52.52, 13.405}, {2, 48.8566, 2.3522}},
+			options:        []func(*spatial){Haversine(), DistanceScale(0.001)},
+			source:         1,
+			target:         2,
+			expectedWeight: 877,
+		},
+		"explicit weight": {
+			vertices:       []spatialVertex{{1, 0, 0}, {2, 3, 4}},
+			source:         1,
+			target:         2,
+			edgeOptions:    []func(*EdgeProperties){EdgeWeight(42)},
+			expectedWeight: 42,
+		},
+		"missing vertex": {
+			vertices:    []spatialVertex{{1, 0, 0}},
+			source:      1,
+			target:      2,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(spatialVertexHash, Weighted())
+
+			s, err := NewSpatialIndex(g, spatialVertexCoordinates, test.options...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, vertex := range test.vertices {
+				if err := s.AddVertex(vertex); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			err = s.AddEdge(test.source, test.target, test.edgeOptions...)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			edge, err := g.Edge(test.source, test.target)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if edge.Properties.Weight != test.expectedWeight {
+				t.Errorf("expected weight %d, got %d", test.expectedWeight, edge.Properties.Weight)
+			}
+		})
+	}
+}
+
+func TestSpatialIndex_Nearest(t *testing.T) {
+	tests := map[string]struct {
+		vertices []spatialVertex
+		options  []func(*spatial)
+		x, y     float64
+		k        int
+		expected []int
+	}{
+		"nearest vertex": {
+			vertices: []spatialVertex{{1, 0, 0}, {2, 10, 0}, {3, 0, 10}, {4, 6, 6}},
+			x:        9,
+			y:        1,
+			k:        1,
+			expected: []int{2},
+		},
+		"nearest vertices ordered by distance": {
+			vertices: []spatialVertex{{1, 0, 0}, {2, 10, 0}, {3, 0, 10}, {4, 6, 6}},
+			x:        1,
+			y:        2,
+			k:        3,
+			expected: []int{1, 4, 3},
+		},
+		"more vertices requested than available": {
+			vertices: []spatialVertex{{1, 0, 0}, {2, 10, 0}},
+			x:        8,
+			y:        0,
+			k:        5,
+			expected: []int{2, 1},
+		},
+		"empty index": {
+			x:        0,
+			y:        0,
+			k:        1,
+			expected: []int{},
+		},
+		"haversine across the antimeridian": {
+			// Tokyo, Auckland, and Honolulu, seen from Fiji.
+			vertices: []spatialVertex{{1, 35.68, 139.69}, {2, -36.85, 174.76}, {3, 21.31, -157.86}},
+			options:  []func(*spatial){Haversine()},
+			x:        -17.71,
+			y:        178.07,
+			k:        2,
+			expected: []int{2, 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(spatialVertexHash)
+
+			s, _ := NewSpatialIndex(g, spatialVertexCoordinates, test.options...)
+
+			for _, vertex := range test.vertices {
+				_ = s.AddVertex(vertex)
+			}
+
+			nearest := s.Nearest(test.x, test.y, test.k)
+
+			if !reflect.DeepEqual(nearest, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, nearest)
+			}
+		})
+	}
+}
+
+func TestSpatialIndex_Within(t *testing.T) {
+	tests := map[string]struct {
+		vertices []spatialVertex
+		options  []func(*spatial)
+		x, y     float64
+		radius   float64
+		expected []int
+	}{
+		"vertices within radius": {
+			vertices: []spatialVertex{{1, 0, 0}, {2, 10, 0}, {3, 0, 10}, {4, 3, 4}},
+			x:        0,
+			y:        0,
+			radius:   5,
+			expected: []int{1, 4},
+		},
+		"no vertices within radius": {
+			vertices: []spatialVertex{{1, 0, 0}, {2, 10, 0}},
+			x:        5,
+			y:        5,
+			radius:   1,
+			expected: []int{},
+		},
+		"haversine radius": {
+			// Berlin, Potsdam, and Munich, within 100 km of Berlin.
+			vertices: []spatialVertex{{1, 52.52, 13.405}, {2, 52.39, 13.065}, {3, 48.135, 11.582}},
+			options:  []func(*spatial){Haversine()},
+			x:        52.52,
+			y:        13.405,
+			radius:   100000,
+			expected: []int{1, 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(spatialVertexHash)
+
+			s, _ := NewSpatialIndex(g, spatialVertexCoordinates, test.options...)
+
+			for _, vertex := range test.vertices {
+				_ = s.AddVertex(vertex)
+			}
+
+			within := s.Within(test.x, test.y, test.radius)
+
+			if !reflect.DeepEqual(within, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, within)
+			}
+		})
+	}
+}
+
+func TestSpatialIndex_existingVertices(t *testing.T) {
+	g := New(spatialVertexHash)
+
+	_ = g.AddVertex(spatialVertex{1, 0, 0})
+	_ = g.AddVertex(spatialVertex{2, 5, 5})
+
+	s, err := NewSpatialIndex(g, spatialVertexCoordinates)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if nearest := s.Nearest(4, 4, 1); !reflect.DeepEqual(nearest, []int{2}) {
+		t.Errorf("expected [2], got %v", nearest)
+	}
+
+	if err := s.RemoveVertex(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if nearest := s.Nearest(4, 4, 1); !reflect.DeepEqual(nearest, []int{1}) {
+		t.Errorf("expected [1] after removing vertex 2, got %v", nearest)
+	}
+}
+
+func TestSpatialIndex_bruteForce(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	g := New(spatialVertexHash)
+	s, _ := NewSpatialIndex(g, spatialVertexCoordinates)
+
+	vertices := make([]spatialVertex, 500)
+	for i := range vertices {
+		vertices[i] = spatialVertex{i, random.Float64() * 100, random.Float64() * 100}
+		_ = s.AddVertex(vertices[i])
+	}
+
+	for i := 0; i < 50; i++ {
+		x, y := random.Float64()*100, random.Float64()*100
+
+		sorted := make([]spatialVertex, len(vertices))
+		copy(sorted, vertices)
+
+		sort.Slice(sorted, func(i, j int) bool {
+			return math.Hypot(sorted[i].x-x, sorted[i].y-y) < math.Hypot(sorted[j].x-x, sorted[j].y-y)
+		})
+
+		expected := make([]int, 10)
+		for j := range expected {
+			expected[j] = sorted[j].id
+		}
+
+		if nearest := s.Nearest(x, y, 10); !reflect.DeepEqual(nearest, expected) {
+			t.Fatalf("expected %v for (%v, %v), got %v", expected, x, y, nearest)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package graph
+
+import "fmt"
+
+// Statistics is a summary of the basic properties of a graph as computed by
+// [Stats].
+type Statistics struct {
+	// Order is the number of vertices.
+	Order int
+	// Size is the number of edges.
+	Size int
+	// Density is the ratio of the number of edges to the maximum number of
+	// edges between distinct vertices. Because self-loops are counted as
+	// edges, the density of a graph with self-loops may exceed 1. For graphs
+	// with less than two vertices, the density is 0.
+	Density float64
+	// MinDegree, MaxDegree, and AverageDegree describe the degrees of all
+	// vertices as defined by [DegreeDistribution]. For an empty graph, they
+	// are 0.
+	MinDegree     int
+	MaxDegree     int
+	AverageDegree float64
+	// Components is the number of connected components. For directed graphs,
+	// these are the weakly connected components, i.e. the direction of the
+	// edges is ignored.
+	Components int
+	// IsConnected indicates whether the graph consists of exactly one
+	// connected component.
+	IsConnected bool
+	// IsAcyclic indicates whether the graph contains no cycles. Self-loops
+	// are cycles. Unlike Traits.IsAcyclic, this is determined from the actual
+	// edges of the graph.
+	IsAcyclic bool
+}
+
+// Stats computes a summary of the basic properties of the given graph, such as
+// its order, size, density, and degrees. This is handy for a quick overview of
+// a graph, for example for monitoring purposes. All statistics are computed in
+// O(|V|+|E|) time.
+func Stats[K comparable, T any](g Graph[K, T]) (Statistics, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return Statistics{}, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	isDirected := g.Traits().IsDirected
+
+	var predecessorMap map[K]map[K]Edge[K]
+
+	if isDirected {
+		predecessorMap, err = g.PredecessorMap()
+		if err != nil {
+			return Statistics{}, fmt.Errorf("failed to get predecessor map: %w", err)
+		}
+	}
+
+	stats := Statistics{
+		Order: len(adjacencyMap),
+	}
+
+	// For undirected graphs, the adjacency map contains each edge twice except
+	// for self-loops, which are contained once.
+	entries, selfLoops := 0, 0
+	isFirst := true
+
+	for vertex, adjacencies := range adjacencyMap {
+		entries += len(adjacencies)
+
+		degree := len(adjacencies)
+
+		if _, ok := adjacencies[vertex]; ok {
+			selfLoops++
+		}
+
+		if isDirected {
+			degree += len(predecessorMap[vertex])
+		} else if _, ok := adjacencies[vertex]; ok {
+			degree++
+		}
+
+		if isFirst || degree < stats.MinDegree {
+			stats.MinDegree = degree
+		}
+		if degree > stats.MaxDegree {
+			stats.MaxDegree = degree
+		}
+
+		isFirst = false
+		stats.AverageDegree += float64(degree)
+	}
+
+	if isDirected {
+		stats.Size = entries
+	} else {
+		stats.Size = (entries + selfLoops) / 2
+	}
+
+	if stats.Order > 0 {
+		stats.AverageDegree /= float64(stats.Order)
+	}
+
+	if n := float64(stats.Order); stats.Order > 1 {
+		maxSize := n * (n - 1)
+		if !isDirected {
+			maxSize /= 2
+		}
+		stats.Density = float64(stats.Size) / maxSize
+	}
+
+	stats.Components = countComponents(adjacencyMap)
+	stats.IsConnected = stats.Components == 1
+
+	if isDirected {
+		stats.IsAcyclic = isAcyclic(adjacencyMap, predecessorMap)
+	} else {
+		// An undirected graph is a forest if and only if each component is a
+		// tree, i.e. a component with n vertices has exactly n-1 edges.
+		stats.IsAcyclic = stats.Size == stats.Order-stats.Components
+	}
+
+	return stats, nil
+}
+
+// countComponents counts the connected components of the graph given by its
+// adjacency map, ignoring the direction of the edges.
+func countComponents[K comparable](adjacencyMap map[K]map[K]Edge[K]) int {
+	components := newUnionFind[K]()
+
+	for vertex := range adjacencyMap {
+		components.add(vertex)
+	}
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			components.union(source, target)
+		}
+	}
+
+	// Only the roots of the sets have a size.
+	return len(components.sizes)
+}
+
+// isAcyclic reports whether the directed graph given by its adjacency map and
+// predecessor map contains no cycles, using Kahn's algorithm.
+func isAcyclic[K comparable](adjacencyMap, predecessorMap map[K]map[K]Edge[K]) bool {
+	inDegrees := make(map[K]int, len(adjacencyMap))
+	queue := make([]K, 0)
+
+	for vertex, predecessors := range predecessorMap {
+		inDegrees[vertex] = len(predecessors)
+		if len(predecessors) == 0 {
+			queue = append(queue, vertex)
+		}
+	}
+
+	visited := 0
+
+	for len(queue) > 0 {
+		vertex := queue[0]
+		queue = queue[1:]
+		visited++
+
+		for adjacency := range adjacencyMap[vertex] {
+			inDegrees[adjacency]--
+			if inDegrees[adjacency] == 0 {
+				queue = append(queue, adjacency)
+			}
+		}
+	}
+
+	return visited == len(adjacencyMap)
+}
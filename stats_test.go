@@ -0,0 +1,121 @@
+package graph
+
+import "testing"
+
+func TestStats(t *testing.T) {
+	tests := map[string]struct {
+		isDirected bool
+		vertices   []int
+		edges      []Edge[int]
+		expected   Statistics
+	}{
+		"empty graph": {
+			expected: Statistics{IsAcyclic: true},
+		},
+		"directed acyclic graph": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 2, Target: 3},
+			},
+			expected: Statistics{
+				Order:         4,
+				Size:          3,
+				Density:       0.25,
+				MinDegree:     0,
+				MaxDegree:     2,
+				AverageDegree: 1.5,
+				Components:    2,
+				IsConnected:   false,
+				IsAcyclic:     true,
+			},
+		},
+		"directed graph with cycle": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+			},
+			expected: Statistics{
+				Order:         3,
+				Size:          3,
+				Density:       0.5,
+				MinDegree:     2,
+				MaxDegree:     2,
+				AverageDegree: 2,
+				Components:    1,
+				IsConnected:   true,
+				IsAcyclic:     false,
+			},
+		},
+		"undirected tree": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+			},
+			expected: Statistics{
+				Order:         4,
+				Size:          3,
+				Density:       0.5,
+				MinDegree:     1,
+				MaxDegree:     3,
+				AverageDegree: 1.5,
+				Components:    1,
+				IsConnected:   true,
+				IsAcyclic:     true,
+			},
+		},
+		"undirected graph with self-loop": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 3},
+			},
+			expected: Statistics{
+				Order:         3,
+				Size:          2,
+				Density:       2.0 / 3.0,
+				MinDegree:     1,
+				MaxDegree:     2,
+				AverageDegree: 4.0 / 3.0,
+				Components:    2,
+				IsConnected:   false,
+				IsAcyclic:     false,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				if err := g.AddEdge(edge.Source, edge.Target); err != nil {
+					t.Fatalf("failed to add edge: %v", err)
+				}
+			}
+
+			stats, err := Stats(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if stats != test.expected {
+				t.Errorf("expected statistics %+v, got %+v", test.expected, stats)
+			}
+		})
+	}
+}
@@ -73,14 +73,37 @@ type memoryStore[K comparable, T any] struct {
 	// these edges themselves are stored in maps whose keys are the hashes of the target vertices.
 	outEdges map[K]map[K]Edge[K] // source -> target
 	inEdges  map[K]map[K]Edge[K] // target -> source
+
+	// capacity is the number of vertices the vertex maps have been pre-sized
+	// for, and degree is the number of edges the edge maps of each vertex are
+	// pre-sized for when they are created.
+	capacity int
+	degree   int
 }
 
 func newMemoryStore[K comparable, T any]() Store[K, T] {
+	return newMemoryStoreWithCapacity[K, T](0, 0)
+}
+
+// newMemoryStoreWithCapacity creates a memory store whose maps are pre-sized
+// for the given number of vertices and edges.
+func newMemoryStoreWithCapacity[K comparable, T any](vertices, edges int) Store[K, T] {
+	if vertices < 0 {
+		vertices = 0
+	}
+
+	degree := 0
+	if vertices > 0 && edges > 0 {
+		degree = (edges + vertices - 1) / vertices
+	}
+
 	return &memoryStore[K, T]{
-		vertices:         make(map[K]T),
-		vertexProperties: make(map[K]VertexProperties),
-		outEdges:         make(map[K]map[K]Edge[K]),
-		inEdges:          make(map[K]map[K]Edge[K]),
+		vertices:         make(map[K]T, vertices),
+		vertexProperties: make(map[K]VertexProperties, vertices),
+		outEdges:         make(map[K]map[K]Edge[K], vertices),
+		inEdges:          make(map[K]map[K]Edge[K], vertices),
+		capacity:         vertices,
+		degree:           degree,
 	}
 }
 
@@ -98,6 +121,52 @@ func (s *memoryStore[K, T]) AddVertex(k K, t T, p VertexProperties) error {
 	return nil
 }
 
+// AddVertices is a fastpath version of AddVertex for adding many vertices at
+// once. It acquires the lock only once and pre-sizes the vertex maps, which
+// avoids repeatedly growing them.
+func (s *memoryStore[K, T]) AddVertices(hashes []K, values []T, properties []VertexProperties) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, k := range hashes {
+		if _, ok := s.vertices[k]; ok {
+			return ErrVertexAlreadyExists
+		}
+	}
+
+	// Growing a map to a known size at once is cheaper than letting it grow
+	// step by step. Re-allocating only pays off for large insertions, though,
+	// and isn't necessary if the maps have been pre-sized.
+	if len(hashes) > len(s.vertices) && len(s.vertices)+len(hashes) > s.capacity {
+		s.vertices = growMap(s.vertices, len(hashes))
+		s.vertexProperties = growMap(s.vertexProperties, len(hashes))
+	}
+
+	for i, k := range hashes {
+		s.vertices[k] = values[i]
+		s.vertexProperties[k] = properties[i]
+	}
+
+	return nil
+}
+
+// UpdateVertex replaces the value and properties of an existing vertex while
+// keeping its edges. If the vertex doesn't exist, ErrVertexNotFound is
+// returned.
+func (s *memoryStore[K, T]) UpdateVertex(k K, t T, p VertexProperties) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.vertices[k]; !ok {
+		return ErrVertexNotFound
+	}
+
+	s.vertices[k] = t
+	s.vertexProperties[k] = p
+
+	return nil
+}
+
 func (s *memoryStore[K, T]) ListVertices() ([]K, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -132,8 +201,8 @@ func (s *memoryStore[K, T]) Vertex(k K) (T, VertexProperties, error) {
 }
 
 func (s *memoryStore[K, T]) RemoveVertex(k K) error {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
+	s.lock.Lock()
+	defer s.lock.Unlock()
 
 	if _, ok := s.vertices[k]; !ok {
 		return ErrVertexNotFound
@@ -164,13 +233,13 @@ func (s *memoryStore[K, T]) AddEdge(sourceHash, targetHash K, edge Edge[K]) erro
 	defer s.lock.Unlock()
 
 	if _, ok := s.outEdges[sourceHash]; !ok {
-		s.outEdges[sourceHash] = make(map[K]Edge[K])
+		s.outEdges[sourceHash] = make(map[K]Edge[K], s.degree)
 	}
 
 	s.outEdges[sourceHash][targetHash] = edge
 
 	if _, ok := s.inEdges[targetHash]; !ok {
-		s.inEdges[targetHash] = make(map[K]Edge[K])
+		s.inEdges[targetHash] = make(map[K]Edge[K], s.degree)
 	}
 
 	s.inEdges[targetHash][sourceHash] = edge
@@ -178,6 +247,40 @@ func (s *memoryStore[K, T]) AddEdge(sourceHash, targetHash K, edge Edge[K]) erro
 	return nil
 }
 
+// AddEdges is a fastpath version of AddEdge for adding many edges at once. It
+// acquires the lock only once and pre-sizes the edge maps of each vertex.
+func (s *memoryStore[K, T]) AddEdges(edges []Edge[K]) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	outDegrees := make(map[K]int)
+	inDegrees := make(map[K]int)
+
+	for _, edge := range edges {
+		outDegrees[edge.Source]++
+		inDegrees[edge.Target]++
+	}
+
+	for k, degree := range outDegrees {
+		if degree > len(s.outEdges[k]) {
+			s.outEdges[k] = growMap(s.outEdges[k], degree)
+		}
+	}
+
+	for k, degree := range inDegrees {
+		if degree > len(s.inEdges[k]) {
+			s.inEdges[k] = growMap(s.inEdges[k], degree)
+		}
+	}
+
+	for _, edge := range edges {
+		s.outEdges[edge.Source][edge.Target] = edge
+		s.inEdges[edge.Target][edge.Source] = edge
+	}
+
+	return nil
+}
+
 func (s *memoryStore[K, T]) UpdateEdge(sourceHash, targetHash K, edge Edge[K]) error {
 	if _, err := s.Edge(sourceHash, targetHash); err != nil {
 		return err
@@ -192,6 +295,28 @@ func (s *memoryStore[K, T]) UpdateEdge(sourceHash, targetHash K, edge Edge[K]) e
 	return nil
 }
 
+// UpdateEdges is a fastpath version of UpdateEdge for updating many edges at
+// once. All updates are applied under a single lock, so that concurrent readers
+// either see all or none of them. If one of the edges doesn't exist,
+// ErrEdgeNotFound is returned and no edge is updated.
+func (s *memoryStore[K, T]) UpdateEdges(edges []Edge[K]) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, edge := range edges {
+		if _, ok := s.outEdges[edge.Source][edge.Target]; !ok {
+			return ErrEdgeNotFound
+		}
+	}
+
+	for _, edge := range edges {
+		s.outEdges[edge.Source][edge.Target] = edge
+		s.inEdges[edge.Target][edge.Source] = edge
+	}
+
+	return nil
+}
+
 func (s *memoryStore[K, T]) RemoveEdge(sourceHash, targetHash K) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -231,6 +356,82 @@ func (s *memoryStore[K, T]) ListEdges() ([]Edge[K], error) {
 	return res, nil
 }
 
+// InEdges returns all ingoing edges of the given vertex. Because inEdges is
+// kept up to date on each edge mutation, this takes O(deg) time instead of
+// scanning all edges like [Graph.PredecessorMap] does.
+func (s *memoryStore[K, T]) InEdges(k K) ([]Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.vertices[k]; !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	return edgesOf(s.inEdges[k]), nil
+}
+
+// OutEdges returns all outgoing edges of the given vertex in O(deg) time.
+func (s *memoryStore[K, T]) OutEdges(k K) ([]Edge[K], error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.vertices[k]; !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	return edgesOf(s.outEdges[k]), nil
+}
+
+// VisitVertices is a fastpath version of [VisitVertices] that iterates over the
+// vertex map directly while holding the read lock.
+func (s *memoryStore[K, T]) VisitVertices(visit func(hash K) bool) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for k := range s.vertices {
+		if visit(k) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// VisitOutEdges is a fastpath version of [VisitAdjacencies].
+func (s *memoryStore[K, T]) VisitOutEdges(k K, visit func(edge Edge[K]) bool) error {
+	return s.visitEdges(k, s.outEdges, visit)
+}
+
+// VisitInEdges is a fastpath version of [VisitPredecessors].
+func (s *memoryStore[K, T]) VisitInEdges(k K, visit func(edge Edge[K]) bool) error {
+	return s.visitEdges(k, s.inEdges, visit)
+}
+
+func (s *memoryStore[K, T]) visitEdges(k K, edges map[K]map[K]Edge[K], visit func(edge Edge[K]) bool) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if _, ok := s.vertices[k]; !ok {
+		return ErrVertexNotFound
+	}
+
+	for _, edge := range edges[k] {
+		if visit(edge) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func edgesOf[K comparable](edges map[K]Edge[K]) []Edge[K] {
+	res := make([]Edge[K], 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge)
+	}
+	return res
+}
+
 // CreatesCycle is a fastpath version of [CreatesCycle] that avoids calling
 // [PredecessorMap], which generates large amounts of garbage to collect.
 //
@@ -274,3 +475,107 @@ func (s *memoryStore[K, T]) CreatesCycle(source, target K) (bool, error) {
 
 	return false, nil
 }
+
+// growMap returns a map with the same entries as the given map and enough room
+// for n additional entries.
+func growMap[K comparable, V any](m map[K]V, n int) map[K]V {
+	grown := make(map[K]V, len(m)+n)
+	for k, v := range m {
+		grown[k] = v
+	}
+	return grown
+}
+
+// weightedStore wraps a store and replaces the weight of each edge it returns
+// with the weight computed by the function registered using WeightFunc. It
+// intentionally implements none of the optional store interfaces, so that
+// functions with a fastpath for these interfaces fall back to the graph.
+type weightedStore[K comparable, T any] struct {
+	Store[K, T]
+	weight     edgeWeightFunc[T]
+	isDirected bool
+}
+
+// readStore returns the store that the read methods of a graph should use: the
+// given store itself, or a weightedStore if a function has been registered
+// using WeightFunc.
+func readStore[K comparable, T any](store Store[K, T], traits *Traits) Store[K, T] {
+	if weight, ok := edgeWeight[T](traits); ok {
+		return weightedStore[K, T]{Store: store, weight: weight, isDirected: traits.IsDirected}
+	}
+
+	return store
+}
+
+func (s weightedStore[K, T]) Edge(sourceHash, targetHash K) (Edge[K], error) {
+	edge, err := s.Store.Edge(sourceHash, targetHash)
+	if err != nil {
+		return Edge[K]{}, err
+	}
+
+	return s.weigh(edge)
+}
+
+func (s weightedStore[K, T]) ListEdges() ([]Edge[K], error) {
+	edges, err := s.Store.ListEdges()
+	if err != nil {
+		return nil, err
+	}
+
+	weighted := make([]Edge[K], len(edges))
+
+	for i, edge := range edges {
+		if weighted[i], err = s.weigh(edge); err != nil {
+			return nil, err
+		}
+	}
+
+	return weighted, nil
+}
+
+func (s weightedStore[K, T]) weigh(edge Edge[K]) (Edge[K], error) {
+	source, _, err := s.Store.Vertex(edge.Source)
+	if err != nil {
+		return Edge[K]{}, fmt.Errorf("failed to get vertex %v: %w", edge.Source, err)
+	}
+
+	target, _, err := s.Store.Vertex(edge.Target)
+	if err != nil {
+		return Edge[K]{}, fmt.Errorf("failed to get vertex %v: %w", edge.Target, err)
+	}
+
+	// In an undirected graph, the edge is passed to the weight function in the
+	// same direction regardless of the direction it has been read in, so that
+	// both directions have the same weight.
+	if !s.isDirected && hashLess(edge.Target, edge.Source) {
+		source, target = target, source
+	}
+
+	edge.Properties.Weight = s.weight(Edge[T]{
+		Source:     source,
+		Target:     target,
+		Properties: edge.Properties,
+	})
+
+	return edge, nil
+}
+
+// hashLess reports whether the hash a is ordered before the hash b. Strings and
+// integers are compared by their value, and all other hashes are compared by
+// their string representation.
+func hashLess[K comparable](a, b K) bool {
+	switch a := any(a).(type) {
+	case string:
+		return a < any(b).(string)
+	case int:
+		return a < any(b).(int)
+	case int64:
+		return a < any(b).(int64)
+	case uint:
+		return a < any(b).(uint)
+	case uint64:
+		return a < any(b).(uint64)
+	}
+
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"errors"
+	"sync"
 	"testing"
 )
 
@@ -60,3 +61,80 @@ func TestRemoveEdge(t *testing.T) {
 		}
 	})
 }
+
+func TestMemoryStore_UpdateEdges(t *testing.T) {
+	store := newMemoryStore[int, int]().(*memoryStore[int, int])
+
+	for _, vertex := range []int{1, 2, 3} {
+		_ = store.AddVertex(vertex, vertex, VertexProperties{})
+	}
+
+	_ = store.AddEdge(1, 2, Edge[int]{Source: 1, Target: 2})
+	_ = store.AddEdge(2, 3, Edge[int]{Source: 2, Target: 3})
+
+	// A missing edge leaves all edges unchanged.
+	err := store.UpdateEdges([]Edge[int]{
+		{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+		{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 5}},
+	})
+	if !errors.Is(err, ErrEdgeNotFound) {
+		t.Fatalf("expected error %v, got %v", ErrEdgeNotFound, err)
+	}
+
+	if edge, _ := store.Edge(1, 2); edge.Properties.Weight != 0 {
+		t.Fatalf("expected weight 0, got %d", edge.Properties.Weight)
+	}
+
+	// Concurrent readers either see all or none of the updates of a batch.
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for i := 1; i <= 1000; i++ {
+			_ = store.UpdateEdges([]Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: i}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: i}},
+			})
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		edges, _ := store.ListEdges()
+		if edges[0].Properties.Weight != edges[1].Properties.Weight {
+			t.Fatalf("expected equal weights, got %d and %d", edges[0].Properties.Weight, edges[1].Properties.Weight)
+		}
+	}
+
+	wg.Wait()
+}
+
+// TestMemoryStore_RemoveVertex removes vertices concurrently, which only fails
+// reliably when running the tests with -race.
+func TestMemoryStore_RemoveVertex(t *testing.T) {
+	store := newMemoryStore[int, int]()
+
+	for i := 0; i < 100; i++ {
+		_ = store.AddVertex(i, i, VertexProperties{})
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(vertex int) {
+			defer wg.Done()
+
+			if err := store.RemoveVertex(vertex); err != nil {
+				t.Errorf("unexpected error removing vertex %d: %v", vertex, err)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if count, _ := store.VertexCount(); count != 0 {
+		t.Errorf("expected no vertices, got %d", count)
+	}
+}
@@ -0,0 +1,457 @@
+// Package stream provides an out-of-core processing mode for graphs that have
+// too many edges to fit into memory. The edges are stored on disk in shards,
+// and algorithms process them by streaming over the shards sequentially, just
+// like X-Stream does. Only the state of the vertices is kept in memory.
+//
+// Because the vertex state is stored in slices, the vertices of a streamed
+// graph are identified by the integers 0 to n-1. Edges can either be written
+// directly using a [Writer], or an existing graph can be exported using the
+// [Export] function:
+//
+//	w, _ := stream.Create("edges", 1000000, 16)
+//	_ = w.WriteEdge(0, 1, 1)
+//	_ = w.Close()
+//
+//	g, _ := stream.Open("edges")
+//	ranks, _ := stream.PageRank(g, 0.85, 20)
+package stream
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/dominikbraun/graph"
+)
+
+// Unreachable is the distance of vertices that cannot be reached from the
+// source vertex in ShortestPaths.
+const Unreachable = math.MaxInt
+
+// ErrNegativeCycle is returned by ShortestPaths if the graph contains a cycle
+// with a negative total weight.
+var ErrNegativeCycle = errors.New("graph contains a negative cycle")
+
+const (
+	metaFile    = "meta"
+	shardFormat = "shard-%04d"
+
+	// Each edge is stored as three little-endian 64-bit integers.
+	recordSize = 24
+	bufferSize = 1 << 20
+)
+
+// Writer writes edges into the shards of a streamed graph. The vertex range is
+// split into as many contiguous partitions as there are shards, and each edge
+// is written into the shard of the partition that contains its source vertex.
+// A Writer has to be closed after all edges have been written.
+type Writer struct {
+	dir      string
+	vertices int
+	files    []*os.File
+	writers  []*bufio.Writer
+	record   [recordSize]byte
+}
+
+// Create creates a streamed graph with the given number of vertices and shards
+// in the given directory, which is created if it doesn't exist.
+func Create(dir string, vertices, shards int) (*Writer, error) {
+	if vertices < 0 {
+		return nil, fmt.Errorf("number of vertices must not be negative, got %d", vertices)
+	}
+
+	if shards < 1 {
+		return nil, fmt.Errorf("number of shards must be at least 1, got %d", shards)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	w := &Writer{
+		dir:      dir,
+		vertices: vertices,
+		files:    make([]*os.File, 0, shards),
+		writers:  make([]*bufio.Writer, 0, shards),
+	}
+
+	for i := 0; i < shards; i++ {
+		file, err := os.Create(filepath.Join(dir, fmt.Sprintf(shardFormat, i)))
+		if err != nil {
+			w.closeFiles()
+			return nil, fmt.Errorf("failed to create shard %d: %w", i, err)
+		}
+
+		w.files = append(w.files, file)
+		w.writers = append(w.writers, bufio.NewWriterSize(file, bufferSize))
+	}
+
+	return w, nil
+}
+
+// WriteEdge writes an edge from source to target with the given weight. Both
+// vertices have to be in the range [0, n), where n is the number of vertices
+// passed to Create.
+func (w *Writer) WriteEdge(source, target, weight int) error {
+	if source < 0 || source >= w.vertices {
+		return fmt.Errorf("source vertex %d is out of range [0, %d)", source, w.vertices)
+	}
+
+	if target < 0 || target >= w.vertices {
+		return fmt.Errorf("target vertex %d is out of range [0, %d)", target, w.vertices)
+	}
+
+	binary.LittleEndian.PutUint64(w.record[0:8], uint64(source))
+	binary.LittleEndian.PutUint64(w.record[8:16], uint64(target))
+	binary.LittleEndian.PutUint64(w.record[16:24], uint64(weight))
+
+	shard := source * len(w.writers) / w.vertices
+
+	if _, err := w.writers[shard].Write(w.record[:]); err != nil {
+		return fmt.Errorf("failed to write edge (%d, %d): %w", source, target, err)
+	}
+
+	return nil
+}
+
+// Close flushes all shards and writes the metadata of the streamed graph. The
+// graph can only be opened after the Writer has been closed.
+func (w *Writer) Close() error {
+	defer w.closeFiles()
+
+	for i, writer := range w.writers {
+		if err := writer.Flush(); err != nil {
+			return fmt.Errorf("failed to flush shard %d: %w", i, err)
+		}
+	}
+
+	meta := fmt.Sprintf("%d %d\n", w.vertices, len(w.files))
+
+	if err := os.WriteFile(filepath.Join(w.dir, metaFile), []byte(meta), 0o644); err != nil {
+		return fmt.Errorf("failed to write metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Writer) closeFiles() {
+	for _, file := range w.files {
+		_ = file.Close()
+	}
+	w.files = nil
+}
+
+// Export writes the edges of the given graph into a streamed graph in dir. The
+// vertex hashes of g have to be non-negative, and the number of vertices of the
+// streamed graph will be the largest hash plus one. For undirected graphs, each
+// edge is written in both directions, and for unweighted graphs, each edge is
+// written with weight 1.
+func Export[T any](g graph.Graph[int, T], dir string, shards int) error {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	vertices := 0
+
+	for hash := range adjacencyMap {
+		if hash < 0 {
+			return fmt.Errorf("vertex %d has a negative hash", hash)
+		}
+		if hash >= vertices {
+			vertices = hash + 1
+		}
+	}
+
+	w, err := Create(dir, vertices, shards)
+	if err != nil {
+		return err
+	}
+
+	isWeighted := g.Traits().IsWeighted
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			weight := 1
+			if isWeighted {
+				weight = edge.Properties.Weight
+			}
+
+			if err := w.WriteEdge(source, target, weight); err != nil {
+				w.closeFiles()
+				return err
+			}
+		}
+	}
+
+	return w.Close()
+}
+
+// Graph is a streamed graph whose edges are stored on disk. It doesn't hold any
+// edges in memory; instead, the edges are read from disk on each pass.
+type Graph struct {
+	dir      string
+	vertices int
+	shards   int
+}
+
+// Open opens the streamed graph in the given directory.
+func Open(dir string) (*Graph, error) {
+	meta, err := os.ReadFile(filepath.Join(dir, metaFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata: %w", err)
+	}
+
+	g := &Graph{
+		dir: dir,
+	}
+
+	if _, err := fmt.Sscanf(string(meta), "%d %d", &g.vertices, &g.shards); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	return g, nil
+}
+
+// Order returns the number of vertices of the streamed graph.
+func (g *Graph) Order() int {
+	return g.vertices
+}
+
+// Edges streams over all edges of the graph and calls the visit function for
+// each of them. The shards are read one after another, and the edges of a shard
+// are visited in the order in which they have been written. If visit returns an
+// error, Edges stops and returns that error.
+func (g *Graph) Edges(visit func(source, target, weight int) error) error {
+	var record [recordSize]byte
+
+	for i := 0; i < g.shards; i++ {
+		file, err := os.Open(filepath.Join(g.dir, fmt.Sprintf(shardFormat, i)))
+		if err != nil {
+			return fmt.Errorf("failed to open shard %d: %w", i, err)
+		}
+
+		reader := bufio.NewReaderSize(file, bufferSize)
+
+		for {
+			_, err := io.ReadFull(reader, record[:])
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				_ = file.Close()
+				return fmt.Errorf("failed to read shard %d: %w", i, err)
+			}
+
+			source := int(binary.LittleEndian.Uint64(record[0:8]))
+			target := int(binary.LittleEndian.Uint64(record[8:16]))
+			weight := int(binary.LittleEndian.Uint64(record[16:24]))
+
+			if err := visit(source, target, weight); err != nil {
+				_ = file.Close()
+				return err
+			}
+		}
+
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("failed to close shard %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// PageRank computes the PageRank of all vertices by running the given number of
+// power iterations, each of which is a single pass over the edges. The returned
+// slice contains the rank of vertex i at index i, and all ranks sum up to 1.
+// The rank of vertices without outgoing edges is distributed evenly.
+//
+// damping is the probability of following an edge instead of jumping to a
+// random vertex and usually is 0.85.
+func PageRank(g *Graph, damping float64, iterations int) ([]float64, error) {
+	teleport := make([]float64, g.vertices)
+
+	for i := range teleport {
+		teleport[i] = 1 / float64(g.vertices)
+	}
+
+	return pageRank(g, damping, iterations, teleport)
+}
+
+// PersonalizedPageRank computes the PageRank of all vertices like [PageRank],
+// but instead of jumping to a uniformly chosen random vertex, the random surfer
+// jumps to one of the given seed vertices. Hence, the ranks measure the
+// relevance of each vertex to the seed vertices, for example to recommend items
+// to a user:
+//
+//	ranks, _ := stream.PersonalizedPageRank(g, 0.85, 20, map[int]float64{user: 1})
+//
+// The personalization maps each seed vertex to the probability of jumping to it,
+// which is normalized so that the probabilities sum up to 1. The rank of vertices
+// without outgoing edges is distributed among the seed vertices in the same way.
+// An error is returned if a seed vertex is out of range, if a probability is
+// negative, or if all probabilities are 0.
+func PersonalizedPageRank(g *Graph, damping float64, iterations int, personalization map[int]float64) ([]float64, error) {
+	teleport := make([]float64, g.vertices)
+	total := 0.0
+
+	for vertex, probability := range personalization {
+		if vertex < 0 || vertex >= g.vertices {
+			return nil, fmt.Errorf("seed vertex %d is out of range [0, %d)", vertex, g.vertices)
+		}
+
+		if probability < 0 {
+			return nil, fmt.Errorf("seed vertex %d has a negative probability", vertex)
+		}
+
+		teleport[vertex] = probability
+		total += probability
+	}
+
+	if total == 0 {
+		return nil, errors.New("personalization must contain at least one seed vertex with a positive probability")
+	}
+
+	for i := range teleport {
+		teleport[i] /= total
+	}
+
+	return pageRank(g, damping, iterations, teleport)
+}
+
+// pageRank runs the power iterations of PageRank, where teleport contains the
+// probability of jumping to each vertex, which sum up to 1.
+func pageRank(g *Graph, damping float64, iterations int, teleport []float64) ([]float64, error) {
+	n := g.vertices
+	if n == 0 {
+		return []float64{}, nil
+	}
+
+	outDegrees := make([]int, n)
+
+	err := g.Edges(func(source, _, _ int) error {
+		outDegrees[source]++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make([]float64, n)
+	next := make([]float64, n)
+
+	copy(ranks, teleport)
+
+	for iteration := 0; iteration < iterations; iteration++ {
+		danglingRank := 0.0
+		for i, degree := range outDegrees {
+			if degree == 0 {
+				danglingRank += ranks[i]
+			}
+		}
+
+		for i := range next {
+			next[i] = (1 - damping + damping*danglingRank) * teleport[i]
+		}
+
+		err := g.Edges(func(source, target, _ int) error {
+			next[target] += damping * ranks[source] / float64(outDegrees[source])
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ranks, next = next, ranks
+	}
+
+	return ranks, nil
+}
+
+// ConnectedComponents computes the weakly connected components of the graph
+// using label propagation, treating each edge as undirected. The returned slice
+// contains the component of vertex i at index i, where each component is
+// identified by its smallest vertex. The number of passes over the edges is
+// bounded by the diameter of the graph.
+func ConnectedComponents(g *Graph) ([]int, error) {
+	components := make([]int, g.vertices)
+
+	for i := range components {
+		components[i] = i
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		err := g.Edges(func(source, target, _ int) error {
+			if components[source] < components[target] {
+				components[target] = components[source]
+				changed = true
+			} else if components[target] < components[source] {
+				components[source] = components[target]
+				changed = true
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return components, nil
+}
+
+// ShortestPaths computes the length of the shortest path from the source vertex
+// to all other vertices using the Bellman-Ford algorithm, where each iteration
+// is a single pass over the edges. The returned slice contains the distance of
+// vertex i at index i, or Unreachable if there is no path to vertex i.
+//
+// Unlike graph.ShortestPath, ShortestPaths supports negative weights. If there
+// is a negative cycle reachable from source, ErrNegativeCycle is returned.
+// Edges of streamed graphs are always weighted, so an unweighted graph should
+// be written with weight 1 for each edge, as Export does.
+func ShortestPaths(g *Graph, source int) ([]int, error) {
+	if source < 0 || source >= g.vertices {
+		return nil, fmt.Errorf("source vertex %d is out of range [0, %d)", source, g.vertices)
+	}
+
+	distances := make([]int, g.vertices)
+
+	for i := range distances {
+		distances[i] = Unreachable
+	}
+
+	distances[source] = 0
+
+	// In a graph without negative cycles, all distances are final after n-1
+	// passes. If the distances still change in the n-th pass, there must be a
+	// negative cycle.
+	for pass := 0; pass < g.vertices; pass++ {
+		changed := false
+
+		err := g.Edges(func(source, target, weight int) error {
+			if distances[source] == Unreachable {
+				return nil
+			}
+			if distance := distances[source] + weight; distance < distances[target] {
+				distances[target] = distance
+				changed = true
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !changed {
+			return distances, nil
+		}
+	}
+
+	return nil, ErrNegativeCycle
+}
@@ -0,0 +1,357 @@
+package stream
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/dominikbraun/graph"
+)
+
+type edge struct {
+	source, target, weight int
+}
+
+func create(t *testing.T, vertices, shards int, edges []edge) *Graph {
+	dir := t.TempDir()
+
+	w, err := Create(dir, vertices, shards)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, e := range edges {
+		if err := w.WriteEdge(e.source, e.target, e.weight); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	g, err := Open(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return g
+}
+
+func TestWriter(t *testing.T) {
+	edges := []edge{
+		{0, 1, 5},
+		{9, 2, -3},
+		{4, 4, 0},
+		{7, 0, 1},
+	}
+
+	g := create(t, 10, 3, edges)
+
+	if g.Order() != 10 {
+		t.Errorf("expected order 10, got %d", g.Order())
+	}
+
+	read := make(map[edge]struct{})
+
+	_ = g.Edges(func(source, target, weight int) error {
+		read[edge{source, target, weight}] = struct{}{}
+		return nil
+	})
+
+	if len(read) != len(edges) {
+		t.Fatalf("expected %d edges, got %d", len(edges), len(read))
+	}
+
+	for _, e := range edges {
+		if _, ok := read[e]; !ok {
+			t.Errorf("expected edge %v to be read", e)
+		}
+	}
+
+	w, _ := Create(t.TempDir(), 2, 1)
+	if err := w.WriteEdge(0, 2, 1); err == nil {
+		t.Errorf("expected error for out-of-range vertex, got nil")
+	}
+	_ = w.Close()
+
+	if _, err := Create(t.TempDir(), 2, 0); err == nil {
+		t.Errorf("expected error for zero shards, got nil")
+	}
+}
+
+func TestExport(t *testing.T) {
+	g := graph.New(graph.IntHash, graph.Weighted())
+
+	for i := 0; i < 4; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	_ = g.AddEdge(0, 1, graph.EdgeWeight(2))
+	_ = g.AddEdge(1, 2, graph.EdgeWeight(3))
+
+	dir := t.TempDir()
+
+	if err := Export(g, dir, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if s.Order() != 4 {
+		t.Errorf("expected order 4, got %d", s.Order())
+	}
+
+	count := 0
+	_ = s.Edges(func(int, int, int) error {
+		count++
+		return nil
+	})
+
+	// Each undirected edge is written in both directions.
+	if count != 4 {
+		t.Errorf("expected 4 edges, got %d", count)
+	}
+}
+
+func TestExport_unweighted(t *testing.T) {
+	g := graph.New(graph.IntHash, graph.Directed())
+
+	for i := 0; i < 4; i++ {
+		_ = g.AddVertex(i)
+	}
+
+	_ = g.AddEdge(0, 1)
+	_ = g.AddEdge(1, 2)
+	_ = g.AddEdge(2, 3)
+
+	dir := t.TempDir()
+
+	if err := Export(g, dir, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	distances, err := ShortestPaths(s, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []int{0, 1, 2, 3}
+
+	for i := range expected {
+		if distances[i] != expected[i] {
+			t.Errorf("expected distances %v, got %v", expected, distances)
+			break
+		}
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	tests := map[string]struct {
+		vertices int
+		edges    []edge
+		expected []float64
+	}{
+		"cycle": {
+			vertices: 4,
+			edges:    []edge{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 0, 1}},
+			expected: []float64{0.25, 0.25, 0.25, 0.25},
+		},
+		"star with dangling leaves": {
+			vertices: 3,
+			edges:    []edge{{0, 1, 1}, {0, 2, 1}},
+			// Computed by iterating the PageRank equations until convergence.
+			expected: []float64{0.2597, 0.3701, 0.3701},
+		},
+		"empty graph": {
+			vertices: 0,
+			expected: []float64{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := create(t, test.vertices, 2, test.edges)
+
+			ranks, err := PageRank(g, 0.85, 100)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(ranks) != len(test.expected) {
+				t.Fatalf("expected %d ranks, got %d", len(test.expected), len(ranks))
+			}
+
+			for i, expected := range test.expected {
+				if math.Abs(ranks[i]-expected) > 1e-3 {
+					t.Errorf("expected rank %v for vertex %d, got %v", expected, i, ranks[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPersonalizedPageRank(t *testing.T) {
+	tests := map[string]struct {
+		vertices        int
+		edges           []edge
+		personalization map[int]float64
+		expected        []float64
+		shouldFail      bool
+	}{
+		"single seed": {
+			vertices:        4,
+			edges:           []edge{{0, 1, 1}, {1, 0, 1}, {2, 3, 1}, {3, 2, 1}},
+			personalization: map[int]float64{0: 1},
+			// r0 = 0.15 + 0.85*r1 and r1 = 0.85*r0.
+			expected: []float64{0.15 / (1 - 0.85*0.85), 0.85 * 0.15 / (1 - 0.85*0.85), 0, 0},
+		},
+		"uniform personalization": {
+			vertices:        3,
+			edges:           []edge{{0, 1, 1}, {0, 2, 1}},
+			personalization: map[int]float64{0: 2, 1: 2, 2: 2},
+			expected:        []float64{0.2597, 0.3701, 0.3701},
+		},
+		"dangling vertex": {
+			vertices:        2,
+			edges:           []edge{{0, 1, 1}},
+			personalization: map[int]float64{0: 1},
+			// r0 = 0.15 + 0.85*r1 and r1 = 0.85*r0, since the rank of the
+			// dangling vertex 1 is given back to the seed.
+			expected: []float64{0.15 / (1 - 0.85*0.85), 0.85 * 0.15 / (1 - 0.85*0.85)},
+		},
+		"seed out of range": {
+			vertices:        2,
+			personalization: map[int]float64{2: 1},
+			shouldFail:      true,
+		},
+		"negative probability": {
+			vertices:        2,
+			personalization: map[int]float64{0: 1, 1: -1},
+			shouldFail:      true,
+		},
+		"no seed": {
+			vertices:   2,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := create(t, test.vertices, 2, test.edges)
+
+			ranks, err := PersonalizedPageRank(g, 0.85, 100, test.personalization)
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+			}
+
+			if len(ranks) != len(test.expected) {
+				t.Fatalf("expected %d ranks, got %d", len(test.expected), len(ranks))
+			}
+
+			for i, expected := range test.expected {
+				if math.Abs(ranks[i]-expected) > 1e-3 {
+					t.Errorf("expected rank %v for vertex %d, got %v", expected, i, ranks[i])
+				}
+			}
+		})
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	g := create(t, 7, 3, []edge{
+		{5, 1, 1},
+		{1, 3, 1},
+		{2, 0, 1},
+		{4, 6, 1},
+		{6, 4, 1},
+	})
+
+	components, err := ConnectedComponents(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []int{0, 1, 0, 1, 4, 1, 4}
+
+	for i := range expected {
+		if components[i] != expected[i] {
+			t.Errorf("expected components %v, got %v", expected, components)
+			break
+		}
+	}
+}
+
+func TestShortestPaths(t *testing.T) {
+	tests := map[string]struct {
+		vertices    int
+		edges       []edge
+		source      int
+		expected    []int
+		expectedErr error
+	}{
+		"positive weights": {
+			vertices: 5,
+			edges: []edge{
+				{0, 1, 4},
+				{0, 2, 1},
+				{2, 1, 2},
+				{1, 3, 1},
+				{2, 3, 5},
+			},
+			source:   0,
+			expected: []int{0, 3, 1, 4, Unreachable},
+		},
+		"negative weights": {
+			vertices: 3,
+			edges: []edge{
+				{0, 1, 4},
+				{0, 2, 2},
+				{1, 2, -3},
+			},
+			source:   0,
+			expected: []int{0, 4, 1},
+		},
+		"negative cycle": {
+			vertices: 3,
+			edges: []edge{
+				{0, 1, 1},
+				{1, 2, -2},
+				{2, 1, 1},
+			},
+			source:      0,
+			expectedErr: ErrNegativeCycle,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := create(t, test.vertices, 2, test.edges)
+
+			distances, err := ShortestPaths(g, test.source)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			for i := range test.expected {
+				if distances[i] != test.expected[i] {
+					t.Errorf("expected distances %v, got %v", test.expected, distances)
+					break
+				}
+			}
+		})
+	}
+}
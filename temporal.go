@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"fmt"
+	"time"
+)
+
+// Validity is the time interval in which an edge exists, including both From
+// and Until. A zero time means that the interval is unbounded on that side. Use
+// [EdgeValidity] or [EdgeTimestamp] to set the validity of an edge.
+type Validity struct {
+	From  time.Time
+	Until time.Time
+}
+
+// Snapshot returns the state of an evolving graph at the given point in time.
+// The returned graph has the same traits as g and contains all vertices of g,
+// but only the edges whose validity interval contains at. Edges without any
+// validity are always contained. The validity of an edge is set using the
+// [EdgeValidity] or [EdgeTimestamp] options:
+//
+//	g := graph.New(graph.StringHash, graph.Directed())
+//
+//	_ = g.AddVertex("A")
+//	_ = g.AddVertex("B")
+//
+//	_ = g.AddEdge("A", "B", graph.EdgeValidity(hired, fired))
+//
+//	// Contains the edge (A, B) if hired <= at <= fired.
+//	snapshot, _ := graph.Snapshot(g, at)
+//
+// The vertices and edges are copied along with their properties, so that the
+// snapshot can be modified without affecting g.
+func Snapshot[K comparable, T any](g Graph[K, T], at time.Time) (Graph[K, T], error) {
+	return temporalView(g, func(properties EdgeProperties) bool {
+		return !startsAfter(properties, at) && !endsBefore(properties, at)
+	})
+}
+
+// Window returns the state of an evolving graph during the time range between
+// from and to, including both. The returned graph has the same traits as g and
+// contains all vertices of g, but only the edges whose validity interval
+// overlaps with the time range. For edges created using [EdgeTimestamp], these
+// are the edges with a timestamp inside the range, which is useful to analyze
+// event logs such as messages or transactions in a certain period of time.
+//
+// Like [Snapshot], Window copies the vertices and edges along with their
+// properties. If to is before from, the window doesn't contain any edges.
+func Window[K comparable, T any](g Graph[K, T], from, to time.Time) (Graph[K, T], error) {
+	return temporalView(g, func(properties EdgeProperties) bool {
+		return !from.After(to) && !startsAfter(properties, to) && !endsBefore(properties, from)
+	})
+}
+
+// temporalView creates a graph with all vertices of g and all edges that are
+// valid according to isValid.
+func temporalView[K comparable, T any](g Graph[K, T], isValid func(EdgeProperties) bool) (Graph[K, T], error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	view := NewLike(g)
+
+	for hash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		if err := view.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edges: %w", err)
+	}
+
+	for _, edge := range edges {
+		if !isValid(edge.Properties) {
+			continue
+		}
+
+		if err := view.AddEdge(copyEdge(edge)); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return view, nil
+}
+
+// startsAfter reports whether the validity interval of an edge starts after t.
+// A zero start means that the edge has always been valid.
+func startsAfter(properties EdgeProperties, t time.Time) bool {
+	from := validFrom(properties)
+	return !from.IsZero() && from.After(t)
+}
+
+// endsBefore reports whether the validity interval of an edge ends before t. A
+// zero end means that the edge stays valid forever.
+func endsBefore(properties EdgeProperties, t time.Time) bool {
+	until := validUntil(properties)
+	return !until.IsZero() && until.Before(t)
+}
+
+// validFrom returns the start of the validity interval of an edge, which is
+// the zero time if the edge doesn't have a validity.
+func validFrom(properties EdgeProperties) time.Time {
+	if properties.Validity == nil {
+		return time.Time{}
+	}
+	return properties.Validity.From
+}
+
+// validUntil returns the end of the validity interval of an edge, which is the
+// zero time if the edge doesn't have a validity.
+func validUntil(properties EdgeProperties) time.Time {
+	if properties.Validity == nil {
+		return time.Time{}
+	}
+	return properties.Validity.Until
+}
@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnapshot(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := map[string]struct {
+		isDirected    bool
+		at            time.Time
+		expectedEdges map[int][]int
+	}{
+		"directed graph before the intervals": {
+			isDirected:    true,
+			at:            day(1),
+			expectedEdges: map[int][]int{1: {4}},
+		},
+		"directed graph at the start of an interval": {
+			isDirected:    true,
+			at:            day(2),
+			expectedEdges: map[int][]int{1: {2, 4}},
+		},
+		"directed graph inside overlapping intervals": {
+			isDirected:    true,
+			at:            day(3),
+			expectedEdges: map[int][]int{1: {2, 4}, 2: {3}},
+		},
+		"directed graph at the end of an interval": {
+			isDirected:    true,
+			at:            day(5),
+			expectedEdges: map[int][]int{1: {2, 4}, 2: {3}, 3: {4}},
+		},
+		"directed graph after the bounded intervals": {
+			isDirected:    true,
+			at:            day(10),
+			expectedEdges: map[int][]int{1: {2, 4}},
+		},
+		"undirected graph at a timestamp": {
+			isDirected:    false,
+			at:            day(5),
+			expectedEdges: map[int][]int{1: {2, 4}, 2: {3}, 3: {4}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newTemporalGraph(test.isDirected, day)
+
+			snapshot, err := Snapshot(g, test.at)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertTemporalView(t, g, snapshot, test.expectedEdges)
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := map[string]struct {
+		isDirected    bool
+		from          time.Time
+		to            time.Time
+		expectedEdges map[int][]int
+	}{
+		"window before the intervals": {
+			isDirected:    true,
+			from:          day(1),
+			to:            day(1),
+			expectedEdges: map[int][]int{1: {4}},
+		},
+		"window touching the start of intervals": {
+			isDirected:    true,
+			from:          day(1),
+			to:            day(3),
+			expectedEdges: map[int][]int{1: {2, 4}, 2: {3}},
+		},
+		"window containing the timestamp": {
+			isDirected:    true,
+			from:          day(4),
+			to:            day(6),
+			expectedEdges: map[int][]int{1: {2, 4}, 2: {3}, 3: {4}},
+		},
+		"window after the bounded intervals": {
+			isDirected:    true,
+			from:          day(6),
+			to:            day(7),
+			expectedEdges: map[int][]int{1: {2, 4}},
+		},
+		"reversed window": {
+			isDirected:    true,
+			from:          day(5),
+			to:            day(3),
+			expectedEdges: map[int][]int{},
+		},
+		"undirected graph": {
+			isDirected:    false,
+			from:          day(6),
+			to:            day(7),
+			expectedEdges: map[int][]int{1: {2, 4}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := newTemporalGraph(test.isDirected, day)
+
+			window, err := Window(g, test.from, test.to)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertTemporalView(t, g, window, test.expectedEdges)
+		})
+	}
+}
+
+func TestEdgeValidity(t *testing.T) {
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		option        func(*EdgeProperties)
+		expectedFrom  time.Time
+		expectedUntil time.Time
+	}{
+		"validity": {
+			option:        EdgeValidity(from, until),
+			expectedFrom:  from,
+			expectedUntil: until,
+		},
+		"validity without end": {
+			option:       EdgeValidity(from, time.Time{}),
+			expectedFrom: from,
+		},
+		"timestamp": {
+			option:        EdgeTimestamp(until),
+			expectedFrom:  until,
+			expectedUntil: until,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			properties := EdgeProperties{}
+
+			test.option(&properties)
+
+			if properties.Validity == nil {
+				t.Fatal("expected validity to be set")
+			}
+
+			if !properties.Validity.From.Equal(test.expectedFrom) {
+				t.Errorf("expected start %v, got %v", test.expectedFrom, properties.Validity.From)
+			}
+
+			if !properties.Validity.Until.Equal(test.expectedUntil) {
+				t.Errorf("expected end %v, got %v", test.expectedUntil, properties.Validity.Until)
+			}
+		})
+	}
+}
+
+func TestEdgeValidity_copied(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	g := New(IntHash, Directed())
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2, EdgeValidity(day(1), day(2)))
+
+	clone, err := g.Clone()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	edge, _ := g.Edge(1, 2)
+	edge.Properties.Validity.From = day(3)
+
+	cloned, _ := clone.Edge(1, 2)
+	if !cloned.Properties.Validity.From.Equal(day(1)) {
+		t.Errorf("expected the clone to keep start %v, got %v", day(1), cloned.Properties.Validity.From)
+	}
+}
+
+// newTemporalGraph creates a graph with the vertices 1 to 4 and the edges
+// (1, 2) valid from day 2 on, (2, 3) valid from day 3 to day 5, (3, 4) with a
+// timestamp on day 5, and (1, 4) without any validity.
+func newTemporalGraph(isDirected bool, day func(int) time.Time) Graph[int, int] {
+	g := New(IntHash)
+	if isDirected {
+		g = New(IntHash, Directed())
+	}
+
+	for _, vertex := range []int{1, 2, 3, 4} {
+		_ = g.AddVertex(vertex)
+	}
+
+	_ = g.AddEdge(1, 2, EdgeValidity(day(2), time.Time{}))
+	_ = g.AddEdge(2, 3, EdgeValidity(day(3), day(5)), EdgeWeight(7))
+	_ = g.AddEdge(3, 4, EdgeTimestamp(day(5)))
+	_ = g.AddEdge(1, 4)
+
+	return g
+}
+
+func assertTemporalView(t *testing.T, g, view Graph[int, int], expectedEdges map[int][]int) {
+	t.Helper()
+
+	if order, _ := view.Order(); order != 4 {
+		t.Errorf("expected 4 vertices, got %d", order)
+	}
+
+	expectedSize := 0
+
+	for source, targets := range expectedEdges {
+		for _, target := range targets {
+			expectedSize++
+
+			edge, err := view.Edge(source, target)
+			if err != nil {
+				t.Errorf("expected edge (%d, %d): %v", source, target, err)
+				continue
+			}
+
+			original, _ := g.Edge(source, target)
+			if !edgePropertiesEqual(edge.Properties, original.Properties) {
+				t.Errorf("expected properties %v for edge (%d, %d), got %v", original.Properties, source, target, edge.Properties)
+			}
+		}
+	}
+
+	if size, _ := view.Size(); size != expectedSize {
+		t.Errorf("expected %d edges, got %d", expectedSize, size)
+	}
+}
@@ -1,18 +1,59 @@
 package graph
 
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrOptionMismatch is returned by ValidateTraits if an option doesn't match
+// the hash or vertex type of the graph.
+var ErrOptionMismatch = errors.New("option doesn't match the types of the graph")
+
 // Traits represents a set of graph traits and types, such as directedness or acyclicness. These
 // traits can be set when creating a graph by passing the corresponding functional options, for
 // example:
 //
 //	g := graph.New(graph.IntHash, graph.Directed())
 //
-// This will set the IsDirected field to true.
+// This will set the IsDirected field to true. The traits of an existing graph are
+// returned by [Graph.Traits].
 type Traits struct {
 	IsDirected    bool
 	IsAcyclic     bool
 	IsWeighted    bool
 	IsRooted      bool
 	PreventCycles bool
+
+	// hooks contains the callbacks registered using OnVertexAdded and similar
+	// functional options. It is a pointer so that Traits remains comparable.
+	hooks *hookSet
+
+	// keepFirstEdge is set using KeepFirstEdge.
+	keepFirstEdge bool
+}
+
+// hookSet is an immutable list of the callbacks registered for a graph.
+type hookSet struct {
+	hooks []any
+}
+
+// with returns a new hookSet that contains the hooks of s and the given hook.
+// The receiver may be nil. Since s isn't modified, copies of a Traits value
+// don't share hooks registered afterwards.
+func (s *hookSet) with(hook any) *hookSet {
+	hooks := make([]any, 0, len(s.all())+1)
+	hooks = append(hooks, s.all()...)
+
+	return &hookSet{hooks: append(hooks, hook)}
+}
+
+// all returns the registered hooks. The receiver may be nil.
+func (s *hookSet) all() []any {
+	if s == nil {
+		return nil
+	}
+
+	return s.hooks
 }
 
 // Directed creates a directed graph. This has implications on graph traversal and the order of
@@ -61,3 +102,193 @@ func PreventCycles() func(*Traits) {
 		t.PreventCycles = true
 	}
 }
+
+// autoVertexFunc is the type of the function registered using AutoVertices.
+type autoVertexFunc[K comparable, T any] func(hash K) T
+
+// AutoVertices makes AddEdge and AddEdges create the source and target vertex
+// of an edge if they don't exist yet, instead of returning ErrVertexNotFound.
+// This is convenient when streaming edges whose vertices are only implied by
+// the edges themselves:
+//
+//	g := graph.New(graph.StringHash, graph.AutoVertices(func(hash string) string {
+//		return hash
+//	}))
+//
+//	_ = g.AddEdge("A", "B")
+//
+// Since AddEdge only receives the hashes of the vertices, the given function
+// has to create the vertex value for a hash. The hash of the created vertex
+// has to be the given hash, otherwise AddEdge returns an error. The vertices
+// are created without any properties.
+//
+// The types of the function's parameter and return value have to match the
+// types of the graph, otherwise the option is ignored. Use [ValidateTraits] to
+// detect such a mismatch. Like hooks, the function is not copied by Clone or
+// NewLike.
+func AutoVertices[K comparable, T any](vertex func(hash K) T) func(*Traits) {
+	return func(t *Traits) {
+		t.hooks = t.hooks.with(autoVertexFunc[K, T](vertex))
+	}
+}
+
+// autoVertex returns the function registered using AutoVertices, if any.
+func autoVertex[K comparable, T any](t *Traits) (autoVertexFunc[K, T], bool) {
+	for _, hook := range t.hooks.all() {
+		if f, ok := hook.(autoVertexFunc[K, T]); ok {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
+
+// edgeMergeFunc is the type of the function registered using MergeEdges.
+type edgeMergeFunc[K comparable] func(existing, duplicate Edge[K]) EdgeProperties
+
+// KeepFirstEdge makes AddEdge and AddEdges ignore an edge that already exists
+// instead of returning ErrEdgeAlreadyExists. The existing edge and its
+// properties are kept. This is useful for ingestion pipelines that may load
+// the same edge several times:
+//
+//	g := graph.New(graph.StringHash, graph.KeepFirstEdge())
+//
+//	_ = g.AddEdge("A", "B", graph.EdgeWeight(3))
+//	_ = g.AddEdge("A", "B", graph.EdgeWeight(5)) // The weight remains 3.
+//
+// Unlike MergeEdges, this policy is copied by Clone and NewLike.
+func KeepFirstEdge() func(*Traits) {
+	return func(t *Traits) {
+		t.keepFirstEdge = true
+	}
+}
+
+// MergeEdges makes AddEdge and AddEdges merge an edge that already exists with
+// the added duplicate edge instead of returning ErrEdgeAlreadyExists. The given
+// function receives the existing edge and the duplicate edge with the
+// properties set by the functional options and returns the properties of the
+// merged edge:
+//
+//	// Sum up the weights of duplicate edges.
+//	g := graph.New(graph.StringHash, graph.Weighted(), graph.MergeEdges(func(existing, duplicate graph.Edge[string]) graph.EdgeProperties {
+//		existing.Properties.Weight += duplicate.Properties.Weight
+//		return existing.Properties
+//	}))
+//
+// The existing edge is updated with the returned properties, which invokes the
+// hooks registered using OnEdgesUpdated. The type of the function's parameters
+// has to match the hash type of the graph, otherwise the option is ignored and
+// duplicate edges are rejected as usual. Use [ValidateTraits] to detect such a
+// mismatch.
+//
+// If both MergeEdges and KeepFirstEdge are set, KeepFirstEdge takes precedence.
+// Like hooks, the function is not copied by Clone or NewLike.
+func MergeEdges[K comparable](merge func(existing, duplicate Edge[K]) EdgeProperties) func(*Traits) {
+	return func(t *Traits) {
+		t.hooks = t.hooks.with(edgeMergeFunc[K](merge))
+	}
+}
+
+// edgeMerge returns the function registered using MergeEdges, if any.
+func edgeMerge[K comparable](t *Traits) (edgeMergeFunc[K], bool) {
+	for _, hook := range t.hooks.all() {
+		if f, ok := hook.(edgeMergeFunc[K]); ok {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
+
+// hasDuplicateEdgePolicy reports whether duplicate edges are handled using
+// KeepFirstEdge or MergeEdges instead of returning ErrEdgeAlreadyExists.
+func hasDuplicateEdgePolicy[K comparable](t *Traits) bool {
+	_, ok := edgeMerge[K](t)
+	return t.keepFirstEdge || ok
+}
+
+// edgeWeightFunc is the type of the function registered using WeightFunc.
+type edgeWeightFunc[T any] func(edge Edge[T]) int
+
+// WeightFunc creates a weighted graph whose edge weights are computed by the
+// given function each time an edge is read, instead of being stored with the
+// edge. This allows weights that are derived from the current state of the
+// vertices, such as the congestion of a road, without updating every edge
+// whenever that state changes:
+//
+//	g := graph.New(roadHash, graph.WeightFunc(func(edge graph.Edge[*Road]) int {
+//		return edge.Properties.Weight * edge.Target.Congestion
+//	}))
+//
+// The function receives the edge with its vertex values and its stored
+// properties, so its result may be based on the stored weight. The computed
+// weight is returned by Edge, Edges, AdjacencyMap, and PredecessorMap, and
+// hence used by all algorithms, while UpdateEdge and UpdateWeights still
+// modify the stored weight. Clone and Compact store the weights computed at
+// the time of the call.
+//
+// In an undirected graph, the function always receives an edge in the same
+// direction, no matter in which direction the edge is read, so that the edges
+// AB and BA have the same weight. This direction is determined by ordering the
+// hashes of the two vertices and is not necessarily the direction the edge has
+// been added in.
+//
+// Since the weights are computed on every read, the store-specific fastpaths
+// of functions like VisitAdjacencies are not used for such a graph.
+//
+// The type of the function's parameter has to match the vertex type of the
+// graph. Otherwise, the function is ignored and the stored weights are used,
+// although the graph is still weighted. Use [ValidateTraits] to detect such a
+// mismatch.
+func WeightFunc[T any](weight func(edge Edge[T]) int) func(*Traits) {
+	return func(t *Traits) {
+		t.IsWeighted = true
+		t.hooks = t.hooks.with(edgeWeightFunc[T](weight))
+	}
+}
+
+// edgeWeight returns the function registered using WeightFunc, if any.
+func edgeWeight[T any](t *Traits) (edgeWeightFunc[T], bool) {
+	for _, hook := range t.hooks.all() {
+		if f, ok := hook.(edgeWeightFunc[T]); ok {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
+
+// ValidateTraits reports whether the given functional options match a graph
+// with the hash type K and the vertex type T. Options like OnVertexAdded or
+// AutoVertices are generic functions themselves, and an option whose types
+// don't match the graph, such as an OnEdgeAdded hook for string hashes passed
+// to a graph of integers, would be ignored by New without notice. Validating
+// the options beforehand detects such a mistake:
+//
+//	options := []func(*graph.Traits){graph.Directed(), graph.OnVertexAdded(onAdded)}
+//
+//	if err := graph.ValidateTraits[string, City](options...); err != nil {
+//		return err
+//	}
+//
+//	g := graph.New(cityHash, options...)
+//
+// If an option doesn't match, the returned error wraps ErrOptionMismatch.
+func ValidateTraits[K comparable, T any](options ...func(*Traits)) error {
+	var p Traits
+
+	for _, option := range options {
+		option(&p)
+	}
+
+	for _, hook := range p.hooks.all() {
+		switch hook.(type) {
+		case vertexAddedHook[K, T], vertexRemovedHook[K], edgeAddedHook[K], edgeRemovedHook[K], edgesUpdatedHook[K]:
+		case autoVertexFunc[K, T], edgeMergeFunc[K], edgeWeightFunc[T]:
+		default:
+			return fmt.Errorf("%T: %w", hook, ErrOptionMismatch)
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,11 @@
 package graph
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
 
 func TestDirected(t *testing.T) {
 	tests := map[string]struct {
@@ -136,6 +141,256 @@ func TestPreventCycles(t *testing.T) {
 	}
 }
 
+func TestAutoVertices(t *testing.T) {
+	tests := map[string]struct {
+		traits      []func(*Traits)
+		vertices    []int
+		edges       []Edge[int]
+		bulk        bool
+		expected    []int
+		expectedErr error
+	}{
+		"directed graph": {
+			traits:   []func(*Traits){Directed(), AutoVertices(func(hash int) int { return hash })},
+			vertices: []int{1},
+			edges:    []Edge[int]{{Source: 1, Target: 2}, {Source: 3, Target: 1}},
+			expected: []int{1, 2, 3},
+		},
+		"undirected graph": {
+			traits:   []func(*Traits){AutoVertices(func(hash int) int { return hash })},
+			edges:    []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}},
+			expected: []int{1, 2, 3},
+		},
+		"bulk insertion": {
+			traits:   []func(*Traits){Directed(), AutoVertices(func(hash int) int { return hash })},
+			edges:    []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}},
+			bulk:     true,
+			expected: []int{1, 2, 3},
+		},
+		"without option": {
+			traits:      []func(*Traits){Directed()},
+			vertices:    []int{1},
+			edges:       []Edge[int]{{Source: 1, Target: 2}},
+			expected:    []int{1},
+			expectedErr: ErrVertexNotFound,
+		},
+		"mismatching types": {
+			traits:      []func(*Traits){Directed(), AutoVertices(func(hash string) string { return hash })},
+			edges:       []Edge[int]{{Source: 1, Target: 2}},
+			expected:    []int{},
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			var err error
+
+			if test.bulk {
+				err = g.AddEdges(test.edges)
+			} else {
+				for _, edge := range test.edges {
+					if err = g.AddEdge(edge.Source, edge.Target); err != nil {
+						break
+					}
+				}
+			}
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			adjacencyMap, _ := g.AdjacencyMap()
+
+			vertices := make([]int, 0, len(adjacencyMap))
+			for vertex := range adjacencyMap {
+				vertices = append(vertices, vertex)
+			}
+
+			if !slicesAreEqual(vertices, test.expected) {
+				t.Errorf("expected vertices %v, got %v", test.expected, vertices)
+			}
+		})
+	}
+}
+
+func TestAutoVertices_hashMismatch(t *testing.T) {
+	g := New(IntHash, AutoVertices(func(hash int) int { return hash + 1 }))
+
+	if err := g.AddEdge(1, 2); err == nil {
+		t.Error("expected error for mismatching hash, got nil")
+	}
+
+	if order, _ := g.Order(); order != 0 {
+		t.Errorf("expected no vertices, got %d", order)
+	}
+}
+
+func TestAutoVertices_rejectedEdge(t *testing.T) {
+	tests := map[string]struct {
+		traits   []func(*Traits)
+		edges    []Edge[int]
+		bulk     bool
+		expected []int
+	}{
+		"edge creates cycle": {
+			traits:   []func(*Traits){Directed(), PreventCycles()},
+			edges:    []Edge[int]{{Source: 3, Target: 3}},
+			expected: []int{1, 2},
+		},
+		"bulk insertion with existing edge": {
+			traits:   []func(*Traits){Directed()},
+			edges:    []Edge[int]{{Source: 3, Target: 4}, {Source: 1, Target: 2}},
+			bulk:     true,
+			expected: []int{1, 2},
+		},
+		"undirected bulk insertion with existing edge": {
+			traits:   []func(*Traits){},
+			edges:    []Edge[int]{{Source: 3, Target: 4}, {Source: 2, Target: 1}},
+			bulk:     true,
+			expected: []int{1, 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			added := make([]int, 0)
+
+			traits := append(test.traits,
+				AutoVertices(func(hash int) int { return hash }),
+				OnVertexAdded(func(hash int, _ int) { added = append(added, hash) }),
+			)
+
+			g := New(IntHash, traits...)
+
+			_ = g.AddVertex(1)
+			_ = g.AddVertex(2)
+			_ = g.AddEdge(1, 2)
+
+			added = added[:0]
+
+			var err error
+
+			if test.bulk {
+				err = g.AddEdges(test.edges)
+			} else {
+				err = g.AddEdge(test.edges[0].Source, test.edges[0].Target)
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			adjacencyMap, _ := g.AdjacencyMap()
+
+			vertices := make([]int, 0, len(adjacencyMap))
+			for vertex := range adjacencyMap {
+				vertices = append(vertices, vertex)
+			}
+
+			if !slicesAreEqual(vertices, test.expected) {
+				t.Errorf("expected vertices %v, got %v", test.expected, vertices)
+			}
+
+			if len(added) != 0 {
+				t.Errorf("expected no added vertices to be reported, got %v", added)
+			}
+		})
+	}
+}
+
+func TestDuplicateEdges(t *testing.T) {
+	sum := MergeEdges(func(existing, duplicate Edge[int]) EdgeProperties {
+		existing.Properties.Weight += duplicate.Properties.Weight
+		return existing.Properties
+	})
+
+	tests := map[string]struct {
+		traits         []func(*Traits)
+		bulk           bool
+		expectedWeight int
+		expectedErr    error
+	}{
+		"default": {
+			expectedWeight: 3,
+			expectedErr:    ErrEdgeAlreadyExists,
+		},
+		"keep first edge": {
+			traits:         []func(*Traits){KeepFirstEdge()},
+			expectedWeight: 3,
+		},
+		"keep first edge in bulk": {
+			traits:         []func(*Traits){KeepFirstEdge()},
+			bulk:           true,
+			expectedWeight: 3,
+		},
+		"merge edges": {
+			traits:         []func(*Traits){sum},
+			expectedWeight: 8,
+		},
+		"merge edges in bulk": {
+			traits:         []func(*Traits){sum},
+			bulk:           true,
+			expectedWeight: 8,
+		},
+		"keep first edge takes precedence": {
+			traits:         []func(*Traits){sum, KeepFirstEdge()},
+			expectedWeight: 3,
+		},
+	}
+
+	for name, test := range tests {
+		for _, isDirected := range []bool{true, false} {
+			t.Run(fmt.Sprintf("%s, directed: %v", name, isDirected), func(t *testing.T) {
+				traits := append([]func(*Traits){Weighted()}, test.traits...)
+				if isDirected {
+					traits = append(traits, Directed())
+				}
+
+				g := New(IntHash, traits...)
+
+				_ = g.AddVertex(1)
+				_ = g.AddVertex(2)
+				_ = g.AddEdge(1, 2, EdgeWeight(3))
+
+				var err error
+
+				// In an undirected graph, the reversed edge is a duplicate as well.
+				source, target := 2, 1
+				if isDirected {
+					source, target = 1, 2
+				}
+
+				if test.bulk {
+					err = g.AddEdges([]Edge[int]{{Source: source, Target: target, Properties: EdgeProperties{Weight: 5}}})
+				} else {
+					err = g.AddEdge(source, target, EdgeWeight(5))
+				}
+
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				edge, _ := g.Edge(1, 2)
+
+				if edge.Properties.Weight != test.expectedWeight {
+					t.Errorf("expected weight %d, got %d", test.expectedWeight, edge.Properties.Weight)
+				}
+
+				if size, _ := g.Size(); size != 1 {
+					t.Errorf("expected size 1, got %d", size)
+				}
+			})
+		}
+	}
+}
+
 func traitsAreEqual(a, b *Traits) bool {
 	return a.IsAcyclic == b.IsAcyclic &&
 		a.IsDirected == b.IsDirected &&
@@ -143,3 +398,230 @@ func traitsAreEqual(a, b *Traits) bool {
 		a.IsWeighted == b.IsWeighted &&
 		a.PreventCycles == b.PreventCycles
 }
+
+func TestWeightFunc(t *testing.T) {
+	for _, isDirected := range []bool{true, false} {
+		t.Run(fmt.Sprintf("directed: %v", isDirected), func(t *testing.T) {
+			congestion := map[string]int{"B": 1, "C": 1, "D": 1}
+
+			// The weight multiplies the stored weight by the congestion of
+			// the vertex that isn't the start vertex A, which is symmetric.
+			traits := []func(*Traits){WeightFunc(func(edge Edge[string]) int {
+				vertex := edge.Target
+				if vertex == "A" {
+					vertex = edge.Source
+				}
+				return edge.Properties.Weight * congestion[vertex]
+			})}
+			if isDirected {
+				traits = append(traits, Directed())
+			}
+
+			g := New(StringHash, traits...)
+
+			if !g.Traits().IsWeighted {
+				t.Errorf("expected IsWeighted to be true")
+			}
+
+			for _, vertex := range []string{"A", "B", "C", "D"} {
+				_ = g.AddVertex(vertex)
+			}
+
+			_ = g.AddEdge("A", "B", EdgeWeight(1))
+			_ = g.AddEdge("A", "C", EdgeWeight(2))
+			_ = g.AddEdge("B", "D", EdgeWeight(1))
+			_ = g.AddEdge("C", "D", EdgeWeight(1))
+
+			path, err := ShortestPath(g, "A", "D")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(path, []string{"A", "B", "D"}) {
+				t.Errorf("expected path %v, got %v", []string{"A", "B", "D"}, path)
+			}
+
+			congestion["B"] = 5
+
+			path, err = ShortestPath(g, "A", "D")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(path, []string{"A", "C", "D"}) {
+				t.Errorf("expected path %v, got %v", []string{"A", "C", "D"}, path)
+			}
+
+			edge, err := g.Edge("A", "B")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if edge.Properties.Weight != 5 {
+				t.Errorf("expected weight 5, got %d", edge.Properties.Weight)
+			}
+
+			// UpdateEdge modifies the stored weight, not the computed one.
+			_ = g.UpdateEdge("A", "B", EdgeAttribute("lane", "left"))
+
+			congestion["B"] = 2
+
+			adjacencyMap, _ := g.AdjacencyMap()
+
+			if weight := adjacencyMap["A"]["B"].Properties.Weight; weight != 2 {
+				t.Errorf("expected weight 2, got %d", weight)
+			}
+		})
+	}
+}
+
+func TestWeightFunc_undirected(t *testing.T) {
+	// The weight depends on the direction of the edge, but both directions of
+	// an undirected edge must have the same weight.
+	g := New(IntHash, WeightFunc(func(edge Edge[int]) int {
+		return edge.Target
+	}))
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(5)
+	_ = g.AddEdge(5, 1)
+
+	forward, err := g.Edge(1, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	backward, err := g.Edge(5, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if forward.Properties.Weight != backward.Properties.Weight {
+		t.Errorf("expected equal weights, got %d and %d", forward.Properties.Weight, backward.Properties.Weight)
+	}
+
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	if a, b := adjacencyMap[1][5].Properties.Weight, adjacencyMap[5][1].Properties.Weight; a != b {
+		t.Errorf("expected equal weights in adjacency map, got %d and %d", a, b)
+	}
+
+	predecessorMap, _ := g.PredecessorMap()
+
+	if weight := predecessorMap[1][5].Properties.Weight; weight != forward.Properties.Weight {
+		t.Errorf("expected weight %d in predecessor map, got %d", forward.Properties.Weight, weight)
+	}
+}
+
+func TestKeepFirstEdge_copied(t *testing.T) {
+	for _, isDirected := range []bool{true, false} {
+		t.Run(fmt.Sprintf("directed: %v", isDirected), func(t *testing.T) {
+			traits := []func(*Traits){KeepFirstEdge()}
+			if isDirected {
+				traits = append(traits, Directed())
+			}
+
+			g := New(IntHash, traits...)
+
+			_ = g.AddVertex(1)
+			_ = g.AddVertex(2)
+			_ = g.AddEdge(1, 2)
+
+			clone, err := g.Clone()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if err := clone.AddEdge(1, 2); err != nil {
+				t.Errorf("expected clone to keep the first edge, got %v", err)
+			}
+
+			like := NewLike(g)
+
+			_ = like.AddVertex(1)
+			_ = like.AddVertex(2)
+			_ = like.AddEdge(1, 2)
+
+			if err := like.AddEdge(1, 2); err != nil {
+				t.Errorf("expected new graph to keep the first edge, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateTraits(t *testing.T) {
+	tests := map[string]struct {
+		options     []func(*Traits)
+		expectedErr error
+	}{
+		"no options": {},
+		"traits only": {
+			options: []func(*Traits){Directed(), PreventCycles(), Weighted()},
+		},
+		"matching hooks": {
+			options: []func(*Traits){
+				OnVertexAdded(func(hash string, value int) {}),
+				OnVertexRemoved(func(hash string) {}),
+				OnEdgeAdded(func(edge Edge[string]) {}),
+				OnEdgeRemoved(func(edge Edge[string]) {}),
+				OnEdgesUpdated(func(edges []Edge[string]) {}),
+			},
+		},
+		"hook with mismatching vertex type": {
+			options:     []func(*Traits){OnVertexAdded(func(hash string, value string) {})},
+			expectedErr: ErrOptionMismatch,
+		},
+		"hook with mismatching hash type": {
+			options:     []func(*Traits){Directed(), OnEdgeAdded(func(edge Edge[int]) {})},
+			expectedErr: ErrOptionMismatch,
+		},
+		"matching AutoVertices": {
+			options: []func(*Traits){AutoVertices(func(hash string) int { return len(hash) })},
+		},
+		"AutoVertices with mismatching types": {
+			options:     []func(*Traits){AutoVertices(func(hash int) int { return hash })},
+			expectedErr: ErrOptionMismatch,
+		},
+		"matching MergeEdges": {
+			options: []func(*Traits){KeepFirstEdge(), MergeEdges(func(existing, _ Edge[string]) EdgeProperties {
+				return existing.Properties
+			})},
+		},
+		"MergeEdges with mismatching hash type": {
+			options: []func(*Traits){MergeEdges(func(existing, _ Edge[int]) EdgeProperties {
+				return existing.Properties
+			})},
+			expectedErr: ErrOptionMismatch,
+		},
+		"matching WeightFunc": {
+			options: []func(*Traits){WeightFunc(func(edge Edge[int]) int { return edge.Target })},
+		},
+		"WeightFunc with mismatching vertex type": {
+			options:     []func(*Traits){WeightFunc(func(edge Edge[string]) int { return len(edge.Target) })},
+			expectedErr: ErrOptionMismatch,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTraits[string, int](test.options...)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Errorf("expected error %v, got %v", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestTraits_comparable(t *testing.T) {
+	g := New(IntHash, Directed(), OnVertexAdded(func(int, int) {}))
+	h := New(IntHash, Directed())
+
+	if *h.Traits() != (Traits{IsDirected: true}) {
+		t.Errorf("expected traits %v to equal directed traits", *h.Traits())
+	}
+
+	if *g.Traits() == *h.Traits() {
+		t.Errorf("expected traits with hooks to differ from traits without hooks")
+	}
+}
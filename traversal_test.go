@@ -2,6 +2,8 @@ package graph
 
 import (
 	"log"
+	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -413,3 +415,251 @@ func TestUndirectedBFS(t *testing.T) {
 		}
 	}
 }
+
+func TestReverseBFS(t *testing.T) {
+	tests := map[string]struct {
+		isDirected     bool
+		edges          []Edge[int]
+		start          int
+		stopAtVertex   int
+		expectedVisits []int
+		shouldFail     bool
+	}{
+		"directed graph": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 4, Target: 3},
+				{Source: 3, Target: 5},
+			},
+			start:          3,
+			stopAtVertex:   -1,
+			expectedVisits: []int{3, 2, 4, 1},
+		},
+		"directed graph until vertex 2": {
+			isDirected: true,
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			start:          3,
+			stopAtVertex:   2,
+			expectedVisits: []int{3, 2},
+		},
+		"undirected graph": {
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			start:          3,
+			stopAtVertex:   -1,
+			expectedVisits: []int{3, 2, 1},
+		},
+		"unknown start vertex": {
+			isDirected: true,
+			start:      6,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		for _, wrap := range []bool{false, true} {
+			var g Graph[int, int]
+
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			} else {
+				g = New(IntHash)
+			}
+
+			for _, vertex := range []int{1, 2, 3, 4, 5} {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target)
+			}
+
+			if wrap {
+				g = wrappedGraph[int, int]{g}
+			}
+
+			var visits []int
+
+			err := ReverseBFS(g, test.start, func(vertex int) bool {
+				visits = append(visits, vertex)
+				return vertex == test.stopAtVertex
+			})
+
+			if test.shouldFail != (err != nil) {
+				t.Fatalf("%s: expected error: %v, got %v", name, test.shouldFail, err)
+			}
+
+			if len(visits) != len(test.expectedVisits) {
+				t.Fatalf("%s: expected visits %v, got %v", name, test.expectedVisits, visits)
+			}
+
+			// Vertices at the same depth may be visited in any order.
+			if len(visits) > 0 && visits[0] != test.start {
+				t.Errorf("%s: expected start vertex to be visited first, got %v", name, visits)
+			}
+
+			sort.Ints(visits)
+			expected := append([]int{}, test.expectedVisits...)
+			sort.Ints(expected)
+
+			if !slicesAreEqual(visits, expected) {
+				t.Errorf("%s: expected visits %v, got %v", name, test.expectedVisits, visits)
+			}
+		}
+	}
+}
+
+func TestTraverser(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		start       int
+		expectedBFS [][]int
+		shouldFail  bool
+	}{
+		"directed graph": {
+			isDirected:  true,
+			start:       1,
+			expectedBFS: [][]int{{1}, {2, 3}},
+		},
+		"undirected graph": {
+			isDirected:  false,
+			start:       4,
+			expectedBFS: [][]int{{4}, {3}, {1, 2}},
+		},
+		"isolated vertex": {
+			isDirected:  true,
+			start:       5,
+			expectedBFS: [][]int{{5}},
+		},
+		"missing start vertex": {
+			isDirected: true,
+			start:      6,
+			shouldFail: true,
+		},
+	}
+
+	for name, test := range tests {
+		for graphName, g := range newNeighborsGraphs(t, test.isDirected) {
+			t.Run(name+", "+graphName, func(t *testing.T) {
+				traverser := NewTraverser(g)
+
+				// Each traversal has to work like the first one, even though
+				// the buffers are reused.
+				for i := 0; i < 2; i++ {
+					bfs := make([]int, 0)
+
+					err := traverser.BFS(test.start, func(hash int) bool {
+						bfs = append(bfs, hash)
+						return false
+					})
+
+					if test.shouldFail != (err != nil) {
+						t.Fatalf("expected error: %v, got %v", test.shouldFail, err)
+					}
+
+					if test.shouldFail {
+						return
+					}
+
+					// The vertices of each level may be visited in any order.
+					for _, level := range test.expectedBFS {
+						if len(bfs) < len(level) || !slicesAreEqual(bfs[:len(level)], level) {
+							t.Fatalf("expected BFS order %v, got %v", test.expectedBFS, bfs)
+						}
+						bfs = bfs[len(level):]
+					}
+
+					if len(bfs) != 0 {
+						t.Fatalf("expected BFS order %v, got additional vertices %v", test.expectedBFS, bfs)
+					}
+
+					expectedDFS := make([]int, 0)
+					_ = DFS(g, test.start, func(hash int) bool {
+						expectedDFS = append(expectedDFS, hash)
+						return false
+					})
+
+					dfs := make([]int, 0)
+					_ = traverser.DFS(test.start, func(hash int) bool {
+						dfs = append(dfs, hash)
+						return false
+					})
+
+					if !slicesAreEqual(dfs, expectedDFS) || dfs[0] != test.start {
+						t.Errorf("expected DFS to visit %v, got %v", expectedDFS, dfs)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestTraverser_stop(t *testing.T) {
+	g := New(IntHash, Directed())
+
+	for vertex := 1; vertex <= 5; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	for vertex := 1; vertex < 5; vertex++ {
+		_ = g.AddEdge(vertex, vertex+1)
+	}
+
+	traverser := NewTraverser(g)
+
+	for name, traverse := range map[string]func(int, func(int) bool) error{
+		"BFS": traverser.BFS,
+		"DFS": traverser.DFS,
+	} {
+		visited := make([]int, 0)
+
+		_ = traverse(1, func(hash int) bool {
+			visited = append(visited, hash)
+			return hash == 3
+		})
+
+		if !reflect.DeepEqual(visited, []int{1, 2, 3}) {
+			t.Errorf("%s: expected traversal to stop at 3, got %v", name, visited)
+		}
+	}
+}
+
+func TestTraverser_allocations(t *testing.T) {
+	g := New(IntHash)
+
+	for vertex := 0; vertex < 100; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	for vertex := 1; vertex < 100; vertex++ {
+		_ = g.AddEdge(vertex/2, vertex)
+	}
+
+	traverser := NewTraverser(g)
+	count := 0
+
+	visit := func(int) bool {
+		count++
+		return false
+	}
+
+	// The first traversal allocates the buffers, which are reused afterwards.
+	_ = traverser.BFS(0, visit)
+	_ = traverser.DFS(0, visit)
+
+	allocations := testing.AllocsPerRun(10, func() {
+		_ = traverser.BFS(0, visit)
+		_ = traverser.DFS(0, visit)
+	})
+
+	if allocations != 0 {
+		t.Errorf("expected no allocations, got %v", allocations)
+	}
+}
@@ -80,3 +80,217 @@ func spanningTree[K comparable, T any](g Graph[K, T], maximum bool) (Graph[K, T]
 
 	return mst, nil
 }
+
+// MinimumSpanningArborescence returns a minimum spanning arborescence of the
+// given directed graph rooted at the given vertex. An arborescence is the
+// directed analog of a spanning tree: It contains a path from the root to each
+// other vertex, and each vertex except for the root has exactly one ingoing
+// edge. A minimum spanning arborescence is an arborescence whose total edge
+// weight is as small as possible.
+//
+// The arborescence contains all vertices from the given graph as well as the
+// required edges. The original graph remains unchanged. If any vertex is not
+// reachable from the root, there is no spanning arborescence and an error that
+// wraps ErrTargetNotReachable is returned.
+//
+// MinimumSpanningArborescence uses the algorithm of Chu, Liu, and Edmonds,
+// which takes O(|V|*|E|) time. It can only be computed on directed graphs.
+func MinimumSpanningArborescence[K comparable, T any](g Graph[K, T], root K) (Graph[K, T], error) {
+	if !g.Traits().IsDirected {
+		return nil, errors.New("arborescences can only be determined for directed graphs")
+	}
+
+	if _, err := g.Vertex(root); err != nil {
+		return nil, fmt.Errorf("could not get vertex with hash %v: %w", root, err)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	arborescence := NewLike(g)
+
+	hashes := make([]K, 0, len(adjacencyMap))
+	indices := make(map[K]int, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		vertex, properties, err := g.VertexWithProperties(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get vertex %v: %w", hash, err)
+		}
+
+		if err := arborescence.AddVertex(vertex, copyVertexProperties(properties)); err != nil {
+			return nil, fmt.Errorf("failed to add vertex %v: %w", hash, err)
+		}
+
+		indices[hash] = len(hashes)
+		hashes = append(hashes, hash)
+	}
+
+	edges := make([]Edge[K], 0)
+	arcs := make([]arc, 0)
+
+	for source, adjacencies := range adjacencyMap {
+		for target, edge := range adjacencies {
+			edges = append(edges, edge)
+			arcs = append(arcs, arc{
+				source: indices[source],
+				target: indices[target],
+				weight: edge.Properties.Weight,
+				origin: len(arcs),
+			})
+		}
+	}
+
+	selected, ok := minimumArborescence(len(hashes), indices[root], arcs)
+	if !ok {
+		return nil, fmt.Errorf("not all vertices are reachable from root %v: %w", root, ErrTargetNotReachable)
+	}
+
+	for _, i := range selected {
+		edge := edges[arcs[i].origin]
+		if err := arborescence.AddEdge(copyEdge(edge)); err != nil {
+			return nil, fmt.Errorf("failed to add edge (%v, %v): %w", edge.Source, edge.Target, err)
+		}
+	}
+
+	return arborescence, nil
+}
+
+// arc is an edge between two vertices identified by their indices. origin is
+// the index of the arc that this arc has been derived from.
+type arc struct {
+	source int
+	target int
+	weight int
+	origin int
+}
+
+// minimumArborescence computes the minimum arborescence of a graph with n
+// vertices and the given arcs rooted at root. It returns the indices of the
+// selected arcs, or false if not all vertices are reachable from the root.
+//
+// Each vertex first selects its cheapest ingoing arc. If these arcs form
+// cycles, each cycle is contracted into a single vertex and the minimum
+// arborescence of the contracted graph is computed recursively. The weight of
+// each arc entering a cycle is reduced by the weight of the cycle's arc that
+// it would replace. Expanding a cycle then yields all of its arcs except for
+// the one replaced by the selected arc entering the cycle.
+func minimumArborescence(n, root int, arcs []arc) ([]int, bool) {
+	cheapest := make([]int, n)
+	for i := range cheapest {
+		cheapest[i] = -1
+	}
+
+	for i, a := range arcs {
+		if a.source == a.target || a.target == root {
+			continue
+		}
+		if cheapest[a.target] < 0 || a.weight < arcs[cheapest[a.target]].weight {
+			cheapest[a.target] = i
+		}
+	}
+
+	for vertex, i := range cheapest {
+		if vertex != root && i < 0 {
+			return nil, false
+		}
+	}
+
+	components := make([]int, n)
+	visitedBy := make([]int, n)
+	isInCycle := make([]bool, n)
+
+	for i := range components {
+		components[i] = -1
+		visitedBy[i] = -1
+	}
+
+	count := 0
+
+	for start := range cheapest {
+		vertex := start
+
+		for vertex != root && visitedBy[vertex] < 0 {
+			visitedBy[vertex] = start
+			vertex = arcs[cheapest[vertex]].source
+		}
+
+		if vertex == root || visitedBy[vertex] != start {
+			continue
+		}
+
+		// The walk that started at start ran into itself, so vertex is part
+		// of a new cycle.
+		for !isInCycle[vertex] {
+			isInCycle[vertex] = true
+			components[vertex] = count
+			vertex = arcs[cheapest[vertex]].source
+		}
+		count++
+	}
+
+	if count == 0 {
+		selected := make([]int, 0, n)
+		for vertex, i := range cheapest {
+			if vertex != root {
+				selected = append(selected, i)
+			}
+		}
+		return selected, true
+	}
+
+	for vertex := range components {
+		if components[vertex] < 0 {
+			components[vertex] = count
+			count++
+		}
+	}
+
+	contracted := make([]arc, 0, len(arcs))
+
+	for i, a := range arcs {
+		source, target := components[a.source], components[a.target]
+		if source == target {
+			continue
+		}
+
+		weight := a.weight
+		if isInCycle[a.target] {
+			weight -= arcs[cheapest[a.target]].weight
+		}
+
+		contracted = append(contracted, arc{
+			source: source,
+			target: target,
+			weight: weight,
+			origin: i,
+		})
+	}
+
+	selectedContracted, ok := minimumArborescence(count, components[root], contracted)
+	if !ok {
+		return nil, false
+	}
+
+	selected := make([]int, 0, n)
+	entries := make(map[int]int)
+
+	for _, i := range selectedContracted {
+		original := contracted[i].origin
+		selected = append(selected, original)
+
+		if target := arcs[original].target; isInCycle[target] {
+			entries[components[target]] = target
+		}
+	}
+
+	for vertex, i := range cheapest {
+		if isInCycle[vertex] && entries[components[vertex]] != vertex {
+			selected = append(selected, i)
+		}
+	}
+
+	return selected, true
+}
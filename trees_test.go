@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"errors"
+	"math/rand"
 	"testing"
 )
 
@@ -213,3 +215,187 @@ func TestUndirectedMaximumSpanningTree(t *testing.T) {
 		})
 	}
 }
+
+func TestMinimumSpanningArborescence(t *testing.T) {
+	tests := map[string]struct {
+		vertices       []int
+		edges          []Edge[int]
+		root           int
+		expectedEdges  []Edge[int]
+		expectedWeight int
+		expectedErr    error
+	}{
+		"tree": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 1}},
+			},
+			root:           1,
+			expectedEdges:  []Edge[int]{{Source: 1, Target: 2}, {Source: 2, Target: 3}},
+			expectedWeight: 4,
+		},
+		"cycle that has to be contracted": {
+			vertices: []int{0, 1, 2},
+			edges: []Edge[int]{
+				{Source: 0, Target: 1, Properties: EdgeProperties{Weight: 5}},
+				{Source: 0, Target: 2, Properties: EdgeProperties{Weight: 10}},
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 1}},
+			},
+			root:           0,
+			expectedEdges:  []Edge[int]{{Source: 0, Target: 1}, {Source: 1, Target: 2}},
+			expectedWeight: 6,
+		},
+		"unreachable vertex": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			root:        1,
+			expectedErr: ErrTargetNotReachable,
+		},
+		"missing root": {
+			vertices:    []int{1},
+			root:        2,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Directed(), Weighted())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			arborescence, err := MinimumSpanningArborescence(g, test.root)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			edges, _ := arborescence.Edges()
+
+			if len(edges) != len(test.expectedEdges) {
+				t.Fatalf("expected edges %v, got %v", test.expectedEdges, edges)
+			}
+
+			for _, expected := range test.expectedEdges {
+				if _, err := arborescence.Edge(expected.Source, expected.Target); err != nil {
+					t.Errorf("expected edge (%d, %d): %v", expected.Source, expected.Target, err)
+				}
+			}
+
+			if weight := totalWeight(edges); weight != test.expectedWeight {
+				t.Errorf("expected total weight %d, got %d", test.expectedWeight, weight)
+			}
+
+			if order, _ := arborescence.Order(); order != len(test.vertices) {
+				t.Errorf("expected %d vertices, got %d", len(test.vertices), order)
+			}
+		})
+	}
+}
+
+func TestMinimumSpanningArborescence_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	n := 6
+
+	for i := 0; i < 20; i++ {
+		g := New(IntHash, Directed(), Weighted())
+
+		for vertex := 0; vertex < n; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for j := 0; j < 18; j++ {
+			_ = g.AddEdge(random.Intn(n), random.Intn(n), EdgeWeight(random.Intn(10)))
+		}
+
+		predecessorMap, _ := g.PredecessorMap()
+
+		// Try all combinations of ingoing edges for the vertices other than
+		// the root and keep the cheapest combination that forms a tree.
+		expectedWeight := -1
+		parents := make([]int, n)
+
+		var search func(vertex, weight int)
+
+		search = func(vertex, weight int) {
+			if vertex == n {
+				for start := 1; start < n; start++ {
+					current, steps := start, 0
+					for current != 0 && steps < n {
+						current = parents[current]
+						steps++
+					}
+					if current != 0 {
+						return
+					}
+				}
+				if expectedWeight < 0 || weight < expectedWeight {
+					expectedWeight = weight
+				}
+				return
+			}
+
+			for parent, edge := range predecessorMap[vertex] {
+				if parent == vertex {
+					continue
+				}
+				parents[vertex] = parent
+				search(vertex+1, weight+edge.Properties.Weight)
+			}
+		}
+
+		search(1, 0)
+
+		arborescence, err := MinimumSpanningArborescence(g, 0)
+
+		if expectedWeight < 0 {
+			if !errors.Is(err, ErrTargetNotReachable) {
+				t.Fatalf("expected error %v, got %v", ErrTargetNotReachable, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		edges, _ := arborescence.Edges()
+
+		if len(edges) != n-1 {
+			t.Fatalf("expected %d edges, got %v", n-1, edges)
+		}
+
+		if weight := totalWeight(edges); weight != expectedWeight {
+			t.Fatalf("expected total weight %d, got %d", expectedWeight, weight)
+		}
+
+		for vertex := 1; vertex < n; vertex++ {
+			if ok, _ := HasPath(arborescence, 0, vertex); !ok {
+				t.Fatalf("expected vertex %d to be reachable from the root", vertex)
+			}
+		}
+	}
+}
+
+func totalWeight(edges []Edge[int]) int {
+	weight := 0
+	for _, edge := range edges {
+		weight += edge.Properties.Weight
+	}
+	return weight
+}
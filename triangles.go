@@ -0,0 +1,166 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// TriangleCount returns the number of triangles in the given graph. A triangle
+// consists of three vertices that are pairwise adjacent. The direction of the
+// edges is ignored, so in a directed graph, the edges (A,B) and (B,A) connect
+// the same pair of vertices. Self-loops are ignored as well.
+//
+// The triangles are counted by ordering the vertices by their degree and only
+// intersecting the adjacencies of each vertex with those of its higher-ordered
+// neighbors. This takes O(|E|^1.5) time, which is considerably faster than
+// checking all pairs of neighbors for graphs with high-degree vertices.
+func TriangleCount[K comparable, T any](g Graph[K, T]) (int, error) {
+	_, _, total, err := countTriangles(g)
+	return total, err
+}
+
+// Triangles returns the number of triangles each vertex of the given graph is
+// part of. Triangles are defined and counted the same way as in
+// [TriangleCount]. The returned map contains all vertices, including those
+// that aren't part of any triangle.
+//
+// Because each triangle consists of three vertices, the sum of all values in
+// the returned map equals three times the number of triangles.
+func Triangles[K comparable, T any](g Graph[K, T]) (map[K]int, error) {
+	hashes, counts, _, err := countTriangles(g)
+	if err != nil {
+		return nil, err
+	}
+
+	triangles := make(map[K]int, len(hashes))
+
+	for i, hash := range hashes {
+		triangles[hash] = counts[i]
+	}
+
+	return triangles, nil
+}
+
+// countTriangles counts the triangles in g. It returns the vertex hashes, the
+// number of triangles for the vertex with the same index, and the total number
+// of triangles.
+func countTriangles[K comparable, T any](g Graph[K, T]) ([]K, []int, int, error) {
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	hashes, neighbors := undirectedNeighbors(adjacencyMap)
+
+	order := make([]int, len(hashes))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.Slice(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if len(neighbors[a]) != len(neighbors[b]) {
+			return len(neighbors[a]) < len(neighbors[b])
+		}
+		return a < b
+	})
+
+	ranks := make([]int, len(hashes))
+	for rank, vertex := range order {
+		ranks[vertex] = rank
+	}
+
+	// Each edge is only kept in the adjacencies of its lower-ranked vertex, so
+	// that each triangle is found exactly once. The adjacencies are stored as
+	// ranks in ascending order, which allows intersecting them in linear time.
+	forward := make([][]int, len(hashes))
+
+	for vertex, adjacencies := range neighbors {
+		for _, adjacency := range adjacencies {
+			if ranks[adjacency] > ranks[vertex] {
+				forward[ranks[vertex]] = append(forward[ranks[vertex]], ranks[adjacency])
+			}
+		}
+	}
+
+	for i := range forward {
+		sort.Ints(forward[i])
+	}
+
+	counts := make([]int, len(hashes))
+	total := 0
+
+	for u, adjacencies := range forward {
+		for _, v := range adjacencies {
+			i, j := 0, 0
+
+			for i < len(adjacencies) && j < len(forward[v]) {
+				switch {
+				case adjacencies[i] < forward[v][j]:
+					i++
+				case adjacencies[i] > forward[v][j]:
+					j++
+				default:
+					counts[order[u]]++
+					counts[order[v]]++
+					counts[order[adjacencies[i]]]++
+					total++
+					i++
+					j++
+				}
+			}
+		}
+	}
+
+	return hashes, counts, total, nil
+}
+
+// undirectedNeighbors assigns an index to each vertex of the given adjacency
+// map and returns the vertex hashes along with the indices of the neighbors of
+// each vertex in ascending order. The direction of the edges is ignored, and
+// self-loops are omitted.
+func undirectedNeighbors[K comparable](adjacencyMap map[K]map[K]Edge[K]) ([]K, [][]int) {
+	hashes := make([]K, 0, len(adjacencyMap))
+	indices := make(map[K]int, len(adjacencyMap))
+
+	for hash := range adjacencyMap {
+		indices[hash] = len(hashes)
+		hashes = append(hashes, hash)
+	}
+
+	// Collect the neighbors of each vertex regardless of the edge direction.
+	// Mutual edges in directed graphs yield duplicates, which are removed.
+	neighbors := make([][]int, len(hashes))
+
+	for source, adjacencies := range adjacencyMap {
+		for target := range adjacencies {
+			if source == target {
+				continue
+			}
+			s, t := indices[source], indices[target]
+			neighbors[s] = append(neighbors[s], t)
+			neighbors[t] = append(neighbors[t], s)
+		}
+	}
+
+	for i := range neighbors {
+		neighbors[i] = sortedUnique(neighbors[i])
+	}
+
+	return hashes, neighbors
+}
+
+// sortedUnique sorts the given integers in place and removes all duplicates.
+func sortedUnique(values []int) []int {
+	sort.Ints(values)
+
+	unique := values[:0]
+
+	for _, value := range values {
+		if len(unique) == 0 || value != unique[len(unique)-1] {
+			unique = append(unique, value)
+		}
+	}
+
+	return unique
+}
@@ -0,0 +1,154 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTriangles(t *testing.T) {
+	tests := map[string]struct {
+		isDirected        bool
+		vertices          []int
+		edges             []Edge[int]
+		expectedTriangles map[int]int
+		expectedCount     int
+	}{
+		"complete graph with 4 vertices": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 1, Target: 3},
+				{Source: 1, Target: 4},
+				{Source: 2, Target: 3},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+			},
+			expectedTriangles: map[int]int{1: 3, 2: 3, 3: 3, 4: 3},
+			expectedCount:     4,
+		},
+		"two triangles sharing an edge": {
+			vertices: []int{1, 2, 3, 4, 5},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 2, Target: 4},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 4},
+			},
+			expectedTriangles: map[int]int{1: 1, 2: 2, 3: 2, 4: 1, 5: 0},
+			expectedCount:     2,
+		},
+		"directed graph with mutual edges": {
+			isDirected: true,
+			vertices:   []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+			expectedTriangles: map[int]int{1: 1, 2: 1, 3: 1},
+			expectedCount:     1,
+		},
+		"graph without triangles": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			expectedTriangles: map[int]int{1: 0, 2: 0, 3: 0, 4: 0},
+			expectedCount:     0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+			if test.isDirected {
+				g = New(IntHash, Directed())
+			}
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(edge.Source, edge.Target)
+			}
+
+			triangles, err := Triangles(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(triangles) != len(test.expectedTriangles) {
+				t.Fatalf("expected triangles %v, got %v", test.expectedTriangles, triangles)
+			}
+
+			for vertex, expected := range test.expectedTriangles {
+				if triangles[vertex] != expected {
+					t.Errorf("expected %d triangles for vertex %d, got %d", expected, vertex, triangles[vertex])
+				}
+			}
+
+			count, err := TriangleCount(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if count != test.expectedCount {
+				t.Errorf("expected %d triangles, got %d", test.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestTriangles_random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	g := New(IntHash)
+
+	for vertex := 0; vertex < 40; vertex++ {
+		_ = g.AddVertex(vertex)
+	}
+
+	for i := 0; i < 300; i++ {
+		_ = g.AddEdge(random.Intn(40), random.Intn(40))
+	}
+
+	adjacencyMap, _ := g.AdjacencyMap()
+
+	expected := make(map[int]int)
+	expectedCount := 0
+
+	for a := 0; a < 40; a++ {
+		for b := a + 1; b < 40; b++ {
+			for c := b + 1; c < 40; c++ {
+				_, ab := adjacencyMap[a][b]
+				_, bc := adjacencyMap[b][c]
+				_, ac := adjacencyMap[a][c]
+
+				if ab && bc && ac {
+					expected[a]++
+					expected[b]++
+					expected[c]++
+					expectedCount++
+				}
+			}
+		}
+	}
+
+	triangles, _ := Triangles(g)
+
+	for vertex := 0; vertex < 40; vertex++ {
+		if triangles[vertex] != expected[vertex] {
+			t.Errorf("expected %d triangles for vertex %d, got %d", expected[vertex], vertex, triangles[vertex])
+		}
+	}
+
+	if count, _ := TriangleCount(g); count != expectedCount {
+		t.Errorf("expected %d triangles, got %d", expectedCount, count)
+	}
+}
@@ -24,8 +24,10 @@ func (u *undirected[K, T]) Traits() *Traits {
 }
 
 func (u *undirected[K, T]) AddVertex(value T, options ...func(*VertexProperties)) error {
-	hash := u.hash(value)
+	return u.AddVertexWithHash(u.hash(value), value, options...)
+}
 
+func (u *undirected[K, T]) AddVertexWithHash(hash K, value T, options ...func(*VertexProperties)) error {
 	prop := VertexProperties{
 		Weight:     0,
 		Attributes: make(map[string]string),
@@ -35,7 +37,30 @@ func (u *undirected[K, T]) AddVertex(value T, options ...func(*VertexProperties)
 		option(&prop)
 	}
 
-	return u.store.AddVertex(hash, value, prop)
+	if err := u.store.AddVertex(hash, value, prop); err != nil {
+		return err
+	}
+
+	vertexAdded(u.traits, hash, value)
+
+	return nil
+}
+
+func (u *undirected[K, T]) AddVertices(values []T, options ...func(*VertexProperties)) error {
+	hashes, properties, err := newVertices(u.store, u.hash, values, options)
+	if err != nil {
+		return err
+	}
+
+	if err := addVertices(u.store, hashes, values, properties); err != nil {
+		return err
+	}
+
+	for i, hash := range hashes {
+		vertexAdded(u.traits, hash, values[i])
+	}
+
+	return nil
 }
 
 func (u *undirected[K, T]) Vertex(hash K) (T, error) {
@@ -53,10 +78,33 @@ func (u *undirected[K, T]) VertexWithProperties(hash K) (T, VertexProperties, er
 }
 
 func (u *undirected[K, T]) RemoveVertex(hash K) error {
-	return u.store.RemoveVertex(hash)
+	if err := u.store.RemoveVertex(hash); err != nil {
+		return err
+	}
+
+	vertexRemoved(u.traits, hash)
+
+	return nil
 }
 
 func (u *undirected[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*EdgeProperties)) error {
+	var created autoVertices[K, T]
+
+	if err := u.insertEdge(&created, sourceHash, targetHash, options); err != nil {
+		created.rollback(u.store)
+		return err
+	}
+
+	return nil
+}
+
+// insertEdge adds an edge along with the vertices created using AutoVertices,
+// which are recorded in created.
+func (u *undirected[K, T]) insertEdge(created *autoVertices[K, T], sourceHash, targetHash K, options []func(*EdgeProperties)) error {
+	if err := addAutoVertices(u.store, u.hash, u.traits, created, sourceHash, targetHash); err != nil {
+		return err
+	}
+
 	if _, _, err := u.store.Vertex(sourceHash); err != nil {
 		return fmt.Errorf("could not find source vertex with hash %v: %w", sourceHash, err)
 	}
@@ -67,7 +115,7 @@ func (u *undirected[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*Ed
 
 	//nolint:govet // False positive.
 	if _, err := u.Edge(sourceHash, targetHash); !errors.Is(err, ErrEdgeNotFound) {
-		return ErrEdgeAlreadyExists
+		return addDuplicateEdge[K, T](u, u.store, u.traits, sourceHash, targetHash, options)
 	}
 
 	// If the user opted in to preventing cycles, run a cycle check.
@@ -97,6 +145,64 @@ func (u *undirected[K, T]) AddEdge(sourceHash, targetHash K, options ...func(*Ed
 		return fmt.Errorf("failed to add edge: %w", err)
 	}
 
+	created.commit(u.traits)
+	edgeAdded(u.traits, edge)
+
+	return nil
+}
+
+func (u *undirected[K, T]) AddEdges(edges []Edge[K]) error {
+	if u.traits.PreventCycles || hasDuplicateEdgePolicy[K](u.traits) {
+		for _, edge := range edges {
+			if err := u.AddEdge(copyEdge(edge)); err != nil {
+				return fmt.Errorf("failed to add (%v, %v): %w", edge.Source, edge.Target, err)
+			}
+		}
+		return nil
+	}
+
+	// Just as addEdge does for a single edge, each edge is stored twice: Once
+	// as (A,B) and once as (B,A).
+	newEdges := make([]Edge[K], 0, 2*len(edges))
+	added := make(map[EdgeKey[K]]struct{}, 2*len(edges))
+
+	var created autoVertices[K, T]
+
+	for _, edge := range edges {
+		if err := addAutoVertices(u.store, u.hash, u.traits, &created, edge.Source, edge.Target); err != nil {
+			created.rollback(u.store)
+			return err
+		}
+
+		if err := checkNewEdge(u.store, edge, added); err != nil {
+			created.rollback(u.store)
+			return err
+		}
+
+		newEdge := newEdgeFrom(edge)
+
+		reversedEdge := newEdge
+		reversedEdge.Source = newEdge.Target
+		reversedEdge.Target = newEdge.Source
+
+		added[EdgeKey[K]{Source: newEdge.Source, Target: newEdge.Target}] = struct{}{}
+		added[EdgeKey[K]{Source: reversedEdge.Source, Target: reversedEdge.Target}] = struct{}{}
+
+		newEdges = append(newEdges, newEdge, reversedEdge)
+	}
+
+	if err := addEdges(u.store, newEdges); err != nil {
+		created.rollback(u.store)
+		return err
+	}
+
+	created.commit(u.traits)
+
+	// Only report the first edge of each (A,B) and (B,A) pair.
+	for i := 0; i < len(newEdges); i += 2 {
+		edgeAdded(u.traits, newEdges[i])
+	}
+
 	return nil
 }
 
@@ -115,6 +221,29 @@ func (u *undirected[K, T]) AddEdgesFrom(g Graph[K, T]) error {
 	return nil
 }
 
+func (u *undirected[K, T]) UpsertVertex(value T, options ...func(*VertexProperties)) error {
+	hash := u.hash(value)
+	properties := VertexProperties{
+		Weight:     0,
+		Attributes: make(map[string]string),
+	}
+
+	for _, option := range options {
+		option(&properties)
+	}
+
+	added, err := upsertVertex(u.store, hash, value, properties)
+	if err != nil {
+		return err
+	}
+
+	if added {
+		vertexAdded(u.traits, hash, value)
+	}
+
+	return nil
+}
+
 func (u *undirected[K, T]) AddVerticesFrom(g Graph[K, T]) error {
 	adjacencyMap, err := g.AdjacencyMap()
 	if err != nil {
@@ -136,36 +265,34 @@ func (u *undirected[K, T]) AddVerticesFrom(g Graph[K, T]) error {
 }
 
 func (u *undirected[K, T]) Edge(sourceHash, targetHash K) (Edge[T], error) {
+	store := readStore(u.store, u.traits)
+
 	// In an undirected graph, since multigraphs aren't supported, the edge AB
 	// is the same as BA. Therefore, if source[target] cannot be found, this
 	// function also looks for target[source].
-	edge, err := u.store.Edge(sourceHash, targetHash)
+	edge, err := store.Edge(sourceHash, targetHash)
 	if errors.Is(err, ErrEdgeNotFound) {
-		edge, err = u.store.Edge(targetHash, sourceHash)
+		edge, err = store.Edge(targetHash, sourceHash)
 	}
 
 	if err != nil {
 		return Edge[T]{}, err
 	}
 
-	sourceVertex, _, err := u.store.Vertex(sourceHash)
+	sourceVertex, _, err := store.Vertex(sourceHash)
 	if err != nil {
 		return Edge[T]{}, err
 	}
 
-	targetVertex, _, err := u.store.Vertex(targetHash)
+	targetVertex, _, err := store.Vertex(targetHash)
 	if err != nil {
 		return Edge[T]{}, err
 	}
 
 	return Edge[T]{
-		Source: sourceVertex,
-		Target: targetVertex,
-		Properties: EdgeProperties{
-			Weight:     edge.Properties.Weight,
-			Attributes: edge.Properties.Attributes,
-			Data:       edge.Properties.Data,
-		},
+		Source:     sourceVertex,
+		Target:     targetVertex,
+		Properties: edge.Properties,
 	}, nil
 }
 
@@ -174,7 +301,9 @@ type tuple[K comparable] struct {
 }
 
 func (u *undirected[K, T]) Edges() ([]Edge[K], error) {
-	storedEdges, err := u.store.ListEdges()
+	store := readStore(u.store, u.traits)
+
+	storedEdges, err := store.ListEdges()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get edges: %w", err)
 	}
@@ -234,7 +363,51 @@ func (u *undirected[K, T]) UpdateEdge(source, target K, options ...func(properti
 	reversedEdge.Source = existingEdge.Target
 	reversedEdge.Target = existingEdge.Source
 
-	return u.store.UpdateEdge(target, source, reversedEdge)
+	if err := u.store.UpdateEdge(target, source, reversedEdge); err != nil {
+		return err
+	}
+
+	edgesUpdated(u.traits, []Edge[K]{existingEdge})
+
+	return nil
+}
+
+func (u *undirected[K, T]) UpdateWeights(weights map[EdgeKey[K]]int) error {
+	edges := make([]Edge[K], 0, 2*len(weights))
+	previous := make([]Edge[K], 0, 2*len(weights))
+
+	for key, weight := range weights {
+		edge, err := u.store.Edge(key.Source, key.Target)
+		if err != nil {
+			return fmt.Errorf("failed to get edge (%v, %v): %w", key.Source, key.Target, err)
+		}
+
+		reversedEdge, err := u.store.Edge(key.Target, key.Source)
+		if err != nil {
+			return fmt.Errorf("failed to get edge (%v, %v): %w", key.Target, key.Source, err)
+		}
+
+		previous = append(previous, edge, reversedEdge)
+
+		edge.Properties.Weight = weight
+		reversedEdge.Properties.Weight = weight
+
+		edges = append(edges, edge, reversedEdge)
+	}
+
+	if err := updateEdges(u.store, edges, previous); err != nil {
+		return err
+	}
+
+	// Only report the first edge of each (A,B) and (B,A) pair.
+	updated := make([]Edge[K], 0, len(weights))
+	for i := 0; i < len(edges); i += 2 {
+		updated = append(updated, edges[i])
+	}
+
+	edgesUpdated(u.traits, updated)
+
+	return nil
 }
 
 func (u *undirected[K, T]) RemoveEdge(source, target K) error {
@@ -242,6 +415,14 @@ func (u *undirected[K, T]) RemoveEdge(source, target K) error {
 		return err
 	}
 
+	edge, err := u.store.Edge(source, target)
+	if errors.Is(err, ErrEdgeNotFound) {
+		edge, err = u.store.Edge(target, source)
+	}
+	if err != nil {
+		return err
+	}
+
 	if err := u.store.RemoveEdge(source, target); err != nil {
 		return fmt.Errorf("failed to remove edge from %v to %v: %w", source, target, err)
 	}
@@ -250,16 +431,20 @@ func (u *undirected[K, T]) RemoveEdge(source, target K) error {
 		return fmt.Errorf("failed to remove edge from %v to %v: %w", target, source, err)
 	}
 
+	edgeRemoved(u.traits, edge)
+
 	return nil
 }
 
 func (u *undirected[K, T]) AdjacencyMap() (map[K]map[K]Edge[K], error) {
-	vertices, err := u.store.ListVertices()
+	store := readStore(u.store, u.traits)
+
+	vertices, err := store.ListVertices()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list vertices: %w", err)
 	}
 
-	edges, err := u.store.ListEdges()
+	edges, err := store.ListEdges()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list edges: %w", err)
 	}
@@ -283,10 +468,11 @@ func (u *undirected[K, T]) PredecessorMap() (map[K]map[K]Edge[K], error) {
 
 func (u *undirected[K, T]) Clone() (Graph[K, T], error) {
 	traits := &Traits{
-		IsDirected: u.traits.IsDirected,
-		IsAcyclic:  u.traits.IsAcyclic,
-		IsWeighted: u.traits.IsWeighted,
-		IsRooted:   u.traits.IsRooted,
+		IsDirected:    u.traits.IsDirected,
+		IsAcyclic:     u.traits.IsAcyclic,
+		IsWeighted:    u.traits.IsWeighted,
+		IsRooted:      u.traits.IsRooted,
+		keepFirstEdge: u.traits.keepFirstEdge,
 	}
 
 	clone := &undirected[K, T]{
@@ -351,13 +537,9 @@ func (u *undirected[K, T]) addEdge(sourceHash, targetHash K, edge Edge[K]) error
 	}
 
 	rEdge := Edge[K]{
-		Source: edge.Target,
-		Target: edge.Source,
-		Properties: EdgeProperties{
-			Weight:     edge.Properties.Weight,
-			Attributes: edge.Properties.Attributes,
-			Data:       edge.Properties.Data,
-		},
+		Source:     edge.Target,
+		Target:     edge.Source,
+		Properties: edge.Properties,
 	}
 
 	err = u.store.AddEdge(targetHash, sourceHash, rEdge)
@@ -212,6 +212,287 @@ func TestUndirected_AddVerticesFrom(t *testing.T) {
 	}
 }
 
+func TestUndirected_AddVertices(t *testing.T) {
+	tests := map[string]struct {
+		existing      []int
+		vertices      []int
+		options       []func(*VertexProperties)
+		expectedOrder int
+		expectedErr   error
+	}{
+		"empty graph": {
+			vertices:      []int{1, 2, 3},
+			options:       []func(*VertexProperties){VertexWeight(2)},
+			expectedOrder: 3,
+		},
+		"graph with existing vertices": {
+			existing:      []int{1, 2},
+			vertices:      []int{3, 4, 5},
+			expectedOrder: 5,
+		},
+		"vertex already exists": {
+			existing:      []int{1, 2},
+			vertices:      []int{3, 2},
+			expectedOrder: 2,
+			expectedErr:   ErrVertexAlreadyExists,
+		},
+		"duplicate vertices": {
+			vertices:      []int{1, 2, 1},
+			expectedOrder: 0,
+			expectedErr:   ErrVertexAlreadyExists,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+
+			for _, vertex := range test.existing {
+				_ = g.AddVertex(vertex)
+			}
+
+			err := g.AddVertices(test.vertices, test.options...)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			order, _ := g.Order()
+			if order != test.expectedOrder {
+				t.Errorf("expected order %v, got %v", test.expectedOrder, order)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			expectedProperties := VertexProperties{Attributes: map[string]string{}}
+			for _, option := range test.options {
+				option(&expectedProperties)
+			}
+
+			for _, vertex := range test.vertices {
+				_, properties, err := g.VertexWithProperties(vertex)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !vertexPropertiesAreEqual(expectedProperties, properties) {
+					t.Errorf("expected properties %v, got %v", expectedProperties, properties)
+				}
+			}
+		})
+	}
+}
+
+func TestUndirected_AddVertexWithHash(t *testing.T) {
+	calls := 0
+	hash := func(v string) string {
+		calls++
+		return v
+	}
+
+	g := New(hash)
+
+	if err := g.AddVertexWithHash("A", "A", VertexWeight(3)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := g.AddVertexWithHash("B", "B"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := g.AddEdge("A", "B"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls != 0 {
+		t.Errorf("expected hash function not to be called, got %d calls", calls)
+	}
+
+	_, properties, err := g.VertexWithProperties("A")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if properties.Weight != 3 {
+		t.Errorf("expected weight 3, got %d", properties.Weight)
+	}
+
+	if err := g.AddVertexWithHash("A", "A"); !errors.Is(err, ErrVertexAlreadyExists) {
+		t.Errorf("expected error %v, got %v", ErrVertexAlreadyExists, err)
+	}
+}
+
+func TestUndirected_UpsertVertex(t *testing.T) {
+	type city struct {
+		name       string
+		population int
+	}
+
+	cityHash := func(c city) string {
+		return c.name
+	}
+
+	tests := map[string]struct {
+		vertex             city
+		options            []func(*VertexProperties)
+		expectedOrder      int
+		expectedProperties VertexProperties
+	}{
+		"new vertex": {
+			vertex:             city{name: "Paris", population: 2_100_000},
+			options:            []func(*VertexProperties){VertexWeight(2)},
+			expectedOrder:      3,
+			expectedProperties: VertexProperties{Weight: 2, Attributes: map[string]string{}},
+		},
+		"existing vertex": {
+			vertex:             city{name: "London", population: 8_900_000},
+			options:            []func(*VertexProperties){VertexAttribute("country", "UK")},
+			expectedOrder:      2,
+			expectedProperties: VertexProperties{Attributes: map[string]string{"country": "UK"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			added := 0
+			g := New(cityHash, OnVertexAdded(func(string, city) { added++ }))
+
+			_ = g.AddVertex(city{name: "London", population: 8_800_000}, VertexWeight(5))
+			_ = g.AddVertex(city{name: "Berlin", population: 3_600_000})
+			_ = g.AddEdge("London", "Berlin")
+
+			added = 0
+
+			if err := g.UpsertVertex(test.vertex, test.options...); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if order, _ := g.Order(); order != test.expectedOrder {
+				t.Errorf("expected order %d, got %d", test.expectedOrder, order)
+			}
+
+			vertex, properties, err := g.VertexWithProperties(test.vertex.name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if vertex != test.vertex {
+				t.Errorf("expected vertex %v, got %v", test.vertex, vertex)
+			}
+
+			if !vertexPropertiesAreEqual(test.expectedProperties, properties) {
+				t.Errorf("expected properties %v, got %v", test.expectedProperties, properties)
+			}
+
+			if _, err := g.Edge("London", "Berlin"); err != nil {
+				t.Errorf("expected edge to be kept, got %v", err)
+			}
+
+			if expectedAdded := test.expectedOrder - 2; added != expectedAdded {
+				t.Errorf("expected %d invocations of the hook, got %d", expectedAdded, added)
+			}
+		})
+	}
+}
+
+func TestUndirected_AddEdges(t *testing.T) {
+	tests := map[string]struct {
+		vertices     []int
+		existing     []Edge[int]
+		edges        []Edge[int]
+		expectedSize int
+		expectedErr  error
+	}{
+		"edges with properties": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 4}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Attributes: map[string]string{"color": "red"}}},
+				{Source: 1, Target: 3},
+			},
+			expectedSize: 3,
+		},
+		"graph with existing edges": {
+			vertices: []int{1, 2, 3},
+			existing: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			edges: []Edge[int]{
+				{Source: 2, Target: 3},
+			},
+			expectedSize: 2,
+		},
+		"edge already exists": {
+			vertices: []int{1, 2, 3},
+			existing: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			edges: []Edge[int]{
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 2},
+			},
+			expectedSize: 1,
+			expectedErr:  ErrEdgeAlreadyExists,
+		},
+		"reversed duplicate edges": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+			},
+			expectedErr: ErrEdgeAlreadyExists,
+		},
+		"non-existent vertex": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			expectedSize: 0,
+			expectedErr:  ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.existing {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			err := g.AddEdges(test.edges)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			size, _ := g.Size()
+			if size != test.expectedSize {
+				t.Errorf("expected size %v, got %v", test.expectedSize, size)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			for _, expectedEdge := range test.edges {
+				edge, err := g.Edge(expectedEdge.Source, expectedEdge.Target)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				actualEdge := Edge[int]{Source: edge.Source, Target: edge.Target, Properties: edge.Properties}
+				if !edgesAreEqual(expectedEdge, actualEdge, false) {
+					t.Errorf("expected edge %v, got %v", expectedEdge, actualEdge)
+				}
+			}
+		})
+	}
+}
+
 func TestUndirected_Vertex(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []int
@@ -844,6 +1125,91 @@ func TestUndirected_UpdateEdge(t *testing.T) {
 	}
 }
 
+func TestUndirected_UpdateWeights(t *testing.T) {
+	tests := map[string]struct {
+		vertices      []int
+		edges         []Edge[int]
+		weights       map[EdgeKey[int]]int
+		expectedEdges []Edge[int]
+		expectedErr   error
+	}{
+		"update multiple edges": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 2}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 3}},
+			},
+			weights: map[EdgeKey[int]]int{
+				{Source: 1, Target: 2}: 10,
+				{Source: 2, Target: 3}: 20,
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 10}},
+				{Source: 2, Target: 3, Properties: EdgeProperties{Weight: 20}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 3}},
+			},
+		},
+		"swapped source and target": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			weights: map[EdgeKey[int]]int{
+				{Source: 2, Target: 1}: 5,
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 5}},
+			},
+		},
+		"non-existent edge leaves the graph unchanged": {
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			weights: map[EdgeKey[int]]int{
+				{Source: 1, Target: 2}: 10,
+				{Source: 2, Target: 3}: 20,
+			},
+			expectedEdges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 1}},
+			},
+			expectedErr: ErrEdgeNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, Weighted())
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			err := g.UpdateWeights(test.weights)
+
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			for _, expectedEdge := range test.expectedEdges {
+				actualEdge, err := g.Edge(expectedEdge.Source, expectedEdge.Target)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err.Error())
+				}
+
+				if actualEdge.Properties.Weight != expectedEdge.Properties.Weight {
+					t.Errorf("expected weight %v for edge (%v, %v), got %v", expectedEdge.Properties.Weight, expectedEdge.Source, expectedEdge.Target, actualEdge.Properties.Weight)
+				}
+			}
+		})
+	}
+}
+
 func TestUndirected_RemoveEdge(t *testing.T) {
 	tests := map[string]struct {
 		vertices      []int
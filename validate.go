@@ -0,0 +1,236 @@
+package graph
+
+import (
+	"fmt"
+)
+
+// Validate checks whether the given graph satisfies the structural invariants
+// implied by its traits. This is useful after a graph has been loaded from an
+// external source, for example using a custom [Store] implementation, where
+// the checks performed by AddVertex and AddEdge have been bypassed.
+//
+// Validate checks the following invariants:
+//
+//   - The source and target vertices of each edge exist.
+//   - For graphs that are acyclic or prevent cycles, there are no self-loops.
+//   - For graphs that are acyclic or prevent cycles, there are no cycles.
+//   - For undirected graphs, each edge is stored in both directions.
+//   - For weighted undirected graphs, both directions have the same weight.
+//
+// If any of the invariants is violated, Validate returns a [MultiError] that
+// contains an error for each violation. Errors for missing vertices or edges
+// wrap [ErrVertexNotFound] or [ErrEdgeNotFound], respectively. If the graph is
+// valid, Validate returns nil.
+func Validate[K comparable, T any](g Graph[K, T]) error {
+	vertices, edges, err := listVerticesAndEdges(g)
+	if err != nil {
+		return err
+	}
+
+	traits := g.Traits()
+	acyclic := traits.IsAcyclic || traits.PreventCycles
+
+	var errs []error
+
+	// validEdges contains all edges whose source and target vertices exist.
+	// Only these edges are considered in the following checks.
+	validEdges := make([]Edge[K], 0, len(edges))
+
+	for _, edge := range edges {
+		valid := true
+
+		if _, ok := vertices[edge.Source]; !ok {
+			errs = append(errs, fmt.Errorf("edge (%v, %v): source vertex %v: %w", edge.Source, edge.Target, edge.Source, ErrVertexNotFound))
+			valid = false
+		}
+
+		if _, ok := vertices[edge.Target]; !ok {
+			errs = append(errs, fmt.Errorf("edge (%v, %v): target vertex %v: %w", edge.Source, edge.Target, edge.Target, ErrVertexNotFound))
+			valid = false
+		}
+
+		if valid {
+			validEdges = append(validEdges, edge)
+		}
+	}
+
+	if acyclic {
+		for _, edge := range validEdges {
+			if edge.Source == edge.Target {
+				errs = append(errs, fmt.Errorf("edge (%v, %v) is a self-loop in an acyclic graph", edge.Source, edge.Target))
+			}
+		}
+	}
+
+	if !traits.IsDirected && storeOf(g) != nil {
+		errs = append(errs, validateSymmetry(validEdges, traits.IsWeighted)...)
+	}
+
+	if acyclic {
+		if traits.IsDirected {
+			errs = append(errs, validateDirectedAcyclicity(vertices, validEdges)...)
+		} else {
+			errs = append(errs, validateUndirectedAcyclicity(vertices, validEdges)...)
+		}
+	}
+
+	return multiError(errs)
+}
+
+// listVerticesAndEdges returns the vertex hashes and the edges of g. For graphs
+// created by this package, it reads the edges from the store directly, so that
+// inconsistencies like the missing reversed edge of an undirected edge can be
+// detected. Otherwise, it falls back to the methods of the Graph interface.
+func listVerticesAndEdges[K comparable, T any](g Graph[K, T]) (map[K]struct{}, []Edge[K], error) {
+	vertices := make(map[K]struct{})
+
+	if store := storeOf(g); store != nil {
+		hashes, err := store.ListVertices()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list vertices: %w", err)
+		}
+
+		for _, hash := range hashes {
+			vertices[hash] = struct{}{}
+		}
+
+		edges, err := store.ListEdges()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list edges: %w", err)
+		}
+
+		return vertices, edges, nil
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	for hash := range adjacencyMap {
+		vertices[hash] = struct{}{}
+	}
+
+	edges, err := g.Edges()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list edges: %w", err)
+	}
+
+	return vertices, edges, nil
+}
+
+// validateSymmetry checks whether each edge (A,B) of an undirected graph has a
+// reversed counterpart (B,A), and whether both have the same weight.
+func validateSymmetry[K comparable](edges []Edge[K], isWeighted bool) []error {
+	stored := make(map[tuple[K]]Edge[K], len(edges))
+
+	for _, edge := range edges {
+		stored[tuple[K]{source: edge.Source, target: edge.Target}] = edge
+	}
+
+	var errs []error
+
+	mismatches := make(map[tuple[K]]struct{})
+
+	for _, edge := range edges {
+		reversed, ok := stored[tuple[K]{source: edge.Target, target: edge.Source}]
+		if !ok {
+			errs = append(errs, fmt.Errorf("edge (%v, %v): reversed edge (%v, %v): %w", edge.Source, edge.Target, edge.Target, edge.Source, ErrEdgeNotFound))
+			continue
+		}
+
+		if !isWeighted || reversed.Properties.Weight == edge.Properties.Weight {
+			continue
+		}
+
+		// Each pair is visited twice, so only report the weight mismatch once.
+		if _, ok := mismatches[tuple[K]{source: edge.Target, target: edge.Source}]; ok {
+			continue
+		}
+		mismatches[tuple[K]{source: edge.Source, target: edge.Target}] = struct{}{}
+
+		errs = append(errs, fmt.Errorf("edge (%v, %v) has weight %d, but reversed edge has weight %d", edge.Source, edge.Target, edge.Properties.Weight, reversed.Properties.Weight))
+	}
+
+	return errs
+}
+
+// validateDirectedAcyclicity checks whether a directed graph contains cycles
+// by repeatedly removing vertices without ingoing edges. Any remaining vertices
+// are part of or reachable from a cycle. Self-loops are reported separately.
+func validateDirectedAcyclicity[K comparable](vertices map[K]struct{}, edges []Edge[K]) []error {
+	inDegrees := make(map[K]int, len(vertices))
+	successors := make(map[K][]K, len(vertices))
+
+	for _, edge := range edges {
+		if edge.Source == edge.Target {
+			continue
+		}
+		inDegrees[edge.Target]++
+		successors[edge.Source] = append(successors[edge.Source], edge.Target)
+	}
+
+	queue := make([]K, 0)
+
+	for vertex := range vertices {
+		if inDegrees[vertex] == 0 {
+			queue = append(queue, vertex)
+		}
+	}
+
+	removed := 0
+
+	for len(queue) > 0 {
+		vertex := queue[0]
+		queue = queue[1:]
+		removed++
+
+		for _, successor := range successors[vertex] {
+			inDegrees[successor]--
+			if inDegrees[successor] == 0 {
+				queue = append(queue, successor)
+			}
+		}
+	}
+
+	if removed == len(vertices) {
+		return nil
+	}
+
+	return []error{fmt.Errorf("graph is acyclic, but %d vertices are part of or reachable from a cycle", len(vertices)-removed)}
+}
+
+// validateUndirectedAcyclicity checks whether an undirected graph is a forest,
+// reporting each edge that connects two already connected vertices.
+func validateUndirectedAcyclicity[K comparable](vertices map[K]struct{}, edges []Edge[K]) []error {
+	hashes := make([]K, 0, len(vertices))
+	for vertex := range vertices {
+		hashes = append(hashes, vertex)
+	}
+
+	subtrees := newUnionFind(hashes...)
+	seen := make(map[tuple[K]]struct{}, len(edges))
+
+	var errs []error
+
+	for _, edge := range edges {
+		if edge.Source == edge.Target {
+			continue
+		}
+
+		// Skip the second direction of the same undirected edge.
+		if _, ok := seen[tuple[K]{source: edge.Target, target: edge.Source}]; ok {
+			continue
+		}
+		seen[tuple[K]{source: edge.Source, target: edge.Target}] = struct{}{}
+
+		if subtrees.find(edge.Source) == subtrees.find(edge.Target) {
+			errs = append(errs, fmt.Errorf("edge (%v, %v) creates a cycle in an acyclic graph", edge.Source, edge.Target))
+			continue
+		}
+
+		subtrees.union(edge.Source, edge.Target)
+	}
+
+	return errs
+}
@@ -0,0 +1,171 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		options     []func(*Traits)
+		vertices    []int
+		edges       []Edge[int]
+		expectedErr error
+		errCount    int
+	}{
+		"valid directed graph": {
+			options:  []func(*Traits){Directed(), Acyclic()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 1, Target: 3},
+			},
+		},
+		"valid undirected graph": {
+			options:  []func(*Traits){Weighted()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 3}},
+			},
+		},
+		"dangling edge endpoints": {
+			options:  []func(*Traits){Directed()},
+			vertices: []int{1},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 3, Target: 4},
+			},
+			expectedErr: ErrVertexNotFound,
+			errCount:    3,
+		},
+		"self-loop in acyclic graph": {
+			options:  []func(*Traits){Directed(), Acyclic()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 2},
+			},
+			errCount: 1,
+		},
+		"self-loop in cyclic graph": {
+			options:  []func(*Traits){Directed()},
+			vertices: []int{1},
+			edges: []Edge[int]{
+				{Source: 1, Target: 1},
+			},
+		},
+		"cycle in directed acyclic graph": {
+			options:  []func(*Traits){Directed(), PreventCycles()},
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 1},
+				{Source: 3, Target: 4},
+			},
+			errCount: 1,
+		},
+		"cycle in undirected acyclic graph": {
+			options:  []func(*Traits){Acyclic()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 1},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 2},
+				{Source: 3, Target: 1},
+				{Source: 1, Target: 3},
+			},
+			errCount: 1,
+		},
+		"missing reversed edge": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+			},
+			expectedErr: ErrEdgeNotFound,
+			errCount:    1,
+		},
+		"weight mismatch": {
+			options:  []func(*Traits){Weighted()},
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 4}},
+			},
+			errCount: 1,
+		},
+		"weight mismatch in unweighted graph": {
+			vertices: []int{1, 2},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 3}},
+				{Source: 2, Target: 1, Properties: EdgeProperties{Weight: 4}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := newMemoryStore[int, int]()
+
+			for _, vertex := range test.vertices {
+				_ = store.AddVertex(vertex, vertex, VertexProperties{})
+			}
+
+			// Add the edges to the store directly, bypassing the checks of the
+			// graph's AddEdge method.
+			for _, edge := range test.edges {
+				_ = store.AddEdge(edge.Source, edge.Target, edge)
+			}
+
+			g := NewWithStore(IntHash, store, test.options...)
+
+			err := Validate(g)
+
+			if test.errCount == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			var multiErr *MultiError
+			if !errors.As(err, &multiErr) {
+				t.Fatalf("expected a MultiError, got %v", err)
+			}
+
+			if len(multiErr.Errors) != test.errCount {
+				t.Errorf("expected %d errors, got %d: %v", test.errCount, len(multiErr.Errors), err)
+			}
+
+			if test.expectedErr != nil && !errors.Is(err, test.expectedErr) {
+				t.Errorf("expected error %v, got %v", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_GraphBuiltWithAddEdge(t *testing.T) {
+	for _, options := range [][]func(*Traits){
+		{Directed(), Acyclic(), PreventCycles()},
+		{Weighted()},
+		{Acyclic(), PreventCycles()},
+	} {
+		g := New(IntHash, options...)
+
+		for i := 1; i <= 5; i++ {
+			_ = g.AddVertex(i)
+		}
+
+		_ = g.AddEdge(1, 2, EdgeWeight(2))
+		_ = g.AddEdge(2, 3, EdgeWeight(4))
+		_ = g.AddEdge(3, 4, EdgeWeight(1))
+		_ = g.AddEdge(1, 5, EdgeWeight(3))
+
+		if err := Validate(g); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	}
+}
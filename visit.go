@@ -0,0 +1,110 @@
+package graph
+
+import "fmt"
+
+// cursorStore is implemented by stores that allow to iterate over their data
+// without copying it, like the default in-memory store and the compact store.
+type cursorStore[K comparable] interface {
+	VisitVertices(visit func(hash K) bool) error
+	VisitOutEdges(hash K, visit func(edge Edge[K]) bool) error
+	VisitInEdges(hash K, visit func(edge Edge[K]) bool) error
+}
+
+// VisitVertices calls the visit function for each vertex hash in the graph
+// until it returns true. Unlike ListVertices or AdjacencyMap, it reads the
+// vertices directly from the graph's store without copying them, which makes
+// it suitable for high-performance algorithms on large graphs:
+//
+//	count := 0
+//	_ = graph.VisitVertices(g, func(hash int) bool {
+//		count++
+//		return false
+//	})
+//
+// The following guarantees apply for the default in-memory store:
+//
+//   - The vertices are visited in an unspecified order.
+//   - The graph is locked for reading while the vertices are visited. Thus, all
+//     vertices belong to a consistent snapshot, and concurrent modifications
+//     are blocked until VisitVertices returns.
+//   - Because of that lock, the visit function must not call any methods of
+//     the graph, because this may cause a deadlock.
+//
+// The compact store created by [NewCompactStore] never changes and therefore
+// doesn't require a lock, so the visit function may call methods of the graph.
+// For other stores, VisitVertices falls back to AdjacencyMap and visits the
+// vertices of the returned copy.
+func VisitVertices[K comparable, T any](g Graph[K, T], visit func(hash K) bool) error {
+	if cursor, ok := storeOf(g).(cursorStore[K]); ok {
+		return cursor.VisitVertices(visit)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("could not get adjacency map: %w", err)
+	}
+
+	for hash := range adjacencyMap {
+		if visit(hash) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// VisitAdjacencies calls the visit function for each outgoing edge of the
+// given vertex until it returns true. Like [VisitVertices], it reads the edges
+// directly from the graph's store without copying them, and the same
+// guarantees apply. In addition, the Attributes and Data of the visited edges
+// are shared with the store and must not be modified.
+//
+// If the vertex doesn't exist, an error wrapping ErrVertexNotFound is returned.
+// In an undirected graph, the source of each visited edge is the given vertex.
+func VisitAdjacencies[K comparable, T any](g Graph[K, T], hash K, visit func(edge Edge[K]) bool) error {
+	if cursor, ok := storeOf(g).(cursorStore[K]); ok {
+		if err := cursor.VisitOutEdges(hash, visit); err != nil {
+			return fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+		return nil
+	}
+
+	edges, err := outEdges(g, hash)
+	if err != nil {
+		return err
+	}
+
+	visitEdges(edges, visit)
+
+	return nil
+}
+
+// VisitPredecessors calls the visit function for each ingoing edge of the given
+// vertex until it returns true. It works like [VisitAdjacencies] and uses the
+// ingoing edge index of the store, if available. In an undirected graph, the
+// target of each visited edge is the given vertex.
+func VisitPredecessors[K comparable, T any](g Graph[K, T], hash K, visit func(edge Edge[K]) bool) error {
+	if cursor, ok := storeOf(g).(cursorStore[K]); ok {
+		if err := cursor.VisitInEdges(hash, visit); err != nil {
+			return fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+		}
+		return nil
+	}
+
+	edges, err := inEdges(g, hash)
+	if err != nil {
+		return err
+	}
+
+	visitEdges(edges, visit)
+
+	return nil
+}
+
+func visitEdges[K comparable](edges []Edge[K], visit func(edge Edge[K]) bool) {
+	for _, edge := range edges {
+		if visit(edge) {
+			return
+		}
+	}
+}
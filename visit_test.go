@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+func TestVisitVertices(t *testing.T) {
+	for _, isDirected := range []bool{true, false} {
+		for graphName, g := range newNeighborsGraphs(t, isDirected) {
+			var visited []int
+
+			err := VisitVertices(g, func(hash int) bool {
+				visited = append(visited, hash)
+				return false
+			})
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", graphName, err)
+			}
+
+			sort.Ints(visited)
+
+			if expected := []int{1, 2, 3, 4, 5}; !slicesAreEqual(visited, expected) {
+				t.Errorf("%s: expected vertices %v, got %v", graphName, expected, visited)
+			}
+
+			count := 0
+
+			_ = VisitVertices(g, func(int) bool {
+				count++
+				return count == 2
+			})
+
+			if count != 2 {
+				t.Errorf("%s: expected visit to stop after 2 vertices, got %d", graphName, count)
+			}
+		}
+	}
+}
+
+func TestVisitAdjacencies(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		vertex      int
+		expected    []int
+		expectedErr error
+	}{
+		"directed graph": {
+			isDirected: true,
+			vertex:     1,
+			expected:   []int{2, 3},
+		},
+		"undirected graph": {
+			vertex:   3,
+			expected: []int{1, 2, 3, 4},
+		},
+		"unknown vertex": {
+			vertex:      6,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		for graphName, g := range newNeighborsGraphs(t, test.isDirected) {
+			t.Run(name+", "+graphName, func(t *testing.T) {
+				var targets []int
+
+				err := VisitAdjacencies(g, test.vertex, func(edge Edge[int]) bool {
+					if edge.Source != test.vertex {
+						t.Errorf("expected source %d, got %d", test.vertex, edge.Source)
+					}
+					targets = append(targets, edge.Target)
+					return false
+				})
+
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				sort.Ints(targets)
+
+				if !slicesAreEqual(targets, test.expected) {
+					t.Errorf("expected targets %v, got %v", test.expected, targets)
+				}
+			})
+		}
+	}
+}
+
+func TestVisitPredecessors(t *testing.T) {
+	tests := map[string]struct {
+		isDirected  bool
+		vertex      int
+		expected    []int
+		expectedErr error
+	}{
+		"directed graph": {
+			isDirected: true,
+			vertex:     3,
+			expected:   []int{1, 2, 3, 4},
+		},
+		"undirected graph": {
+			vertex:   1,
+			expected: []int{2, 3},
+		},
+		"unknown vertex": {
+			isDirected:  true,
+			vertex:      6,
+			expectedErr: ErrVertexNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		for graphName, g := range newNeighborsGraphs(t, test.isDirected) {
+			t.Run(name+", "+graphName, func(t *testing.T) {
+				var sources []int
+
+				err := VisitPredecessors(g, test.vertex, func(edge Edge[int]) bool {
+					if edge.Target != test.vertex {
+						t.Errorf("expected target %d, got %d", test.vertex, edge.Target)
+					}
+					sources = append(sources, edge.Source)
+					return false
+				})
+
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %v, got %v", test.expectedErr, err)
+				}
+
+				sort.Ints(sources)
+
+				if !slicesAreEqual(sources, test.expected) {
+					t.Errorf("expected sources %v, got %v", test.expected, sources)
+				}
+			})
+		}
+	}
+}
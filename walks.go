@@ -0,0 +1,152 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// RandomWalks generates biased second-order random walks like node2vec does,
+// which can be used as a corpus for training vertex embeddings, for example
+// using word2vec. Starting from each vertex, the given number of walks are
+// generated, each of which visits up to length vertices. Each walk is passed to
+// the visit function as soon as it has been generated. If visit returns true,
+// the generation stops:
+//
+//	rng := rand.New(rand.NewSource(42))
+//
+//	_ = graph.RandomWalks(g, 10, 80, 1, 0.5, rng, func(walk []string) bool {
+//		fmt.Fprintln(corpus, strings.Join(walk, " "))
+//		return false
+//	})
+//
+// The first step of a walk leads to a random successor of the start vertex.
+// Afterwards, the next vertex is chosen depending on the previous vertex: The
+// walk returns to the previous vertex with a weight of 1/p, moves to a vertex
+// adjacent to the previous vertex with a weight of 1, and moves further away
+// with a weight of 1/q. Hence, a low p keeps the walk local, a low q lets it
+// explore the graph like a depth-first search, and p = q = 1 yields uniform
+// random walks. In a weighted graph, these weights are multiplied by the edge
+// weights, which must not be negative. A walk ends early if it reaches a vertex
+// without outgoing edges.
+//
+// Each walk is passed to visit as a new slice that may be retained. The walks
+// are generated in rounds, where each round generates one walk for each vertex.
+// Each step takes O(deg) time, and no transition probabilities are precomputed.
+// With a store that enumerates its vertices and edges in a deterministic order,
+// such as the one created by NewOrderedStore, the same seed always yields the
+// same walks.
+func RandomWalks[K comparable, T any](g Graph[K, T], walksPerVertex, length int, p, q float64, rng *rand.Rand, visit func(walk []K) bool) error {
+	if walksPerVertex < 0 || length < 1 {
+		return fmt.Errorf("number of walks must not be negative and length must be at least 1, got %d and %d", walksPerVertex, length)
+	}
+
+	if p <= 0 || q <= 0 {
+		return fmt.Errorf("parameters p and q must be positive, got %v and %v", p, q)
+	}
+
+	adjacencyMap, err := g.AdjacencyMap()
+	if err != nil {
+		return fmt.Errorf("failed to get adjacency map: %w", err)
+	}
+
+	hashes := make([]K, 0, len(adjacencyMap))
+
+	_ = VisitVertices(g, func(hash K) bool {
+		hashes = append(hashes, hash)
+		return false
+	})
+
+	isWeighted := g.Traits().IsWeighted
+	index, hasIndex := storeOf(g).(edgeIndex[K])
+
+	// successors contains the outgoing edges of each vertex, in the order of
+	// the store if it provides an edge index.
+	successors := make(map[K][]Edge[K], len(hashes))
+
+	for _, hash := range hashes {
+		var edges []Edge[K]
+
+		if hasIndex {
+			if edges, err = index.OutEdges(hash); err != nil {
+				return fmt.Errorf("could not get vertex with hash %v: %w", hash, err)
+			}
+		} else {
+			for target, edge := range adjacencyMap[hash] {
+				edge.Source, edge.Target = hash, target
+				edges = append(edges, edge)
+			}
+		}
+
+		for _, edge := range edges {
+			if isWeighted && edge.Properties.Weight < 0 {
+				return fmt.Errorf("edge (%v, %v) has a negative weight", edge.Source, edge.Target)
+			}
+		}
+
+		successors[hash] = edges
+	}
+
+	weights := make([]float64, 0)
+
+	for round := 0; round < walksPerVertex; round++ {
+		rng.Shuffle(len(hashes), func(i, j int) {
+			hashes[i], hashes[j] = hashes[j], hashes[i]
+		})
+
+		for _, start := range hashes {
+			walk := make([]K, 1, length)
+			walk[0] = start
+
+			for len(walk) < length {
+				current := walk[len(walk)-1]
+				edges := successors[current]
+
+				weights = weights[:0]
+				total := 0.0
+
+				for _, edge := range edges {
+					weight := 1.0
+					if isWeighted {
+						weight = float64(edge.Properties.Weight)
+					}
+
+					if len(walk) > 1 {
+						previous := walk[len(walk)-2]
+
+						if edge.Target == previous {
+							weight /= p
+						} else if _, ok := adjacencyMap[previous][edge.Target]; !ok {
+							weight /= q
+						}
+					}
+
+					weights = append(weights, weight)
+					total += weight
+				}
+
+				if total == 0 {
+					break
+				}
+
+				r := rng.Float64() * total
+				next := len(edges) - 1
+
+				for i, weight := range weights {
+					if r < weight {
+						next = i
+						break
+					}
+					r -= weight
+				}
+
+				walk = append(walk, edges[next].Target)
+			}
+
+			if visit(walk) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestRandomWalks(t *testing.T) {
+	tests := map[string]struct {
+		traits   []func(*Traits)
+		vertices []int
+		edges    []Edge[int]
+		p, q     float64
+		// check is called for each walk and each index i >= 2.
+		check func(walk []int, i int) bool
+	}{
+		"directed path": {
+			traits:   []func(*Traits){Directed()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+			},
+			p: 1,
+			q: 1,
+		},
+		"low p returns to the previous vertex": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			p:     1e-9,
+			q:     1,
+			check: func(walk []int, i int) bool { return walk[i] == walk[i-2] },
+		},
+		"low q moves away from the previous vertex": {
+			vertices: []int{1, 2, 3, 4},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2},
+				{Source: 2, Target: 3},
+				{Source: 3, Target: 4},
+				{Source: 4, Target: 1},
+			},
+			p:     1,
+			q:     1e-9,
+			check: func(walk []int, i int) bool { return walk[i] != walk[i-2] },
+		},
+		"weighted graph": {
+			traits:   []func(*Traits){Directed(), Weighted()},
+			vertices: []int{1, 2, 3},
+			edges: []Edge[int]{
+				{Source: 1, Target: 2, Properties: EdgeProperties{Weight: 0}},
+				{Source: 1, Target: 3, Properties: EdgeProperties{Weight: 1}},
+				{Source: 3, Target: 1, Properties: EdgeProperties{Weight: 1}},
+			},
+			p:     1,
+			q:     1,
+			check: func(walk []int, i int) bool { return walk[i] != 2 },
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := New(IntHash, test.traits...)
+
+			for _, vertex := range test.vertices {
+				_ = g.AddVertex(vertex)
+			}
+
+			for _, edge := range test.edges {
+				_ = g.AddEdge(copyEdge(edge))
+			}
+
+			count := 0
+
+			err := RandomWalks(g, 5, 6, test.p, test.q, rand.New(rand.NewSource(1)), func(walk []int) bool {
+				count++
+
+				if len(walk) == 0 || len(walk) > 6 {
+					t.Fatalf("expected walk of length 1 to 6, got %v", walk)
+				}
+
+				for i := 1; i < len(walk); i++ {
+					if _, err := g.Edge(walk[i-1], walk[i]); err != nil {
+						t.Fatalf("expected edge (%d, %d) in walk %v: %v", walk[i-1], walk[i], walk, err)
+					}
+
+					if i >= 2 && test.check != nil && !test.check(walk, i) {
+						t.Fatalf("unexpected vertex %d at index %d of walk %v", walk[i], i, walk)
+					}
+				}
+
+				if test.traits == nil && len(walk) != 6 {
+					t.Errorf("expected walk of length 6, got %v", walk)
+				}
+
+				return false
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if count != 5*len(test.vertices) {
+				t.Errorf("expected %d walks, got %d", 5*len(test.vertices), count)
+			}
+		})
+	}
+}
+
+func TestRandomWalks_stop(t *testing.T) {
+	g := New(IntHash)
+
+	_ = g.AddVertex(1)
+	_ = g.AddVertex(2)
+	_ = g.AddEdge(1, 2)
+
+	count := 0
+
+	_ = RandomWalks(g, 10, 5, 1, 1, rand.New(rand.NewSource(1)), func(walk []int) bool {
+		count++
+		return true
+	})
+
+	if count != 1 {
+		t.Errorf("expected 1 walk, got %d", count)
+	}
+}
+
+func TestRandomWalks_deterministic(t *testing.T) {
+	walks := func() [][]int {
+		g := NewWithStore(IntHash, NewOrderedStore[int, int](nil))
+
+		for vertex := 0; vertex < 10; vertex++ {
+			_ = g.AddVertex(vertex)
+		}
+
+		for vertex := 0; vertex < 10; vertex++ {
+			_ = g.AddEdge(vertex, (vertex+1)%10)
+			_ = g.AddEdge(vertex, (vertex+3)%10)
+		}
+
+		collected := make([][]int, 0)
+
+		_ = RandomWalks(g, 3, 10, 0.5, 2, rand.New(rand.NewSource(42)), func(walk []int) bool {
+			collected = append(collected, walk)
+			return false
+		})
+
+		return collected
+	}
+
+	if first, second := walks(), walks(); !reflect.DeepEqual(first, second) {
+		t.Errorf("expected the same walks for the same seed, got %v and %v", first, second)
+	}
+}
+
+func TestRandomWalks_invalidParameters(t *testing.T) {
+	g := New(IntHash)
+	rng := rand.New(rand.NewSource(1))
+	visit := func([]int) bool { return false }
+
+	if err := RandomWalks(g, 1, 0, 1, 1, rng, visit); err == nil {
+		t.Error("expected error for length 0")
+	}
+
+	if err := RandomWalks(g, 1, 5, 0, 1, rng, visit); err == nil {
+		t.Error("expected error for p = 0")
+	}
+
+	w := New(IntHash, Weighted())
+	_ = w.AddVertex(1)
+	_ = w.AddVertex(2)
+	_ = w.AddEdge(1, 2, EdgeWeight(-1))
+
+	if err := RandomWalks(w, 1, 5, 1, 1, rng, visit); err == nil {
+		t.Error("expected error for negative weight")
+	}
+}